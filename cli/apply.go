@@ -16,6 +16,10 @@ type ApplyOpts struct {
 	PackageURL   string
 	ForcePackage bool
 	UserCACerts  tls.KeyPairs
+	Nodes        []string
+	Pools        []string
+	Resources    bool
+	Output       string
 }
 
 // ApplyGetOpts get the `apply` command parameters from the cobra commands and arguments
@@ -84,12 +88,51 @@ func ApplyGetOpts(cmd *cobra.Command, args []string) (opts *ApplyOpts, warns []s
 		return nil, warns, err
 	}
 
+	// --nodes --pools --resources
+	var nodes, pools []string
+	if nodesFlag := cmd.Flags().Lookup("nodes"); nodesFlag != nil {
+		if nodes, err = StringToArray(nodesFlag.Value.String()); err != nil {
+			return nil, warns, fmt.Errorf("failed to parse the list of nodes")
+		}
+	}
+	if poolsFlag := cmd.Flags().Lookup("pools"); poolsFlag != nil {
+		if pools, err = StringToArray(poolsFlag.Value.String()); err != nil {
+			return nil, warns, fmt.Errorf("failed to parse the list of pools")
+		}
+	}
+	var resources bool
+	if resourcesFlag := cmd.Flags().Lookup("resources"); resourcesFlag != nil {
+		resources = resourcesFlag.Value.String() == "true"
+	}
+	if len(nodes) != 0 || len(pools) != 0 {
+		if action != "CONFIGURE" {
+			return nil, warns, fmt.Errorf("the flags 'nodes' and 'pools' can only be used with 'configure', the provisioning is always applied to the entire cluster")
+		}
+	} else if resources {
+		warns = append(warns, "the flag 'resources' is ignored without 'nodes' or 'pools', the resources are always applied when the entire cluster is configured")
+	}
+
+	// --output
+	output := "text"
+	if outputFlag := cmd.Flags().Lookup("output"); outputFlag != nil {
+		output = outputFlag.Value.String()
+	}
+	switch output {
+	case "text", "json", "yaml", "events", "progress":
+	default:
+		return nil, warns, fmt.Errorf("unknown or unsupported output format %q. Available formats: 'text', 'events' and 'progress', or 'json' and 'yaml' for the plan", output)
+	}
+
 	opts = &ApplyOpts{
 		ClusterName:  clusterName,
 		Action:       action,
 		PackageURL:   pkgURL,
 		ForcePackage: forcePkg,
 		UserCACerts:  userCACerts,
+		Nodes:        nodes,
+		Pools:        pools,
+		Resources:    resources,
+		Output:       output,
 	}
 
 	return opts, warns, nil
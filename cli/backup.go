@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// RestoreOpts encapsulate all the CLI parameters received from the `restore` command
+type RestoreOpts struct {
+	ClusterName string
+	From        string
+	Force       bool
+}
+
+// RestoreGetOpts get the `restore` command parameters from the cobra commands and arguments
+func RestoreGetOpts(cmd *cobra.Command, args []string) (opts *RestoreOpts, warns []string, err error) {
+	warns = make([]string, 0)
+
+	// cluster_name
+	clusterName, err := GetOneClusterName(cmd, args, false)
+	if err != nil {
+		return nil, warns, err
+	}
+
+	// Get the flags `--from` and `--force`
+	var from string
+	if fromFlag := cmd.Flags().Lookup("from"); fromFlag != nil {
+		from = fromFlag.Value.String()
+	}
+	if len(from) == 0 {
+		return nil, warns, fmt.Errorf("the etcd snapshot file to restore is required, use the flag '--from'")
+	}
+	force := false
+	if forceFlag := cmd.Flags().Lookup("force"); forceFlag != nil {
+		force = forceFlag.Value.String() == "true"
+	}
+
+	opts = &RestoreOpts{
+		ClusterName: clusterName,
+		From:        from,
+		Force:       force,
+	}
+
+	return opts, warns, nil
+}
+
+// Confirm ask to the user to confirm to replace the cluster data with the snapshot
+func (opts *RestoreOpts) Confirm() bool {
+	if opts.Force {
+		return true
+	}
+	question := fmt.Sprintf("Do you want to replace the data of cluster %q with the etcd snapshot %s", opts.ClusterName, opts.From)
+	return HardConfirmation(question, "yes")
+}
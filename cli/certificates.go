@@ -0,0 +1,173 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/liferaft/kubekit/pkg/kluster"
+	"github.com/spf13/cobra"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// GetCertificatesOpts encapsulate all the CLI parameters received from the
+// `get certificates` command
+type GetCertificatesOpts struct {
+	ClusterName string
+	Deployed    bool
+	Nodes       []string
+	Pools       []string
+	Output      string
+	Pp          bool
+}
+
+// GetCertificatesGetOpts get the `get certificates` command parameters from the
+// cobra commands and arguments
+func GetCertificatesGetOpts(cmd *cobra.Command, args []string) (opts *GetCertificatesOpts, warns []string, err error) {
+	warns = make([]string, 0)
+
+	clusterName, err := GetOneClusterName(cmd, args, false)
+	if err != nil {
+		return nil, warns, err
+	}
+
+	// Get the flags `--deployed`, `--output` and `--pp`
+	deployed := false
+	if deployedFlag := cmd.Flags().Lookup("deployed"); deployedFlag != nil {
+		deployed = deployedFlag.Value.String() == "true"
+	}
+	var output string
+	if outputFlag := cmd.Flags().Lookup("output"); outputFlag != nil {
+		output = outputFlag.Value.String()
+	}
+	pp := false
+	if ppFlag := cmd.Flags().Lookup("pp"); ppFlag != nil {
+		pp = ppFlag.Value.String() == "true"
+	}
+
+	// Get the flags `--nodes` and `--pools`
+	var nodes, pools []string
+	if nodesFlag := cmd.Flags().Lookup("nodes"); nodesFlag != nil {
+		if nodes, err = StringToArray(nodesFlag.Value.String()); err != nil {
+			return nil, warns, fmt.Errorf("failed to parse the list of nodes")
+		}
+	}
+	if poolsFlag := cmd.Flags().Lookup("pools"); poolsFlag != nil {
+		if pools, err = StringToArray(poolsFlag.Value.String()); err != nil {
+			return nil, warns, fmt.Errorf("failed to parse the list of pools")
+		}
+	}
+
+	if !deployed && (len(nodes) != 0 || len(pools) != 0) {
+		warns = append(warns, "the flags '--nodes' and '--pools' are ignored without the flag '--deployed'")
+		nodes, pools = nil, nil
+	}
+
+	return &GetCertificatesOpts{
+		ClusterName: clusterName,
+		Deployed:    deployed,
+		Nodes:       nodes,
+		Pools:       pools,
+		Output:      output,
+		Pp:          pp,
+	}, warns, nil
+}
+
+// RotateCertificatesOpts encapsulate all the CLI parameters received from the
+// `rotate certificates` command
+type RotateCertificatesOpts struct {
+	ClusterName string
+	Only        []string
+}
+
+// RotateCertificatesGetOpts get the `rotate certificates` command parameters
+// from the cobra commands and arguments
+func RotateCertificatesGetOpts(cmd *cobra.Command, args []string) (opts *RotateCertificatesOpts, warns []string, err error) {
+	warns = make([]string, 0)
+
+	clusterName, err := GetOneClusterName(cmd, args, false)
+	if err != nil {
+		return nil, warns, err
+	}
+
+	// Get the flag `--only`
+	var only []string
+	if onlyFlag := cmd.Flags().Lookup("only"); onlyFlag != nil {
+		names, err := StringToArray(onlyFlag.Value.String())
+		if err != nil {
+			return nil, warns, fmt.Errorf("failed to parse the list of certificates")
+		}
+		if only, err = kluster.CertNamesFrom(names); err != nil {
+			return nil, warns, err
+		}
+	}
+
+	return &RotateCertificatesOpts{
+		ClusterName: clusterName,
+		Only:        only,
+	}, warns, nil
+}
+
+// CertificatesInfo is the list of certificates of a cluster
+type CertificatesInfo []kluster.CertificateInfo
+
+// Sprintf returns a string to print in the given format. Pretty Print (`pp`)
+// applies only for JSON
+func (ci CertificatesInfo) Sprintf(format string, pp bool) (string, error) {
+	switch format {
+	case "":
+		return "", ci.Table(false)
+	case "wide", "w":
+		return "", ci.Table(true)
+	case "json":
+		var (
+			output []byte
+			err    error
+		)
+		if pp {
+			output, err = json.MarshalIndent(ci, "", "  ")
+		} else {
+			output, err = json.Marshal(ci)
+		}
+		return string(output), err
+	case "yaml":
+		output, err := yaml.Marshal(ci)
+		return string(output), err
+	default:
+		return "", fmt.Errorf("unknown format %q", format)
+	}
+}
+
+// Table prints the certificates as a table, wide includes the subject, SANs
+// and file of every certificate
+func (ci CertificatesInfo) Table(wide bool) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	now := time.Now()
+	if wide {
+		fmt.Fprintf(w, "Name\tNode\tCA\tExpires\tDays Left\tSubject\tSANs\tFile\n")
+	} else {
+		fmt.Fprintf(w, "Name\tNode\tCA\tExpires\tDays Left\n")
+	}
+	for _, cert := range ci {
+		node := cert.Node
+		if len(node) == 0 {
+			node = "-"
+		}
+		ca := "no"
+		if cert.IsCA {
+			ca = "yes"
+		}
+		daysLeft := int(cert.ExpiresIn(now).Hours() / 24)
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d", cert.Name, node, ca, cert.NotAfter.Format("2006-01-02"), daysLeft)
+		if wide {
+			fmt.Fprintf(w, "\t%s\t%s\t%s", cert.Subject, strings.Join(cert.SANs(), ","), cert.File)
+		}
+		fmt.Fprintln(w)
+	}
+
+	return w.Flush()
+}
@@ -5,8 +5,10 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
+	"github.com/liferaft/kubekit/pkg/configurator"
 	"github.com/liferaft/kubekit/pkg/crypto/tls"
 	"github.com/liferaft/kubekit/pkg/kluster"
 	homedir "github.com/mitchellh/go-homedir"
@@ -20,7 +22,7 @@ func AddCertFlags(cmd *cobra.Command) {
 		if len(caCertInfo.Desc) == 0 {
 			continue
 		}
-		cmd.Flags().String(caCertInfo.CN+"-key-file", "", "CA RSA Key file "+caCertInfo.Desc+", recommended for production.")
+		cmd.Flags().String(caCertInfo.CN+"-key-file", "", "CA Key file (RSA or ECDSA) "+caCertInfo.Desc+", recommended for production.")
 		cmd.Flags().String(caCertInfo.CN+"-cert-file", "", "CA x509 Certificate file "+caCertInfo.Desc+", recommended for production.")
 	}
 }
@@ -84,6 +86,43 @@ func GetCertFlags(cmd *cobra.Command) (tls.KeyPairs, error) {
 	return userCACertsFiles, nil
 }
 
+// SetKeyOptionsFlags sets in the given cluster configuration the key
+// algorithm, validity and signature algorithm of the certificates from the
+// flags `--key-algorithm`, `--validity-days` and `--signature-algorithm`. Only
+// the flags set by the user replace the configuration. Returns true if the
+// configuration was modified
+func SetKeyOptionsFlags(cmd *cobra.Command, config *configurator.Config) (bool, error) {
+	if config == nil {
+		// Some platforms such as EKS and AKS does not have a config section
+		for _, name := range []string{"key-algorithm", "validity-days", "signature-algorithm"} {
+			if flag := cmd.Flags().Lookup(name); flag != nil && flag.Changed {
+				return false, fmt.Errorf("the flag '--%s' cannot be used, the cluster does not have a Kubernetes configuration", name)
+			}
+		}
+		return false, nil
+	}
+
+	changed := false
+	if flag := cmd.Flags().Lookup("key-algorithm"); flag != nil && flag.Changed {
+		config.CertificatesKeyAlgorithm = strings.ToLower(flag.Value.String())
+		changed = true
+	}
+	if flag := cmd.Flags().Lookup("validity-days"); flag != nil && flag.Changed {
+		days, err := strconv.Atoi(flag.Value.String())
+		if err != nil || days <= 0 {
+			return false, fmt.Errorf("invalid value %q for the flag '--validity-days', it has to be a number of days greater than 0", flag.Value.String())
+		}
+		config.CertificatesValidityDays = days
+		changed = true
+	}
+	if flag := cmd.Flags().Lookup("signature-algorithm"); flag != nil && flag.Changed {
+		config.CertificatesSignatureAlgorithm = flag.Value.String()
+		changed = true
+	}
+
+	return changed, nil
+}
+
 // GetCredentials get the credentials from cobra CLI flags and insert them into
 // the list of variables. Returns, as a warning, the list of variables ignored
 // or replaced
@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// CheckOpts encapsulate all the CLI parameters received from the `check` command
+type CheckOpts struct {
+	ClusterName string
+	Output      string
+	Pp          bool
+	SkipChecks  []string
+}
+
+// CheckGetOpts get the `check` command parameters from the cobra commands and arguments
+func CheckGetOpts(cmd *cobra.Command, args []string) (opts *CheckOpts, warns []string, err error) {
+	warns = make([]string, 0)
+
+	// cluster_name
+	clusterName, err := GetOneClusterName(cmd, args, false)
+	if err != nil {
+		return nil, warns, err
+	}
+
+	// Get the flags `--output` and `--pp`
+	var output string
+	if outputFlag := cmd.Flags().Lookup("output"); outputFlag != nil {
+		output = outputFlag.Value.String()
+	}
+	pp := false
+	if ppFlag := cmd.Flags().Lookup("pp"); ppFlag != nil {
+		pp = ppFlag.Value.String() == "true"
+	}
+
+	skipChecks, err := GetSkipChecksFlag(cmd)
+	if err != nil {
+		return nil, warns, err
+	}
+
+	opts = &CheckOpts{
+		ClusterName: clusterName,
+		Output:      output,
+		Pp:          pp,
+		SkipChecks:  skipChecks,
+	}
+
+	return opts, warns, nil
+}
+
+// AddSkipChecksFlag adds the flag `--skip-checks` to skip some or all the
+// preflight checks
+func AddSkipChecksFlag(cmd *cobra.Command) {
+	cmd.Flags().StringSlice("skip-checks", nil, "list of preflight checks to skip, or 'all' to skip all of them if no check is given")
+	cmd.Flags().Lookup("skip-checks").NoOptDefVal = "all"
+}
+
+// GetSkipChecksFlag returns the preflight checks to skip from the flag
+// `--skip-checks`
+func GetSkipChecksFlag(cmd *cobra.Command) ([]string, error) {
+	skipChecksFlag := cmd.Flags().Lookup("skip-checks")
+	if skipChecksFlag == nil {
+		return nil, nil
+	}
+	skipChecks, err := StringToArray(skipChecksFlag.Value.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the list of checks to skip")
+	}
+	return skipChecks, nil
+}
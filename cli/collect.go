@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// CollectOpts encapsulate all the CLI parameters received from the `collect` command
+type CollectOpts struct {
+	ClusterName string
+	Output      string
+}
+
+// CollectGetOpts get the `collect` command parameters from the cobra commands and arguments
+func CollectGetOpts(cmd *cobra.Command, args []string) (opts *CollectOpts, warns []string, err error) {
+	warns = make([]string, 0)
+
+	// cluster_name
+	clusterName, err := GetOneClusterName(cmd, args, false)
+	if err != nil {
+		return nil, warns, err
+	}
+
+	// Get the flag `--output`, by default it's the cluster name
+	var output string
+	if outputFlag := cmd.Flags().Lookup("output"); outputFlag != nil {
+		output = outputFlag.Value.String()
+	}
+	if len(output) == 0 {
+		output = clusterName + "-bundle.tar.gz"
+	}
+
+	opts = &CollectOpts{
+		ClusterName: clusterName,
+		Output:      output,
+	}
+
+	return opts, warns, nil
+}
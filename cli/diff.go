@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// DiffOpts encapsulate all the CLI parameters received from the `diff` command
+type DiffOpts struct {
+	ClusterName string
+	Output      string
+	Pp          bool
+}
+
+// DiffGetOpts get the `diff` command parameters from the cobra commands and arguments
+func DiffGetOpts(cmd *cobra.Command, args []string) (opts *DiffOpts, warns []string, err error) {
+	warns = make([]string, 0)
+
+	// cluster_name
+	clusterName, err := GetOneClusterName(cmd, args, false)
+	if err != nil {
+		return nil, warns, err
+	}
+
+	// Get the flags `--output` and `--pp`
+	var output string
+	if outputFlag := cmd.Flags().Lookup("output"); outputFlag != nil {
+		output = outputFlag.Value.String()
+	}
+	pp := false
+	if ppFlag := cmd.Flags().Lookup("pp"); ppFlag != nil {
+		pp = ppFlag.Value.String() == "true"
+	}
+
+	opts = &DiffOpts{
+		ClusterName: clusterName,
+		Output:      output,
+		Pp:          pp,
+	}
+
+	return opts, warns, nil
+}
@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// ExportOpts encapsulate all the CLI parameters received from the `export` command
+type ExportOpts struct {
+	ClusterName    string
+	Output         string
+	IncludeBackups bool
+	KeyFile        string
+}
+
+// ExportGetOpts get the `export` command parameters from the cobra commands and arguments
+func ExportGetOpts(cmd *cobra.Command, args []string) (opts *ExportOpts, warns []string, err error) {
+	warns = make([]string, 0)
+
+	// cluster_name
+	clusterName, err := GetOneClusterName(cmd, args, false)
+	if err != nil {
+		return nil, warns, err
+	}
+
+	// Get the flag `--output`, by default it's the cluster name
+	var output string
+	if outputFlag := cmd.Flags().Lookup("output"); outputFlag != nil {
+		output = outputFlag.Value.String()
+	}
+	if len(output) == 0 {
+		output = clusterName + ".tar.gz"
+	}
+
+	// Get the flag `--include-backups`
+	includeBackups := false
+	if includeBackupsFlag := cmd.Flags().Lookup("include-backups"); includeBackupsFlag != nil {
+		includeBackups = includeBackupsFlag.Value.String() == "true"
+	}
+
+	opts = &ExportOpts{
+		ClusterName:    clusterName,
+		Output:         output,
+		IncludeBackups: includeBackups,
+		KeyFile:        getKeyFileFlag(cmd),
+	}
+
+	return opts, warns, nil
+}
+
+// ImportOpts encapsulate all the CLI parameters received from the `import` command
+type ImportOpts struct {
+	Filename string
+	KeyFile  string
+}
+
+// ImportGetOpts get the `import` command parameters from the cobra commands and arguments
+func ImportGetOpts(cmd *cobra.Command, args []string) (opts *ImportOpts, err error) {
+	if len(args) == 0 {
+		return nil, fmt.Errorf("requires a cluster archive file")
+	}
+	if len(args) != 1 {
+		return nil, fmt.Errorf("accepts 1 cluster archive file, received %d. %v", len(args), args)
+	}
+	if len(args[0]) == 0 {
+		return nil, fmt.Errorf("cluster archive file cannot be empty")
+	}
+
+	return &ImportOpts{
+		Filename: args[0],
+		KeyFile:  getKeyFileFlag(cmd),
+	}, nil
+}
+
+// getKeyFileFlag returns the flag `--key-file`, the key to encrypt or decrypt
+// the cluster archive
+func getKeyFileFlag(cmd *cobra.Command) string {
+	if keyFileFlag := cmd.Flags().Lookup("key-file"); keyFileFlag != nil {
+		return keyFileFlag.Value.String()
+	}
+	return ""
+}
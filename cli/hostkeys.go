@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/liferaft/kubekit/pkg/configurator/ssh"
+	"github.com/spf13/cobra"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// HostKeysOpts encapsulate all the CLI parameters received from the
+// `get hostkeys` and `delete hostkeys` commands
+type HostKeysOpts struct {
+	ClusterName string
+	Nodes       []string
+	All         bool
+	Output      string
+	Pp          bool
+}
+
+// HostKeysGetOpts get the `get hostkeys` and `delete hostkeys` command
+// parameters from the cobra commands and arguments
+func HostKeysGetOpts(cmd *cobra.Command, args []string) (opts *HostKeysOpts, warns []string, err error) {
+	warns = make([]string, 0)
+
+	if len(args) == 0 {
+		return nil, warns, fmt.Errorf("requires a cluster name")
+	}
+	clusterName, err := GetOneClusterName(cmd, args[:1], false)
+	if err != nil {
+		return nil, warns, err
+	}
+	nodes := args[1:]
+
+	// Get the flags `--all`, `--output` and `--pp`
+	all := false
+	allFlag := cmd.Flags().Lookup("all")
+	if allFlag != nil {
+		all = allFlag.Value.String() == "true"
+	}
+	var output string
+	outputFlag := cmd.Flags().Lookup("output")
+	if outputFlag != nil {
+		output = outputFlag.Value.String()
+	}
+	pp := false
+	ppFlag := cmd.Flags().Lookup("pp")
+	if ppFlag != nil {
+		pp = ppFlag.Value.String() == "true"
+	}
+
+	if all && len(nodes) != 0 {
+		return nil, warns, fmt.Errorf("'--all' flag and the list of nodes are mutually exclusive, use one or the other")
+	}
+
+	return &HostKeysOpts{
+		ClusterName: clusterName,
+		Nodes:       nodes,
+		All:         all,
+		Output:      output,
+		Pp:          pp,
+	}, warns, nil
+}
+
+// HostKeysInfo is the list of pinned host keys of a cluster
+type HostKeysInfo []ssh.HostKey
+
+// Sprintf returns a string to print in the given format. Pretty Print (`pp`)
+// applies only for JSON
+func (hki HostKeysInfo) Sprintf(format string, pp bool) (string, error) {
+	switch format {
+	case "", "wide", "w":
+		return "", hki.Table()
+	case "json":
+		var (
+			output []byte
+			err    error
+		)
+		if pp {
+			output, err = json.MarshalIndent(hki, "", "  ")
+		} else {
+			output, err = json.Marshal(hki)
+		}
+		return string(output), err
+	case "yaml":
+		output, err := yaml.Marshal(hki)
+		return string(output), err
+	default:
+		return "", fmt.Errorf("unknown format %q", format)
+	}
+}
+
+// Table prints the host keys as a table
+func (hki HostKeysInfo) Table() error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintf(w, "Host\tType\tFingerprint\n")
+	for _, key := range hki {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", key.Host, key.Type, key.Fingerprint)
+	}
+
+	return w.Flush()
+}
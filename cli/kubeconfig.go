@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// DefaultKubeconfigTTL is the default time the user client certificates are
+// valid
+const DefaultKubeconfigTTL = 24 * time.Hour
+
+// MaxKubeconfigTTL is the maximum time the user client certificates are valid,
+// they cannot be revoked
+const MaxKubeconfigTTL = 7 * 24 * time.Hour
+
+// GetKubeconfigOpts encapsulate all the CLI parameters received from the
+// `get kubeconfig` command
+type GetKubeconfigOpts struct {
+	ClusterName string
+	User        string
+	Groups      []string
+	TTL         time.Duration
+	OIDC        bool
+	File        string
+}
+
+// GetKubeconfigGetOpts get the `get kubeconfig` command parameters from the
+// cobra commands and arguments
+func GetKubeconfigGetOpts(cmd *cobra.Command, args []string) (opts *GetKubeconfigOpts, warns []string, err error) {
+	warns = make([]string, 0)
+
+	clusterName, err := GetOneClusterName(cmd, args, false)
+	if err != nil {
+		return nil, warns, err
+	}
+
+	// Get the flags `--user`, `--group`, `--ttl`, `--oidc` and `--file`
+	var user string
+	userChanged := false
+	if userFlag := cmd.Flags().Lookup("user"); userFlag != nil {
+		user = strings.TrimSpace(userFlag.Value.String())
+		userChanged = userFlag.Changed
+	}
+	var groups []string
+	groupsChanged := false
+	if groupFlag := cmd.Flags().Lookup("group"); groupFlag != nil {
+		if groups, err = StringToArray(groupFlag.Value.String()); err != nil {
+			return nil, warns, fmt.Errorf("failed to parse the list of groups")
+		}
+		groupsChanged = groupFlag.Changed
+	}
+	ttl := DefaultKubeconfigTTL
+	ttlChanged := false
+	if ttlFlag := cmd.Flags().Lookup("ttl"); ttlFlag != nil {
+		if ttl, err = time.ParseDuration(ttlFlag.Value.String()); err != nil {
+			return nil, warns, fmt.Errorf("failed to parse the TTL %q. %s", ttlFlag.Value.String(), err)
+		}
+		ttlChanged = ttlFlag.Changed
+	}
+	oidc := false
+	if oidcFlag := cmd.Flags().Lookup("oidc"); oidcFlag != nil {
+		oidc = oidcFlag.Value.String() == "true"
+	}
+	var file string
+	if fileFlag := cmd.Flags().Lookup("file"); fileFlag != nil {
+		file = fileFlag.Value.String()
+	}
+
+	if oidc {
+		if userChanged || groupsChanged || ttlChanged {
+			warns = append(warns, "the flags '--user', '--group' and '--ttl' are ignored with the flag '--oidc', the user, groups and token expiration are set by the OIDC issuer")
+		}
+		user, groups, ttl = "", nil, 0
+	} else {
+		if len(user) == 0 {
+			return nil, warns, fmt.Errorf("the user name is required, use the flag '--user' or '--oidc' to authenticate with OIDC")
+		}
+		if ttl <= 0 {
+			return nil, warns, fmt.Errorf("the TTL has to be greater than zero, received %s", ttl)
+		}
+		if ttl > MaxKubeconfigTTL {
+			return nil, warns, fmt.Errorf("the TTL cannot be longer than %s, the client certificates cannot be revoked, received %s", MaxKubeconfigTTL, ttl)
+		}
+	}
+
+	return &GetKubeconfigOpts{
+		ClusterName: clusterName,
+		User:        user,
+		Groups:      groups,
+		TTL:         ttl,
+		OIDC:        oidc,
+		File:        file,
+	}, warns, nil
+}
@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+func TestGetKubeconfigGetOpts(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		want      *GetKubeconfigOpts
+		wantWarns int
+		wantErr   bool
+	}{
+		{"user", []string{"kkdemo", "--user", "alice"}, &GetKubeconfigOpts{ClusterName: "kkdemo", User: "alice", Groups: []string{}, TTL: DefaultKubeconfigTTL}, 0, false},
+		{"user and groups", []string{"kkdemo", "--user", "alice", "--group", "devs", "--group", "ops", "--ttl", "1h", "--file", "alice.kubeconfig"}, &GetKubeconfigOpts{ClusterName: "kkdemo", User: "alice", Groups: []string{"devs", "ops"}, TTL: time.Hour, File: "alice.kubeconfig"}, 0, false},
+		{"oidc", []string{"kkdemo", "--oidc"}, &GetKubeconfigOpts{ClusterName: "kkdemo", OIDC: true}, 0, false},
+		{"oidc ignores user", []string{"kkdemo", "--oidc", "--user", "alice", "--ttl", "1h"}, &GetKubeconfigOpts{ClusterName: "kkdemo", OIDC: true}, 1, false},
+		{"no user", []string{"kkdemo"}, nil, 0, true},
+		{"zero ttl", []string{"kkdemo", "--user", "alice", "--ttl", "0s"}, nil, 0, true},
+		{"max ttl", []string{"kkdemo", "--user", "alice", "--ttl", "168h"}, &GetKubeconfigOpts{ClusterName: "kkdemo", User: "alice", Groups: []string{}, TTL: MaxKubeconfigTTL}, 0, false},
+		{"ttl too long", []string{"kkdemo", "--user", "alice", "--ttl", "8760h"}, nil, 0, true},
+		{"no cluster", []string{"--user", "alice"}, nil, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			cmd.Flags().String("user", "", "")
+			cmd.Flags().StringSlice("group", []string{}, "")
+			cmd.Flags().Duration("ttl", DefaultKubeconfigTTL, "")
+			cmd.Flags().Bool("oidc", false, "")
+			cmd.Flags().String("file", "", "")
+			if err := cmd.ParseFlags(tt.args); err != nil {
+				t.Fatal(err)
+			}
+
+			got, warns, err := GetKubeconfigGetOpts(cmd, cmd.Flags().Args())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetKubeconfigGetOpts() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetKubeconfigGetOpts() = %+v, want %+v", got, tt.want)
+			}
+			if len(warns) != tt.wantWarns {
+				t.Errorf("GetKubeconfigGetOpts() warns = %v, want %d warnings", warns, tt.wantWarns)
+			}
+		})
+	}
+}
@@ -1,15 +1,18 @@
 package kubekit
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
 
+	"github.com/kraken/ui"
 	"github.com/liferaft/kubekit/cli"
 
 	"github.com/liferaft/kubekit/pkg/kluster"
 	"github.com/liferaft/kubekit/pkg/packages"
 	"github.com/spf13/cobra"
+	yaml "gopkg.in/yaml.v2"
 )
 
 var (
@@ -68,7 +71,7 @@ or testing.`,
 }
 
 func addApplyCmd() {
-	// apply [cluster] NAME --provision --configure --certificates --generate-certs --export --plan --CERT-key-file FILE --CERT-cert-file FILE
+	// apply [cluster] NAME --provision --configure --certificates --generate-certs --export --plan --output (text|json|yaml) --plan-out FILE --plan-file FILE --CERT-key-file FILE --CERT-cert-file FILE
 	RootCmd.AddCommand(applyCmd)
 	applyCmd.Flags().BoolVarP(&doProvision, "provision", "p", false, "only apply the provisioning. If possible for the cluster platform, creates or updates the nodes of the cluster")
 	applyCmd.Flags().BoolVarP(&doConfigure, "configure", "c", false, "only apply the configuration. The cluster must exists. Generate the certificates (if doesn't exists), install and configure Kubernetes on the existing cluster")
@@ -79,9 +82,17 @@ func addApplyCmd() {
 	applyCmd.Flags().BoolVar(&doExportTF, "export-tf", false, "don't apply, just export the Terraform templates to the cluster config directory")
 	applyCmd.Flags().BoolVar(&doExportK8s, "export-k8s", false, "don't apply, just export the Kubernetes manifests templates to the cluster config directory")
 	applyCmd.Flags().Bool("force-pkg", false, "force install of package")
+	cli.AddParallelFlag(applyCmd)
+	applyCmd.Flags().StringSlice("nodes", nil, "only configure the given nodes, identified by IP or DNS name. Requires --configure")
+	applyCmd.Flags().StringSlice("pools", nil, "only configure the nodes in the given node pools. Requires --configure")
+	applyCmd.Flags().Bool("resources", false, "with --nodes or --pools, also apply the cluster-wide Kubernetes resources")
+	cli.AddSkipChecksFlag(applyCmd)
 	// Advance command, do not print in help:
 	// applyCmd.Flags().MarkHidden("export")
 	applyCmd.Flags().BoolVar(&doPlan, "plan", false, "don't apply, just print the provisioning changes")
+	applyCmd.Flags().StringP("output", "o", "text", "output format. Use 'events' to print the progress events as JSON lines or 'progress' to print the progress of every node. The plan printed with --plan is available in 'text', 'json' and 'yaml'")
+	applyCmd.Flags().String("plan-out", "", "save the plan printed with --plan to the given file, to apply it later with --plan-file")
+	applyCmd.Flags().String("plan-file", "", "only apply exactly the provisioning changes of the plan saved with --plan-out. The plan is not applied if the infrastructure changed after it was created")
 
 	// Advance command, do not print in help:
 	// applyCmd.Flags().MarkHidden("plan")
@@ -99,7 +110,15 @@ func addApplyCmd() {
 	// Advance command, do not print in help:
 	// applyClusterCmd.Flags().MarkHidden("export")
 	applyClusterCmd.Flags().BoolVar(&doPlan, "plan", false, "don't apply, just print the provisioning changes")
+	applyClusterCmd.Flags().StringP("output", "o", "text", "output format. Use 'events' to print the progress events as JSON lines or 'progress' to print the progress of every node. The plan printed with --plan is available in 'text', 'json' and 'yaml'")
+	applyClusterCmd.Flags().String("plan-out", "", "save the plan printed with --plan to the given file, to apply it later with --plan-file")
+	applyClusterCmd.Flags().String("plan-file", "", "only apply exactly the provisioning changes of the plan saved with --plan-out. The plan is not applied if the infrastructure changed after it was created")
 	applyClusterCmd.Flags().Bool("force-pkg", false, "force install of package")
+	cli.AddParallelFlag(applyClusterCmd)
+	applyClusterCmd.Flags().StringSlice("nodes", nil, "only configure the given nodes, identified by IP or DNS name. Requires --configure")
+	applyClusterCmd.Flags().StringSlice("pools", nil, "only configure the nodes in the given node pools. Requires --configure")
+	applyClusterCmd.Flags().Bool("resources", false, "with --nodes or --pools, also apply the cluster-wide Kubernetes resources")
+	cli.AddSkipChecksFlag(applyClusterCmd)
 	// Advance command, do not print in help:
 	// applyClusterCmd.Flags().MarkHidden("plan")
 	addCertFlags(applyClusterCmd)
@@ -146,11 +165,35 @@ func applyClusterRun(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("cluster name cannot be empty")
 	}
 
+	parallel, err := cli.GetParallelFlag(cmd)
+	if err != nil {
+		return err
+	}
+
+	opts, warns, err := cli.ApplyGetOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	for _, w := range warns {
+		config.UI.Log.Warn(w)
+	}
+	switch opts.Output {
+	case "events":
+		config.UI.OnEvent(ui.NewJSONLinesHandler(os.Stdout))
+	case "progress":
+		config.UI.OnEvent(ui.NewProgress(os.Stdout).Handle)
+	}
+	skipChecks, err := cli.GetSkipChecksFlag(cmd)
+	if err != nil {
+		return err
+	}
+
 	// the cluster config file must exists. This command should be executed after 'init' or will fail
 	cluster, err := loadCluster(clusterName)
 	if err != nil {
 		return err
 	}
+	cluster.SetParallel(parallel)
 
 	// generate (if doesn't exists) the SSH keys, required for the terraform templates and provisioner
 	if err := cluster.HandleKeys(); err != nil {
@@ -164,7 +207,13 @@ func applyClusterRun(cmd *cobra.Command, args []string) error {
 
 	// If so, that's all, print the plan and return
 	if doPlan {
-		return cluster.Plan(false)
+		return plan(cmd, cluster, false)
+	}
+
+	// If so, that's all, apply the saved plan and return. The plan only contain
+	// the provisioning changes
+	if planFile := cmd.Flags().Lookup("plan-file").Value.String(); len(planFile) != 0 {
+		return applyPlan(cluster, planFile)
 	}
 
 	pkgFilename := cmd.Flags().Lookup("package-file").Value.String()
@@ -181,6 +230,9 @@ func applyClusterRun(cmd *cobra.Command, args []string) error {
 	// if not explicit action was set, then do provisioning as part of the e2e
 	// process. Otherwise, do provisioning only if explicitly requested
 	if (!explicitActions || doProvision) && !doExportK8s {
+		if err := runPreflight(cluster, false, skipChecks); err != nil {
+			return err
+		}
 		if err := provision(cluster); err != nil {
 			return err
 		}
@@ -207,6 +259,12 @@ func applyClusterRun(cmd *cobra.Command, args []string) error {
 	// if not explicit action was set, then do configuration as part of the e2e
 	// process. Otherwise, do configuration only if explicitly requested
 	if !explicitActions || doConfigure {
+		if !doExportK8s {
+			if err := runPreflight(cluster, true, skipChecks); err != nil {
+				return err
+			}
+		}
+
 		// for platforms that are not "eks" and "aks", generate the certificates and apply the package (if any)
 		// initialize the certificates if they don't exists, unless requested with forceCerts ...
 		userCACertsFiles, err := cli.GetCertFlags(cmd)
@@ -238,7 +296,10 @@ func applyClusterRun(cmd *cobra.Command, args []string) error {
 			return cluster.ExportK8s()
 		}
 
-		// ... and do the configuration
+		// ... and do the configuration, only on the selected nodes if any
+		if len(opts.Nodes) != 0 || len(opts.Pools) != 0 {
+			return configureSelection(cluster, opts.Nodes, opts.Pools, opts.Resources)
+		}
 		return configure(cluster)
 	}
 
@@ -304,6 +365,51 @@ func provision(cluster *kluster.Kluster) error {
 	return errS
 }
 
+func applyPlan(cluster *kluster.Kluster, planFile string) error {
+	errP := cluster.ApplyPlan(planFile)
+	errS := cluster.Save()
+	if errP != nil && errS != nil {
+		return fmt.Errorf("failed to apply the plan and to save the cluster configuration file.\n%s\n%s", errP, errS)
+	}
+	if errP != nil {
+		return errP
+	}
+	return errS
+}
+
+func plan(cmd *cobra.Command, cluster *kluster.Kluster, destroy bool) error {
+	opts, err := cli.PlanGetOpts(cmd)
+	if err != nil {
+		return err
+	}
+
+	p, err := cluster.Plan(destroy, opts.PlanOut)
+	if err != nil {
+		return err
+	}
+
+	var output string
+	switch opts.Output {
+	case "json":
+		var b []byte
+		b, err = json.MarshalIndent(p, "", "  ")
+		output = string(b) + "\n"
+	case "yaml":
+		var b []byte
+		b, err = yaml.Marshal(p)
+		output = string(b)
+	default:
+		output = p.String()
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(output)
+
+	return nil
+}
+
 func copyAndExecPackage(cluster *kluster.Kluster, pkgFilename string, forcePkg bool) error {
 	clusterPath := cluster.Dir()
 
@@ -336,6 +442,18 @@ func configure(cluster *kluster.Kluster) error {
 	return errS
 }
 
+func configureSelection(cluster *kluster.Kluster, nodes, pools []string, withResources bool) error {
+	errC := cluster.ConfigureSelection(nodes, pools, withResources)
+	errS := cluster.Save()
+	if errC != nil && errS != nil {
+		return fmt.Errorf("failed to configure Kubernetes on the selected nodes and to save the cluster configuration file.\n%s\n%s", errC, errS)
+	}
+	if errC != nil {
+		return errC
+	}
+	return errS
+}
+
 func actionPackageRun(cmd *cobra.Command, args []string, doExec bool) error {
 	if len(args) == 0 {
 		return fmt.Errorf("requires a cluster name")
@@ -0,0 +1,163 @@
+package kubekit
+
+import (
+	"fmt"
+
+	"github.com/liferaft/kubekit/cli"
+	"github.com/spf13/cobra"
+)
+
+// backupCmd represents the backup command
+var backupCmd = &cobra.Command{
+	Use:   "backup [cluster] NAME",
+	Short: "takes a snapshot of the cluster etcd",
+	Long: `Takes a snapshot of etcd on one of the cluster masters and downloads it to the
+'backups' directory of the cluster, with a metadata file containing its checksum.
+It's not available for EKS and AKS clusters, where etcd is managed by the platform.`,
+	RunE: backupClusterRun,
+}
+
+// backupClusterCmd represents the backup cluster command
+var backupClusterCmd = &cobra.Command{
+	Use:   "cluster NAME",
+	Short: "takes a snapshot of the cluster etcd",
+	Long: `Takes a snapshot of etcd on one of the cluster masters and downloads it to the
+'backups' directory of the cluster, with a metadata file containing its checksum.
+It's not available for EKS and AKS clusters, where etcd is managed by the platform.`,
+	RunE: backupClusterRun,
+}
+
+// restoreCmd represents the restore command
+var restoreCmd = &cobra.Command{
+	Use:   "restore [cluster] NAME --from FILE",
+	Short: "restores an etcd snapshot to the cluster",
+	Long: `Restores an etcd snapshot taken with the 'backup' command to every etcd member
+of the cluster. The etcd members are re-initialized with the snapshot data, the
+previous data is kept on every master in a backup directory next to the etcd
+data directory.`,
+	RunE: restoreClusterRun,
+}
+
+// restoreClusterCmd represents the restore cluster command
+var restoreClusterCmd = &cobra.Command{
+	Use:   "cluster NAME --from FILE",
+	Short: "restores an etcd snapshot to the cluster",
+	Long: `Restores an etcd snapshot taken with the 'backup' command to every etcd member
+of the cluster. The etcd members are re-initialized with the snapshot data, the
+previous data is kept on every master in a backup directory next to the etcd
+data directory.`,
+	RunE: restoreClusterRun,
+}
+
+// restoreStateCmd represents the restore state command
+var restoreStateCmd = &cobra.Command{
+	Use:   "state NAME --version ID",
+	Short: "restores a version of the state history of the cluster",
+	Long: `Restores a version of the Terraform state history of the cluster, listed with
+'kubekit get state-history'. The restored state is saved as a new version, so the
+previous state can be restored back. The infrastructure is not modified, execute
+'kubekit diff' to find the differences with the restored state.`,
+	RunE: restoreStateRun,
+}
+
+func addBackupCmd() {
+	// backup [cluster] NAME
+	RootCmd.AddCommand(backupCmd)
+	backupCmd.AddCommand(backupClusterCmd)
+
+	// restore [cluster] NAME --from FILE --force
+	RootCmd.AddCommand(restoreCmd)
+	restoreCmd.PersistentFlags().Bool("force", false, "do not confirm or ask to the user before restore")
+	restoreCmd.Flags().String("from", "", "etcd snapshot file to restore")
+	restoreCmd.AddCommand(restoreClusterCmd)
+	restoreClusterCmd.Flags().String("from", "", "etcd snapshot file to restore")
+
+	// restore state NAME --version ID --force
+	restoreCmd.AddCommand(restoreStateCmd)
+	restoreStateCmd.Flags().String("version", "", "version of the state history to restore")
+}
+
+func backupClusterRun(cmd *cobra.Command, args []string) error {
+	clusterName, err := cli.GetOneClusterName(cmd, args, false)
+	if err != nil {
+		return err
+	}
+
+	cluster, err := loadCluster(clusterName)
+	if err != nil {
+		return err
+	}
+
+	snapshotFile, err := cluster.Backup()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("etcd snapshot of cluster %q saved to %s\n", clusterName, snapshotFile)
+
+	return nil
+}
+
+func restoreClusterRun(cmd *cobra.Command, args []string) error {
+	opts, warns, err := cli.RestoreGetOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	if len(warns) != 0 {
+		for _, w := range warns {
+			config.UI.Log.Warn(w)
+		}
+	}
+
+	cluster, err := loadCluster(opts.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	if ok := opts.Confirm(); !ok {
+		fmt.Printf("the etcd snapshot was not restored to cluster %q\n", opts.ClusterName)
+		return nil
+	}
+
+	errR := cluster.Restore(opts.From)
+	errS := cluster.Save()
+	if errR != nil && errS != nil {
+		return fmt.Errorf("failed to restore the etcd snapshot and to save the cluster configuration file.\n%s\n%s", errR, errS)
+	}
+	if errR != nil {
+		return errR
+	}
+	return errS
+}
+
+func restoreStateRun(cmd *cobra.Command, args []string) error {
+	opts, warns, err := cli.RestoreStateGetOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	if len(warns) != 0 {
+		for _, w := range warns {
+			config.UI.Log.Warn(w)
+		}
+	}
+
+	cluster, err := loadCluster(opts.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	if ok := opts.Confirm(); !ok {
+		fmt.Printf("the state of cluster %q was not restored\n", opts.ClusterName)
+		return nil
+	}
+
+	errR := cluster.RestoreState(opts.Version)
+	errS := cluster.Save()
+	if errR != nil && errS != nil {
+		return fmt.Errorf("failed to restore the state and to save the cluster configuration file.\n%s\n%s", errR, errS)
+	}
+	if errR != nil {
+		return errR
+	}
+	return errS
+}
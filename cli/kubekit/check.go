@@ -0,0 +1,128 @@
+package kubekit
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/liferaft/kubekit/cli"
+	"github.com/liferaft/kubekit/pkg/kluster"
+	"github.com/liferaft/kubekit/pkg/preflight"
+	"github.com/spf13/cobra"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// checkCmd represents the check command
+var checkCmd = &cobra.Command{
+	Use:   "check [cluster] NAME",
+	Short: "verifies the cluster is ready to be provisioned or configured",
+	Long: `Executes the preflight checks to verify the cluster is ready to be provisioned
+or configured: the platform credentials, the pods and services CIDRs, the nodes
+addresses and DNS names and, on every node, the clock skew, swap, disk space,
+kernel modules and the ports used by Kubernetes. The same checks are executed by
+'apply' unless the flag --skip-checks is used.
+
+The available checks are: ` + strings.Join(preflight.Names(preflight.DefaultChecks()), ", "),
+	RunE: checkClusterRun,
+}
+
+// checkClusterCmd represents the check cluster command
+var checkClusterCmd = &cobra.Command{
+	Use:   "cluster NAME",
+	Short: "verifies the cluster is ready to be provisioned or configured",
+	Long: `Executes the preflight checks to verify the cluster is ready to be provisioned
+or configured: the platform credentials, the pods and services CIDRs, the nodes
+addresses and DNS names and, on every node, the clock skew, swap, disk space,
+kernel modules and the ports used by Kubernetes. The same checks are executed by
+'apply' unless the flag --skip-checks is used.
+
+The available checks are: ` + strings.Join(preflight.Names(preflight.DefaultChecks()), ", "),
+	RunE: checkClusterRun,
+}
+
+func addCheckCmd() {
+	// check [cluster] NAME --output (table|json|yaml) --pp --skip-checks CHECK,...
+	RootCmd.AddCommand(checkCmd)
+	checkCmd.PersistentFlags().StringP("output", "o", "table", "Output format. Available formats: 'table', 'json' and 'yaml'")
+	checkCmd.PersistentFlags().BoolP("pp", "p", false, "Pretty print. Show the results in a human readable format. Applies only for 'json' format")
+	cli.AddSkipChecksFlag(checkCmd)
+	checkCmd.AddCommand(checkClusterCmd)
+	cli.AddSkipChecksFlag(checkClusterCmd)
+}
+
+func checkClusterRun(cmd *cobra.Command, args []string) error {
+	opts, warns, err := cli.CheckGetOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	if len(warns) != 0 {
+		for _, w := range warns {
+			config.UI.Log.Warn(w)
+		}
+	}
+
+	cluster, err := loadCluster(opts.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	results, err := cluster.Preflight(true, opts.SkipChecks...)
+	if err != nil {
+		return err
+	}
+
+	var output string
+	switch opts.Output {
+	case "", "table", "text":
+		output = results.String()
+	case "json":
+		var b []byte
+		if opts.Pp {
+			b, err = json.MarshalIndent(results, "", "  ")
+		} else {
+			b, err = json.Marshal(results)
+		}
+		output = string(b) + "\n"
+	case "yaml":
+		var b []byte
+		b, err = yaml.Marshal(results)
+		output = string(b)
+	default:
+		return fmt.Errorf("unknown or unsupported format %q", opts.Output)
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(output)
+
+	if results.Failed() {
+		return fmt.Errorf("%d preflight checks failed on cluster %q", results.Count(preflight.Fail), opts.ClusterName)
+	}
+
+	return nil
+}
+
+// runPreflight executes the preflight checks before apply, only the cluster checks
+// if withNodes is false. It fails if any check failed, printing the failed
+// checks and the warnings
+func runPreflight(cluster *kluster.Kluster, withNodes bool, skipChecks []string) error {
+	results, err := cluster.Preflight(withNodes, skipChecks...)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range results.Filter(preflight.Warn) {
+		node := r.Node
+		if len(node) == 0 {
+			node = cluster.Name
+		}
+		config.UI.Log.Warnf("[%s] preflight check %s: %s", node, r.Check, r.Message)
+	}
+	if !results.Failed() {
+		return nil
+	}
+
+	fmt.Print(results.Filter(preflight.Fail).String())
+	return fmt.Errorf("%d preflight checks failed, fix them or skip them with --skip-checks. Run 'kubekit check cluster %s' for the details", results.Count(preflight.Fail), cluster.Name)
+}
@@ -87,6 +87,35 @@ func AddCommands() {
 	// scale [cluster] NAME POOL-NAME=[+|-]N
 	addScaleCmd()
 
+	// upgrade [cluster] NAME --to VERSION --batch-size N
+	addUpgradeCmd()
+
+	// backup [cluster] NAME
+	// restore [cluster] NAME --from FILE --force
+	addBackupCmd()
+
+	// export [cluster] NAME --output FILE
+	// import [cluster] FILE
+	addExportImportCmd()
+
+	// migrate [cluster] NAME --dry-run
+	addMigrateCmd()
+
+	// diff [cluster] NAME --output (text|json|yaml) --pp
+	addDiffCmd()
+
+	// check [cluster] NAME --output (table|json|yaml) --pp --skip-checks CHECK,...
+	addCheckCmd()
+
+	// collect [cluster] NAME --output FILE --parallel N
+	addCollectCmd()
+
+	// rekey [NAME[ NAME ...]] --generate-key --old-key-file FILE --decrypt
+	addRekeyCmd()
+
+	// rotate certificates CLUSTER-NAME --only CERT[,CERT...] --parallel N
+	addRotateCmd()
+
 	// --version
 	// version
 	addVersionCmd()
@@ -122,7 +151,7 @@ func addCertFlags(command *cobra.Command) {
 		if len(caCertInfo.Desc) == 0 {
 			continue
 		}
-		command.Flags().String(caCertInfo.CN+"-key-file", "", "CA RSA Key file "+caCertInfo.Desc+", recommended for production.")
+		command.Flags().String(caCertInfo.CN+"-key-file", "", "CA Key file (RSA or ECDSA) "+caCertInfo.Desc+", recommended for production.")
 		command.Flags().String(caCertInfo.CN+"-cert-file", "", "CA x509 Certificate file "+caCertInfo.Desc+", recommended for production.")
 	}
 }
@@ -0,0 +1,82 @@
+package kubekit
+
+import (
+	"fmt"
+
+	"github.com/liferaft/kubekit/cli"
+	"github.com/liferaft/kubekit/pkg/kluster"
+	"github.com/spf13/cobra"
+)
+
+// collectCmd represents the collect command
+var collectCmd = &cobra.Command{
+	Use:   "collect [cluster] NAME -o FILE",
+	Short: "collects the cluster diagnostics to a support bundle",
+	Long: `Collects the diagnostics of the cluster to a gzip compressed tar archive, the
+support bundle: from every node the KubeKit logs, the docker, kubelet and warning
+journal entries, the docker containers and the etcd health, and a dump of the
+Kubernetes objects. The secrets and the values to encrypt are redacted. The file
+` + kluster.CollectManifestFilename + ` in the bundle lists what was collected and what failed.`,
+	RunE: collectClusterRun,
+}
+
+// collectClusterCmd represents the collect cluster command
+var collectClusterCmd = &cobra.Command{
+	Use:   "cluster NAME -o FILE",
+	Short: "collects the cluster diagnostics to a support bundle",
+	Long: `Collects the diagnostics of the cluster to a gzip compressed tar archive, the
+support bundle: from every node the KubeKit logs, the docker, kubelet and warning
+journal entries, the docker containers and the etcd health, and a dump of the
+Kubernetes objects. The secrets and the values to encrypt are redacted. The file
+` + kluster.CollectManifestFilename + ` in the bundle lists what was collected and what failed.`,
+	RunE: collectClusterRun,
+}
+
+func addCollectCmd() {
+	// collect [cluster] NAME --output FILE --parallel N
+	RootCmd.AddCommand(collectCmd)
+	collectCmd.PersistentFlags().StringP("output", "o", "", "support bundle file to create, by default it's the cluster name with the suffix '-bundle.tar.gz'")
+	cli.AddParallelFlag(collectCmd)
+	collectCmd.AddCommand(collectClusterCmd)
+	cli.AddParallelFlag(collectClusterCmd)
+}
+
+func collectClusterRun(cmd *cobra.Command, args []string) error {
+	opts, warns, err := cli.CollectGetOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	if len(warns) != 0 {
+		for _, w := range warns {
+			config.UI.Log.Warn(w)
+		}
+	}
+	parallel, err := cli.GetParallelFlag(cmd)
+	if err != nil {
+		return err
+	}
+
+	cluster, err := loadCluster(opts.ClusterName)
+	if err != nil {
+		return err
+	}
+	cluster.SetParallel(parallel)
+
+	manifest, err := cluster.Collect(opts.Output)
+	if err != nil {
+		return err
+	}
+
+	failed := manifest.Failed()
+	for _, item := range failed {
+		if len(item.Node) != 0 {
+			config.UI.Log.Warnf("[%s] failed to collect %s. %s", item.Node, item.Name, item.Error)
+			continue
+		}
+		config.UI.Log.Warnf("failed to collect %s. %s", item.Name, item.Error)
+	}
+
+	fmt.Printf("support bundle of cluster %q saved to %s, collected %d of %d items\n", opts.ClusterName, opts.Output, len(manifest.Items)-len(failed), len(manifest.Items))
+
+	return nil
+}
@@ -11,6 +11,8 @@ import (
 
 	"github.com/johandry/log"
 	"github.com/kraken/ui"
+	"github.com/liferaft/kubekit/pkg/crypto"
+	"github.com/liferaft/kubekit/pkg/kluster"
 	homedir "github.com/mitchellh/go-homedir"
 	toml "github.com/pelletier/go-toml"
 	"github.com/spf13/cobra"
@@ -62,6 +64,9 @@ type Config struct {
 	TemplatesPath  string `json:"templates_path" yaml:"templates_path" toml:"templates_path" mapstructure:"templates_path"`
 	PKIPath        string `json:"pki_path" yaml:"pki_path" toml:"pki_path" mapstructure:"pki_path"`
 
+	// Encryption at rest of the clusters Terraform state and credentials files
+	Encryption crypto.EncryptionConfig `json:"encryption" yaml:"encryption" toml:"encryption" mapstructure:"encryption"`
+
 	// Keep viper and command just in case a parameter is missing or to compare them
 	// Remove them when no needed anymore.
 	viper   *viper.Viper
@@ -172,6 +177,37 @@ func (c *Config) PKIDir() string {
 	return absDir(c.Dir(), c.PKIPath)
 }
 
+// EncryptionConfig returns the configuration of the encryption at rest with the
+// absolute path of the key file
+func (c *Config) EncryptionConfig() *crypto.EncryptionConfig {
+	encConfig := c.Encryption
+	if len(encConfig.KeyFile) != 0 {
+		if keyFile, err := homedir.Expand(encConfig.KeyFile); err == nil {
+			encConfig.KeyFile = keyFile
+		}
+		encConfig.KeyFile = absDir(c.Dir(), encConfig.KeyFile)
+	}
+	return &encConfig
+}
+
+// setupEncryption enables the encryption at rest of the clusters Terraform
+// state and credentials files if it's enabled in the configuration
+func (c *Config) setupEncryption() error {
+	encConfig := c.EncryptionConfig()
+	if !encConfig.Enabled {
+		kluster.SetEncryption(nil)
+		return nil
+	}
+
+	provider, err := crypto.NewKeyProvider(encConfig)
+	if err != nil {
+		return fmt.Errorf("failed to setup the encryption at rest. %s", err)
+	}
+	kluster.SetEncryption(crypto.NewEnvelope(provider))
+
+	return nil
+}
+
 func absDir(baseDir, path string) string {
 	if filepath.IsAbs(path) {
 		return path
@@ -257,6 +293,7 @@ func (c *Config) Text(pp bool) (string, error) {
 	fmt.Fprintf(&b, "Log Level:\t\t%s\n", c.LogLevel)
 	fmt.Fprintf(&b, "Log Force Colors:\t%t\n", c.LogForceColors)
 	fmt.Fprintf(&b, "Log File:\t\t%s\n", c.LogFile)
+	fmt.Fprintf(&b, "Encryption at Rest:\t%t\n", c.Encryption.Enabled)
 	fmt.Fprintf(&b, "Log Prefix:\t\t%s\n", c.UI.Log.GetPrefix())
 
 	if c.command.Flags().Lookup("debug").Value.String() == "true" {
@@ -298,7 +335,7 @@ func initConfigE() error {
 
 	config.UI = ui
 
-	return nil
+	return config.setupEncryption()
 }
 
 // LoadConfig loads the configuration file into a Viper object
@@ -384,6 +421,7 @@ func setDefaultsAndBindPFlags(v *viper.Viper) {
 	v.SetDefault("clusters_path", filepath.Join(kubekitHomeDir, defClustersDir))
 	v.SetDefault("templates_path", filepath.Join(kubekitHomeDir, defTemplatesDir))
 	v.SetDefault("pki_path", filepath.Join(kubekitHomeDir, defServerPKIDir))
+	v.SetDefault("encryption.key_file", filepath.Join(kubekitHomeDir, crypto.DefaultKeyFilename))
 }
 
 func setDefaultAndBindPFlag(v *viper.Viper, f *pflag.Flag, value interface{}) {
@@ -169,6 +169,7 @@ func addCopyCmd() {
 	copyFilesCmd.Flags().StringP("owner", "o", "", "name of the user that will own the target file")
 	copyFilesCmd.Flags().StringP("group", "g", "", "name of the group that will own the target file")
 	copyFilesCmd.Flags().StringP("mode", "m", "", "mode of the target file. Could be octal number or string like chmod format")
+	cli.AddParallelFlag(copyFilesCmd)
 
 	// copy certificates
 	copyCmd.AddCommand(copyCertificatesCmd)
@@ -291,12 +292,18 @@ func copyFilesRun(cmd *cobra.Command, args []string) error {
 	// }
 	// fmt.Printf("copy files %s --from %s --to %s --nodes %v --pools %v %s%s%s  --owner %s --group %s --mode %s\n", clusterName, from, to, nodes, pools, forceFlag, backupFlag, sudoFlag, owner, group, mode)
 
+	parallel, err := cli.GetParallelFlag(cmd)
+	if err != nil {
+		return err
+	}
+
 	// the cluster config file and the hosts must exists. This command should be
 	// executed after 'apply' or 'apply --provision'
 	cluster, err := loadCluster(clusterName)
 	if err != nil {
 		return err
 	}
+	cluster.SetParallel(parallel)
 
 	config.UI.Log.Infof("copying file to/from every host of cluster %s", clusterName)
 
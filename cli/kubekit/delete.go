@@ -68,17 +68,33 @@ include wildcards (*,?) or ranges (i.e. [1-3])`,
 	RunE: deleteFilesRun,
 }
 
+// deleteHostKeysCmd represents the 'delete hostkeys' command
+var deleteHostKeysCmd = &cobra.Command{
+	Use:     "hostkeys CLUSTER-NAME NODE[ NODE...]",
+	Aliases: []string{"hk"},
+	Short:   "Deletes the SSH host keys pinned for the given cluster nodes",
+	Long: `Deletes the SSH host keys pinned for the given nodes, identified by IP address
+or DNS name, or all the cluster nodes with '--all'. Use it when a node is replaced
+and has a new host key, the new key is pinned the next time KubeKit connects to
+the node.`,
+	RunE: deleteHostKeysRun,
+}
+
 func addDeleteCmd() {
-	// delete [cluster] NAME --force --all
+	// delete [cluster] NAME --force --all --plan --output (text|json|yaml) --plan-out FILE
 	RootCmd.AddCommand(deleteCmd)
 	deleteCmd.PersistentFlags().BoolVar(&deleteForce, "force", false, "do not confirm or ask to the user before delete the resource")
 
 	deleteCmd.Flags().Bool("all", false, "delete all the cluster resources such as configuration files, certificates and state")
 	deleteCmd.Flags().BoolVar(&doPlan, "plan", false, "don't delete the cluster, just print the changes to apply")
+	deleteCmd.Flags().StringP("output", "o", "text", "format of the plan printed with --plan. Available formats: 'text', 'json' and 'yaml'")
+	deleteCmd.Flags().String("plan-out", "", "save the plan printed with --plan to the given file, to apply it later with 'apply --plan-file'")
 
 	deleteCmd.AddCommand(deleteClusterCmd)
 	deleteClusterCmd.Flags().Bool("all", false, "delete all the cluster resources such as configuration files, certificates and state")
 	deleteClusterCmd.Flags().BoolVar(&doPlan, "plan", false, "don't delete the cluster, just print the changes to apply")
+	deleteClusterCmd.Flags().StringP("output", "o", "text", "format of the plan printed with --plan. Available formats: 'text', 'json' and 'yaml'")
+	deleteClusterCmd.Flags().String("plan-out", "", "save the plan printed with --plan to the given file, to apply it later with 'apply --plan-file'")
 
 	// delete clusters-config NAME --force
 	deleteCmd.AddCommand(deleteClustersConfigCmd)
@@ -90,6 +106,10 @@ func addDeleteCmd() {
 	deleteCmd.AddCommand(deleteFilesCmd)
 	deleteFilesCmd.Flags().StringSliceP("nodes", "n", nil, "list of nodes where to delete the files")
 	deleteFilesCmd.Flags().StringSliceP("pools", "p", nil, "list of node pools where in such nodes the files will be delete")
+
+	// delete hostkeys CLUSTER-NAME NODE[ NODE...] --all
+	deleteCmd.AddCommand(deleteHostKeysCmd)
+	deleteHostKeysCmd.Flags().Bool("all", false, "delete the host keys of all the cluster nodes")
 }
 
 func deleteClusterRun(cmd *cobra.Command, args []string) error {
@@ -123,7 +143,7 @@ func deleteClusterRun(cmd *cobra.Command, args []string) error {
 
 	// If so, that's all, print the plan and return
 	if doPlan {
-		return cluster.Plan(true)
+		return plan(cmd, cluster, true)
 	}
 
 	var errS error
@@ -192,6 +212,46 @@ func deleteFilesRun(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func deleteHostKeysRun(cmd *cobra.Command, args []string) error {
+	opts, warns, err := cli.HostKeysGetOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	if len(warns) != 0 {
+		for _, w := range warns {
+			config.UI.Log.Warn(w)
+		}
+	}
+
+	if !opts.All && len(opts.Nodes) == 0 {
+		return fmt.Errorf("requires at least one node, or use '--all' to delete the host keys of all the cluster nodes")
+	}
+
+	cluster, err := loadCluster(opts.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	if opts.All {
+		if !deleteForce && !cli.HardConfirmation(fmt.Sprintf("Do you want to delete the SSH host keys of all the nodes of cluster %q", opts.ClusterName), "yes") {
+			return nil
+		}
+		if err := cluster.DeleteAllHostKeys(); err != nil {
+			return err
+		}
+		fmt.Printf("the SSH host keys of all the nodes of cluster %q were deleted\n", opts.ClusterName)
+		return nil
+	}
+
+	deleted, err := cluster.DeleteHostKeys(opts.Nodes)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d SSH host keys of cluster %q were deleted\n", deleted, opts.ClusterName)
+
+	return nil
+}
+
 func deleteCerts(clusterName string, cluster *kluster.Kluster) (err error) {
 	if cluster == nil {
 		if cluster, err = loadCluster(clusterName); err != nil {
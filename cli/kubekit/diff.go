@@ -0,0 +1,99 @@
+package kubekit
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/liferaft/kubekit/cli"
+	"github.com/spf13/cobra"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// DriftExitCode is the exit code of the `diff` command when a drift is found
+const DriftExitCode = 2
+
+// diffCmd represents the diff command
+var diffCmd = &cobra.Command{
+	Use:   "diff [cluster] NAME",
+	Short: "finds the changes done to the cluster out of KubeKit",
+	Long: `Finds the differences, or drift, between what KubeKit knows about the cluster
+and the real cluster. The Terraform state is refreshed and compared with the saved
+state and with the nodes in the cluster configuration, then the Kubernetes nodes
+are compared with the expected nodes, pools, labels and taints. The saved state
+and configuration are not modified. The exit code is 2 when a drift is found.`,
+	RunE: diffClusterRun,
+}
+
+// diffClusterCmd represents the diff cluster command
+var diffClusterCmd = &cobra.Command{
+	Use:   "cluster NAME",
+	Short: "finds the changes done to the cluster out of KubeKit",
+	Long: `Finds the differences, or drift, between what KubeKit knows about the cluster
+and the real cluster. The Terraform state is refreshed and compared with the saved
+state and with the nodes in the cluster configuration, then the Kubernetes nodes
+are compared with the expected nodes, pools, labels and taints. The saved state
+and configuration are not modified. The exit code is 2 when a drift is found.`,
+	RunE: diffClusterRun,
+}
+
+func addDiffCmd() {
+	// diff [cluster] NAME --output (text|json|yaml) --pp
+	RootCmd.AddCommand(diffCmd)
+	diffCmd.PersistentFlags().StringP("output", "o", "text", "Output format. Available formats: 'text', 'json' and 'yaml'")
+	diffCmd.PersistentFlags().BoolP("pp", "p", false, "Pretty print. Show the drift in a human readable format. Applies only for 'json' format")
+	diffCmd.AddCommand(diffClusterCmd)
+}
+
+func diffClusterRun(cmd *cobra.Command, args []string) error {
+	opts, warns, err := cli.DiffGetOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	if len(warns) != 0 {
+		for _, w := range warns {
+			config.UI.Log.Warn(w)
+		}
+	}
+
+	cluster, err := loadCluster(opts.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	drift, err := cluster.Diff()
+	if err != nil {
+		return err
+	}
+
+	var output string
+	switch opts.Output {
+	case "", "text":
+		output = drift.String()
+	case "json":
+		var b []byte
+		if opts.Pp {
+			b, err = json.MarshalIndent(drift, "", "  ")
+		} else {
+			b, err = json.Marshal(drift)
+		}
+		output = string(b) + "\n"
+	case "yaml":
+		var b []byte
+		b, err = yaml.Marshal(drift)
+		output = string(b)
+	default:
+		return fmt.Errorf("unknown or unsupported format %q", opts.Output)
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(output)
+
+	if drift.Drifted() {
+		os.Exit(DriftExitCode)
+	}
+
+	return nil
+}
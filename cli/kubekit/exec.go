@@ -54,6 +54,7 @@ func addExecCmd() {
 	execCmd.Flags().BoolVar(&sudoExec, "sudo", false, "use sudo. The user needs to have sudo access")
 	execCmd.Flags().StringP("output", "o", "yaml", "Output format. Available formats: 'json', 'yaml' and 'toml'")
 	execCmd.Flags().Bool("pp", false, "Pretty print. Show the configuration in a human readable format. Applies only for 'json' format")
+	cli.AddParallelFlag(execCmd)
 
 	execCmd.AddCommand(execClusterCmd)
 	execClusterCmd.Flags().StringP("cmd", "c", "", "command to execute")
@@ -63,6 +64,7 @@ func addExecCmd() {
 	execClusterCmd.Flags().BoolVar(&sudoExec, "sudo", false, "use sudo. The user needs to have sudo access")
 	execClusterCmd.Flags().StringP("output", "o", "yaml", "Output format. Available formats: 'json', 'yaml' and 'toml'")
 	execClusterCmd.Flags().Bool("pp", false, "Pretty print. Show the configuration in a human readable format. Applies only for 'json' format")
+	cli.AddParallelFlag(execClusterCmd)
 
 	// exec package CLUSTER-NAME
 	execCmd.AddCommand(execPackageCmd)
@@ -106,6 +108,11 @@ func execClusterRun(cmd *cobra.Command, args []string) error {
 	output := cmd.Flags().Lookup("output").Value.String()
 	pp := cmd.Flags().Lookup("pp").Value.String() == "true"
 
+	parallel, err := cli.GetParallelFlag(cmd)
+	if err != nil {
+		return err
+	}
+
 	// DEBUG:
 	// var ppFlag, sudoFlag string
 	// if pp {
@@ -120,10 +127,12 @@ func execClusterRun(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	cluster.SetParallel(parallel)
 
-	result, err := cluster.Exec(command, script, nodes, pools, sudoExec)
-	if err != nil {
-		return err
+	// the result of every host is printed even if the command failed in some of them
+	result, errExec := cluster.Exec(command, script, nodes, pools, sudoExec)
+	if result == nil {
+		return errExec
 	}
 
 	var outputResult []byte
@@ -135,9 +144,12 @@ func execClusterRun(cmd *cobra.Command, args []string) error {
 	case "toml":
 		outputResult, err = result.TOML()
 	}
+	if err != nil {
+		return fmt.Errorf("failed to format the command result. %s", err)
+	}
 
 	fmt.Println(string(outputResult))
-	return nil
+	return errExec
 }
 
 func execPackageRun(cmd *cobra.Command, args []string) error {
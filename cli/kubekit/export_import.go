@@ -0,0 +1,147 @@
+package kubekit
+
+import (
+	"fmt"
+
+	"github.com/liferaft/kubekit/cli"
+	"github.com/liferaft/kubekit/pkg/crypto"
+	"github.com/liferaft/kubekit/pkg/kluster"
+	"github.com/spf13/cobra"
+)
+
+// exportCmd represents the export command
+var exportCmd = &cobra.Command{
+	Use:   "export [cluster] NAME -o FILE",
+	Short: "exports the cluster to an archive file",
+	Long: `Exports everything KubeKit knows about the cluster to a gzip compressed tar
+archive: the cluster configuration file, the state, certificates, credentials and
+registries. The credentials, state, private keys and kubeconfig files are
+encrypted in the archive with the key file given with '--key-file', or the key of
+the encryption at rest, or the key in the environment variable KUBEKIT_KEY. The
+default KubeKit key is not accepted. The etcd snapshots contain every Kubernetes
+secret, they are only exported, also encrypted, with the flag '--include-backups'.
+Use the 'import' command to import the cluster on another workstation.`,
+	RunE: exportClusterRun,
+}
+
+// exportClusterCmd represents the export cluster command
+var exportClusterCmd = &cobra.Command{
+	Use:   "cluster NAME -o FILE",
+	Short: "exports the cluster to an archive file",
+	Long: `Exports everything KubeKit knows about the cluster to a gzip compressed tar
+archive: the cluster configuration file, the state, certificates, credentials and
+registries. The credentials, state, private keys and kubeconfig files are
+encrypted in the archive with the key file given with '--key-file', or the key of
+the encryption at rest, or the key in the environment variable KUBEKIT_KEY. The
+default KubeKit key is not accepted. The etcd snapshots contain every Kubernetes
+secret, they are only exported, also encrypted, with the flag '--include-backups'.
+Use the 'import' command to import the cluster on another workstation.`,
+	RunE: exportClusterRun,
+}
+
+// importCmd represents the import command
+var importCmd = &cobra.Command{
+	Use:   "import [cluster] FILE",
+	Short: "imports a cluster from an archive file",
+	Long: `Imports a cluster from an archive file created with the 'export' command. The
+cluster is imported in a new directory and its name has to be unique. The
+archive secrets are decrypted with the key file given with '--key-file', or the
+key of the encryption at rest, or the key in the environment variable KUBEKIT_KEY.
+It has to be the same key used to export the cluster.`,
+	RunE: importClusterRun,
+}
+
+// importClusterCmd represents the import cluster command
+var importClusterCmd = &cobra.Command{
+	Use:   "cluster FILE",
+	Short: "imports a cluster from an archive file",
+	Long: `Imports a cluster from an archive file created with the 'export' command. The
+cluster is imported in a new directory and its name has to be unique. The
+archive secrets are decrypted with the key file given with '--key-file', or the
+key of the encryption at rest, or the key in the environment variable KUBEKIT_KEY.
+It has to be the same key used to export the cluster.`,
+	RunE: importClusterRun,
+}
+
+func addExportImportCmd() {
+	// export [cluster] NAME --output FILE
+	RootCmd.AddCommand(exportCmd)
+	exportCmd.PersistentFlags().StringP("output", "o", "", "archive file to create, by default it's the cluster name with extension '.tar.gz'")
+	exportCmd.PersistentFlags().Bool("include-backups", false, "export also the etcd snapshots, encrypted in the archive")
+	exportCmd.PersistentFlags().String("key-file", "", "key file to encrypt the secrets in the archive")
+	exportCmd.AddCommand(exportClusterCmd)
+
+	// import [cluster] FILE --key-file FILE
+	RootCmd.AddCommand(importCmd)
+	importCmd.PersistentFlags().String("key-file", "", "key file to decrypt the secrets in the archive")
+	importCmd.AddCommand(importClusterCmd)
+}
+
+func exportClusterRun(cmd *cobra.Command, args []string) error {
+	opts, warns, err := cli.ExportGetOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	if len(warns) != 0 {
+		for _, w := range warns {
+			config.UI.Log.Warn(w)
+		}
+	}
+
+	cluster, err := loadCluster(opts.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	envelope, err := archiveEnvelope(opts.KeyFile)
+	if err != nil {
+		return err
+	}
+
+	if err := cluster.Export(opts.Output, opts.IncludeBackups, envelope); err != nil {
+		return err
+	}
+
+	fmt.Printf("cluster %q exported to %s\n", opts.ClusterName, opts.Output)
+
+	return nil
+}
+
+func importClusterRun(cmd *cobra.Command, args []string) error {
+	opts, err := cli.ImportGetOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+
+	envelope, err := archiveEnvelope(opts.KeyFile)
+	if err != nil {
+		return err
+	}
+
+	cluster, err := kluster.Import(opts.Filename, config.ClustersDir(), envelope, config.UI)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("cluster %q imported to %s\n", cluster.Name, cluster.Dir())
+
+	return nil
+}
+
+// archiveEnvelope returns the envelope to encrypt and decrypt the secrets in a
+// cluster archive with the given key file. Without a key file, it's the
+// envelope of the encryption at rest or the key in KUBEKIT_KEY, which cannot be
+// the default passphrase
+func archiveEnvelope(keyFile string) (*crypto.Envelope, error) {
+	if len(keyFile) != 0 {
+		return crypto.NewEnvelope(crypto.NewFileKeyProvider(keyFile)), nil
+	}
+	if envelope := kluster.Encryption(); envelope != nil {
+		return envelope, nil
+	}
+	provider, err := crypto.NewPassphraseKeyProvider()
+	if err != nil {
+		return nil, fmt.Errorf("a key is required for the secrets in the cluster archive, use the flag '--key-file' or enable the encryption at rest. %s", err)
+	}
+	return crypto.NewEnvelope(provider), nil
+}
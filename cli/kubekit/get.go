@@ -2,6 +2,7 @@ package kubekit
 
 import (
 	"fmt"
+	"io/ioutil"
 	"path/filepath"
 
 	"github.com/liferaft/kubekit/cli"
@@ -55,6 +56,56 @@ absent, prints the nodes in the configuration.`,
 	RunE: getNodesRun,
 }
 
+// getHostKeysCmd represents the 'get hostkeys' command
+var getHostKeysCmd = &cobra.Command{
+	Use:     "hostkeys CLUSTER-NAME [NODE[ NODE...]]",
+	Aliases: []string{"hk"},
+	Short:   "Prints the SSH host keys pinned for the nodes of the given cluster",
+	Long: `Prints the SSH host keys pinned for the given nodes, or all the cluster nodes.
+The nodes are identified by IP address or DNS name. The host key of a node is
+pinned the first time KubeKit connects to it, or when the cluster is provisioned
+if the platform exposes it, and the later connections are rejected if the node
+has a different host key.`,
+	RunE: getHostKeysRun,
+}
+
+// getCertificatesCmd represents the 'get certificates' command
+var getCertificatesCmd = &cobra.Command{
+	Use:     "certificates CLUSTER-NAME",
+	Aliases: []string{"certs"},
+	Short:   "Prints the certificates of the given cluster and when they expire",
+	Long: `Prints every CA and signed certificate of the given cluster with the subject,
+SANs and expiration date. By default the certificates are read from the cluster
+certificates directory, use '--deployed' to read the certificates deployed on
+the nodes.`,
+	RunE: getCertificatesRun,
+}
+
+// getKubeconfigCmd represents the 'get kubeconfig' command
+var getKubeconfigCmd = &cobra.Command{
+	Use:     "kubeconfig CLUSTER-NAME",
+	Aliases: []string{"kc"},
+	Short:   "Prints a KubeConfig file for a user of the given cluster",
+	Long: `Prints a KubeConfig file for the given user and groups with a short-lived
+client certificate signed by the cluster CA, so every user has its own
+credentials instead of the admin KubeConfig. The certificate cannot be revoked,
+so use a short TTL, up to 7 days. With '--oidc' the KubeConfig authenticates the
+user with the OIDC issuer of the cluster configuration using the kubectl plugin
+'oidc-login', verifying the issuer with the 'oidc_ca_file' CA certificate if set.`,
+	RunE: getKubeconfigRun,
+}
+
+// getStateHistoryCmd represents the 'get state-history' command
+var getStateHistoryCmd = &cobra.Command{
+	Use:     "state-history CLUSTER-NAME",
+	Aliases: []string{"sh"},
+	Short:   "Prints the versions of the state history of the given cluster",
+	Long: `Prints the versions of the Terraform state kept in the state backend history of
+the given cluster, the newest version first. Restore a version with the command
+'kubekit restore state'. The HTTP state backend does not keep a history.`,
+	RunE: getStateHistoryRun,
+}
+
 // getTemplatesCmd represents the 'get templates' command
 var getTemplatesCmd = &cobra.Command{
 	Hidden:  true,
@@ -103,6 +154,27 @@ func addGetCmd() {
 	// getFilesCmd.Flags().StringSliceP("pools", "p", nil, "list of node pools where in such nodes locate the files")
 	// getFilesCmd.Flags().StringSlice("path", nil, "path in the selected nodes where to find the given filenames")
 
+	// [get] hostkeys CLUSTER-NAME [NODE[ NODE...]] --output (json|yaml) --pp
+	getCmd.AddCommand(getHostKeysCmd)
+
+	// [get] certificates CLUSTER-NAME --output (wide|json|yaml) --pp --deployed --nodes NODE[,NODE] --pools POOL[,POOL]
+	getCmd.AddCommand(getCertificatesCmd)
+	getCertificatesCmd.Flags().Bool("deployed", false, "read the certificates deployed on the nodes instead of the local files")
+	getCertificatesCmd.Flags().StringSliceP("nodes", "n", nil, "list of nodes to read the deployed certificates from")
+	getCertificatesCmd.Flags().StringSliceP("pools", "p", nil, "list of node pools to read the deployed certificates from the nodes in there")
+	cli.AddParallelFlag(getCertificatesCmd)
+
+	// [get] kubeconfig CLUSTER-NAME --user NAME --group GROUP[,GROUP] --ttl DURATION --oidc --file FILE
+	getCmd.AddCommand(getKubeconfigCmd)
+	getKubeconfigCmd.Flags().String("user", "", "user name of the client certificate")
+	getKubeconfigCmd.Flags().StringSlice("group", nil, "list of groups of the user in the client certificate")
+	getKubeconfigCmd.Flags().Duration("ttl", cli.DefaultKubeconfigTTL, "time the client certificate is valid, i.e. 8h or 30m, up to 168h")
+	getKubeconfigCmd.Flags().Bool("oidc", false, "authenticate the user with the OIDC issuer of the cluster instead of a client certificate")
+	getKubeconfigCmd.Flags().String("file", "", "save the KubeConfig to this file instead of print it")
+
+	// [get] state-history CLUSTER-NAME --output (wide|json|yaml) --pp
+	getCmd.AddCommand(getStateHistoryCmd)
+
 	// [get] templates NAME[,NAME...] --output (wide|json|yaml|toml) --pp
 	// RootCmd.AddCommand(getTemplatesCmd)
 	getCmd.AddCommand(getTemplatesCmd)
@@ -195,6 +267,153 @@ func getNodesRun(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func getHostKeysRun(cmd *cobra.Command, args []string) error {
+	opts, warns, err := cli.HostKeysGetOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	if len(warns) != 0 {
+		for _, w := range warns {
+			config.UI.Log.Warn(w)
+		}
+	}
+
+	cluster, err := loadCluster(opts.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	keys, err := cluster.HostKeys(opts.Nodes)
+	if err != nil {
+		return err
+	}
+
+	output, err := cli.HostKeysInfo(keys).Sprintf(opts.Output, opts.Pp)
+	if err != nil {
+		return err
+	}
+	if len(output) != 0 {
+		fmt.Println(output)
+	}
+
+	return nil
+}
+
+func getStateHistoryRun(cmd *cobra.Command, args []string) error {
+	opts, warns, err := cli.StateHistoryGetOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	if len(warns) != 0 {
+		for _, w := range warns {
+			config.UI.Log.Warn(w)
+		}
+	}
+
+	cluster, err := loadCluster(opts.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	versions, err := cluster.StateHistory()
+	if err != nil {
+		return err
+	}
+
+	output, err := cli.StateHistoryInfo(versions).Sprintf(opts.Output, opts.Pp)
+	if err != nil {
+		return err
+	}
+	if len(output) != 0 {
+		fmt.Println(output)
+	}
+
+	return nil
+}
+
+func getCertificatesRun(cmd *cobra.Command, args []string) error {
+	opts, warns, err := cli.GetCertificatesGetOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	if len(warns) != 0 {
+		for _, w := range warns {
+			config.UI.Log.Warn(w)
+		}
+	}
+	parallel, err := cli.GetParallelFlag(cmd)
+	if err != nil {
+		return err
+	}
+
+	cluster, err := loadCluster(opts.ClusterName)
+	if err != nil {
+		return err
+	}
+	cluster.SetParallel(parallel)
+
+	var certs []kluster.CertificateInfo
+	if opts.Deployed {
+		certs, err = cluster.DeployedCertificates(opts.Nodes, opts.Pools)
+	} else {
+		certs, err = cluster.Certificates()
+	}
+	if err != nil {
+		return err
+	}
+
+	output, err := cli.CertificatesInfo(certs).Sprintf(opts.Output, opts.Pp)
+	if err != nil {
+		return err
+	}
+	if len(output) != 0 {
+		fmt.Println(output)
+	}
+
+	return nil
+}
+
+func getKubeconfigRun(cmd *cobra.Command, args []string) error {
+	opts, warns, err := cli.GetKubeconfigGetOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	if len(warns) != 0 {
+		for _, w := range warns {
+			config.UI.Log.Warn(w)
+		}
+	}
+
+	cluster, err := loadCluster(opts.ClusterName)
+	if err != nil {
+		return err
+	}
+	if err := cluster.LoadState(); err != nil {
+		return err
+	}
+
+	var content []byte
+	if opts.OIDC {
+		content, err = cluster.GenerateOIDCKubeConfig()
+	} else {
+		content, err = cluster.GenerateUserKubeConfig(opts.User, opts.Groups, opts.TTL)
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(opts.File) == 0 {
+		fmt.Print(string(content))
+		return nil
+	}
+	if err := ioutil.WriteFile(opts.File, content, 0600); err != nil {
+		return fmt.Errorf("failed to save the KubeConfig to %s. %s", opts.File, err)
+	}
+	config.UI.Log.Infof("KubeConfig saved to %s", opts.File)
+
+	return nil
+}
+
 func getEnvRun(cmd *cobra.Command, args []string) error {
 	opts, warns, err := cli.GetEnvGetOpts(cmd, args)
 	if err != nil {
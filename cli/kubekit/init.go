@@ -128,6 +128,9 @@ func addInitCmd() {
 	// init certificates CLUSTER-NAME --CERT-key-file FILE --CERT-cert-file FILE --update
 	initCmd.AddCommand(initCertificatesCmd)
 	addCertFlags(initCertificatesCmd)
+	initCertificatesCmd.Flags().String("key-algorithm", "", "algorithm of the private keys. Available algorithms: 'rsa-2048', 'rsa-3072', 'rsa-4096', 'ecdsa-p256' and 'ecdsa-p384' (default \""+tls.DefaultKeyAlgorithm+"\")")
+	initCertificatesCmd.Flags().Int("validity-days", 0, fmt.Sprintf("number of days the certificates are valid (default %d)", tls.Duration))
+	initCertificatesCmd.Flags().String("signature-algorithm", "", "algorithm to sign the certificates, such as SHA256-RSA, SHA384-RSAPSS or ECDSA-SHA256 (default is the one for the CA key algorithm)")
 	// initCertificatesCmd.Flags().BoolP("update", "u", false, "allows to update the existing certificate files")
 
 	// init package CLUSTER-NAME --update
@@ -295,9 +298,30 @@ func initCertificatesRun(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	cluster, err := loadCluster(clusterName)
+	if err != nil {
+		return err
+	}
+
+	// The key options are saved in the cluster configuration to generate the
+	// same kind of certificates when the cluster is applied or the
+	// certificates rotated
+	changed, err := cli.SetKeyOptionsFlags(cmd, cluster.Config)
+	if err != nil {
+		return err
+	}
+	if changed {
+		if _, err := cluster.KeyOptions(); err != nil {
+			return err
+		}
+		if err := cluster.Save(); err != nil {
+			return err
+		}
+	}
+
 	forceGenerateCA := false
 	//forceGenerateCA := cmd.Flags().Lookup("generate-ca-certs").Value.String() == "true"
-	return initCertificates(clusterName, nil, forceGenerateCA, userCACertsFiles)
+	return initCertificates(clusterName, cluster, forceGenerateCA, userCACertsFiles)
 }
 
 func initPackageRun(cmd *cobra.Command, args []string) error {
@@ -0,0 +1,81 @@
+package kubekit
+
+import (
+	"fmt"
+
+	"github.com/liferaft/kubekit/cli"
+	"github.com/liferaft/kubekit/pkg/kluster"
+	"github.com/spf13/cobra"
+)
+
+// migrateCmd represents the migrate command
+var migrateCmd = &cobra.Command{
+	Use:   "migrate [cluster] NAME",
+	Short: "migrates the cluster configuration file to the current version",
+	Long: `Migrates the cluster configuration file of a previous version to the version
+supported by this KubeKit, showing the differences between the original and the
+migrated file. The original file is saved in the 'backups' directory of the
+cluster before it's rewritten. Obsolete credentials in the configuration file are
+moved to the credentials file. Use '--dry-run' to only show the differences.`,
+	RunE: migrateClusterRun,
+}
+
+// migrateClusterCmd represents the migrate cluster command
+var migrateClusterCmd = &cobra.Command{
+	Use:   "cluster NAME",
+	Short: "migrates the cluster configuration file to the current version",
+	Long: `Migrates the cluster configuration file of a previous version to the version
+supported by this KubeKit, showing the differences between the original and the
+migrated file. The original file is saved in the 'backups' directory of the
+cluster before it's rewritten. Obsolete credentials in the configuration file are
+moved to the credentials file. Use '--dry-run' to only show the differences.`,
+	RunE: migrateClusterRun,
+}
+
+func addMigrateCmd() {
+	// migrate [cluster] NAME --dry-run
+	RootCmd.AddCommand(migrateCmd)
+	migrateCmd.PersistentFlags().Bool("dry-run", false, "only show the differences, do not modify the cluster configuration file")
+	migrateCmd.AddCommand(migrateClusterCmd)
+}
+
+func migrateClusterRun(cmd *cobra.Command, args []string) error {
+	opts, warns, err := cli.MigrateGetOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	if len(warns) != 0 {
+		for _, w := range warns {
+			config.UI.Log.Warn(w)
+		}
+	}
+
+	klusterFile := kluster.Path(opts.ClusterName, config.ClustersDir())
+	if len(klusterFile) == 0 {
+		return fmt.Errorf("failed to find the cluster named %q", opts.ClusterName)
+	}
+
+	result, err := kluster.Migrate(klusterFile, opts.DryRun, config.UI)
+	if err != nil {
+		return err
+	}
+
+	if len(result.Migrations) == 0 {
+		fmt.Printf("the configuration of cluster %q is in version %s, there is nothing to migrate\n", opts.ClusterName, result.From)
+		return nil
+	}
+
+	for _, m := range result.Migrations {
+		fmt.Printf("%s -> %s: %s\n", m.From, m.To, m.Description)
+	}
+	fmt.Println()
+	fmt.Print(result.Diff)
+
+	if opts.DryRun {
+		return nil
+	}
+
+	fmt.Printf("\nthe configuration of cluster %q was migrated from version %s to %s, the original file was saved to %s\n", opts.ClusterName, result.From, result.To, result.Backup)
+
+	return nil
+}
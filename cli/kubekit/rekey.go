@@ -0,0 +1,139 @@
+package kubekit
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/liferaft/kubekit/cli"
+	"github.com/liferaft/kubekit/pkg/crypto"
+	"github.com/liferaft/kubekit/pkg/kluster"
+	"github.com/spf13/cobra"
+)
+
+// rekeyCmd represents the rekey command
+var rekeyCmd = &cobra.Command{
+	Use:   "rekey [NAME[ NAME ...]]",
+	Short: "re-encrypts the clusters state and credentials files",
+	Long: `Re-encrypts the Terraform state and credentials files of the given clusters, or
+all the clusters if no cluster is given, with the encryption at rest key in the
+KubeKit configuration. The files that are not encrypted, created before the
+encryption was enabled, are encrypted.
+
+Use '--generate-key' to generate a new key file, the current key file is renamed
+and used to decrypt. Use '--old-key-file' to decrypt with the previous key file,
+if it was replaced or the key provider changed. Use '--decrypt' to decrypt the
+files before disabling the encryption at rest.`,
+	RunE: rekeyRun,
+}
+
+func addRekeyCmd() {
+	// rekey [NAME[ NAME ...]] --generate-key --old-key-file FILE --decrypt
+	RootCmd.AddCommand(rekeyCmd)
+	rekeyCmd.Flags().Bool("generate-key", false, "generate a new key file and re-encrypt all the clusters with it. Only for the 'file' key provider")
+	rekeyCmd.Flags().String("old-key-file", "", "key file previously used to encrypt the files")
+	rekeyCmd.Flags().Bool("decrypt", false, "decrypt the files, use it to disable the encryption at rest")
+}
+
+func rekeyRun(cmd *cobra.Command, args []string) error {
+	opts, warns, err := cli.RekeyGetOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	if len(warns) != 0 {
+		for _, w := range warns {
+			config.UI.Log.Warn(w)
+		}
+	}
+
+	encConfig := config.EncryptionConfig()
+	if !encConfig.Enabled && !opts.Decrypt {
+		return fmt.Errorf("the encryption at rest is not enabled in the KubeKit configuration, use '--decrypt' to decrypt the files encrypted before it was disabled")
+	}
+
+	previous := []crypto.KeyProvider{}
+	if len(opts.OldKeyFile) != 0 {
+		previous = append(previous, crypto.NewFileKeyProvider(absDir(config.Dir(), opts.OldKeyFile)))
+	}
+
+	var current crypto.KeyProvider
+	if encConfig.Enabled {
+		if current, err = crypto.NewKeyProvider(encConfig); err != nil {
+			return fmt.Errorf("failed to setup the encryption at rest. %s", err)
+		}
+	}
+
+	if opts.GenerateKey {
+		if current.Name() != crypto.FileKeyProvider {
+			return fmt.Errorf("a new key can only be generated for the %s key provider, the key provider is %s", crypto.FileKeyProvider, current.Name())
+		}
+		keyFile := encConfig.KeyFile
+		if _, err := os.Stat(keyFile); err == nil {
+			oldKeyFile := keyFile + "." + time.Now().UTC().Format("20060102T150405Z")
+			if err := os.Rename(keyFile, oldKeyFile); err != nil {
+				return fmt.Errorf("failed to rename the key file %s. %s", keyFile, err)
+			}
+			config.UI.Log.Infof("the key file %s was renamed to %s", keyFile, oldKeyFile)
+			previous = append(previous, crypto.NewFileKeyProvider(oldKeyFile))
+		}
+		if err := crypto.GenerateKeyFile(keyFile); err != nil {
+			return err
+		}
+		config.UI.Log.Infof("new key generated in the key file %s", keyFile)
+		current = crypto.NewFileKeyProvider(keyFile)
+	}
+
+	// decrypt with the current key or any of the previous keys
+	var from *crypto.Envelope
+	switch {
+	case current != nil:
+		from = crypto.NewEnvelope(current, previous...)
+	case len(previous) != 0:
+		from = crypto.NewEnvelope(previous[0], previous[1:]...)
+	}
+
+	var to *crypto.Envelope
+	if !opts.Decrypt {
+		to = crypto.NewEnvelope(current)
+	}
+	// the clusters are loaded with the credentials encrypted with any of the keys
+	kluster.SetEncryption(from)
+
+	clustersName := opts.ClustersName
+	if len(clustersName) == 0 {
+		if clustersName, err = kluster.ListNames(config.ClustersDir()); err != nil {
+			return err
+		}
+	}
+
+	failed := []string{}
+	for _, clusterName := range clustersName {
+		cluster, err := kluster.LoadCluster(clusterName, config.ClustersDir(), config.UI)
+		if err != nil {
+			config.UI.Log.Error(err.Error())
+			failed = append(failed, clusterName)
+			continue
+		}
+		files, err := cluster.Rekey(from, to)
+		for _, f := range files {
+			config.UI.Log.Debugf("re-encrypted %s", f)
+		}
+		if err != nil {
+			config.UI.Log.Error(err.Error())
+			failed = append(failed, clusterName)
+			continue
+		}
+		if opts.Decrypt {
+			fmt.Printf("%d files of cluster %q were decrypted\n", len(files), clusterName)
+		} else {
+			fmt.Printf("%d files of cluster %q were re-encrypted\n", len(files), clusterName)
+		}
+	}
+
+	if len(failed) != 0 {
+		return fmt.Errorf("failed to re-encrypt the clusters: %s", strings.Join(failed, ", "))
+	}
+
+	return nil
+}
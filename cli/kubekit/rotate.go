@@ -0,0 +1,77 @@
+package kubekit
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/liferaft/kubekit/cli"
+	"github.com/spf13/cobra"
+)
+
+// rotateCmd represents the rotate command
+var rotateCmd = &cobra.Command{
+	Use:   "rotate",
+	Short: "Rotate the credentials of a given cluster",
+	Long: `The rotate command is used to replace the credentials of a cluster, such as the
+certificates, with new ones.`,
+}
+
+// rotateCertificatesCmd represents the 'rotate certificates' command
+var rotateCertificatesCmd = &cobra.Command{
+	Use:     "certificates CLUSTER-NAME",
+	Aliases: []string{"certs"},
+	Short:   "Regenerates the certificates of the given cluster",
+	Long: `Regenerates the certificates of the given cluster, or only the certificates
+given with '--only', signed by the current CA certificates. The certificates are
+uploaded to the nodes and the services using them are restarted one node at a
+time, the masters first, waiting for every node to be ready.
+
+The CA certificates are not rotated: the pods trust the CA certificate in their
+service account 'ca.crt' and would fail to connect to the API server if the
+certificates were signed by a new CA.`,
+	RunE: rotateCertificatesRun,
+}
+
+func addRotateCmd() {
+	RootCmd.AddCommand(rotateCmd)
+
+	// rotate certificates CLUSTER-NAME --only CERT[,CERT...] --parallel N
+	rotateCmd.AddCommand(rotateCertificatesCmd)
+	rotateCertificatesCmd.Flags().StringSlice("only", nil, "list of certificates to rotate, such as 'kubelet,apiserver'. By default all the certificates are rotated")
+	cli.AddParallelFlag(rotateCertificatesCmd)
+}
+
+func rotateCertificatesRun(cmd *cobra.Command, args []string) error {
+	opts, warns, err := cli.RotateCertificatesGetOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	if len(warns) != 0 {
+		for _, w := range warns {
+			config.UI.Log.Warn(w)
+		}
+	}
+	parallel, err := cli.GetParallelFlag(cmd)
+	if err != nil {
+		return err
+	}
+
+	cluster, err := loadCluster(opts.ClusterName)
+	if err != nil {
+		return err
+	}
+	cluster.SetParallel(parallel)
+
+	if err := cluster.RotateCertificates(opts.Only); err != nil {
+		return err
+	}
+
+	switch {
+	case len(opts.Only) != 0:
+		fmt.Printf("the certificates %s of cluster %q were rotated\n", strings.Join(opts.Only, ", "), opts.ClusterName)
+	default:
+		fmt.Printf("the certificates of cluster %q were rotated\n", opts.ClusterName)
+	}
+
+	return nil
+}
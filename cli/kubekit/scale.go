@@ -3,39 +3,159 @@ package kubekit
 import (
 	"fmt"
 
+	"github.com/liferaft/kubekit/cli"
+	"github.com/liferaft/kubekit/pkg/kluster"
 	"github.com/spf13/cobra"
 )
 
 // scaleCmd represents the scale command
 var scaleCmd = &cobra.Command{
-	Hidden: true,
-	Use:    "scale [cluster] NAME POOL-NAME=[+|-]NUMBER",
-	Short:  "scales up or down a cluster",
+	Use:   "scale [cluster] NAME POOL-NAME=[+|-]NUMBER",
+	Short: "scales up or down a cluster",
 	Long: `Scales by increasing or decreasing the number of nodes in a cluster from a given
 pool. The number could be possitive (to add), negative (to remove) or unsigned
-number (assign).`,
-	RunE: func(cmd *cobra.Command, args []string) error {
-		fmt.Println("[ERROR] command 'scale' still not implemented")
-		return nil
-	},
+number (assign).
+
+On the platforms where KubeKit does not create the nodes (raw and stacki) the
+addresses of the new nodes are required with the flag '--addresses'. When the
+pool is scaled down on these platforms, the nodes to remove are also required
+with '--addresses'. On the other platforms the last nodes of the pool are
+removed.`,
+	RunE: scaleClusterRun,
 }
 
 // scaleClusterCmd represents the cluster command
 var scaleClusterCmd = &cobra.Command{
-	Hidden: true,
-	Use:    "cluster NAME POOL-NAME=[+|-]NUMBER",
-	Short:  "scales up or down a cluster",
+	Use:   "cluster NAME POOL-NAME=[+|-]NUMBER",
+	Short: "scales up or down a cluster",
 	Long: `Scales by increasing or decreasing the number of nodes in a cluster from a given
 pool. The number could be possitive (to add), negative (to remove) or unsigned
-number (assign).`,
-	RunE: func(cmd *cobra.Command, args []string) error {
-		fmt.Println("[ERROR] command 'scale cluster' still not implemented")
-		return nil
-	},
+number (assign).
+
+On the platforms where KubeKit does not create the nodes (raw and stacki) the
+addresses of the new nodes are required with the flag '--addresses'. When the
+pool is scaled down on these platforms, the nodes to remove are also required
+with '--addresses'. On the other platforms the last nodes of the pool are
+removed.`,
+	RunE: scaleClusterRun,
 }
 
 func addScaleCmd() {
-	// scale [cluster] NAME POOL-NAME=[+|-]N
+	// scale [cluster] NAME POOL-NAME=[+|-]N --addresses IP[:IP[:DNS[:DNS]]] --force
 	RootCmd.AddCommand(scaleCmd)
+	scaleCmd.PersistentFlags().StringSlice("addresses", nil, "list of node addresses to add or remove in the form PUBLIC_IP[:PRIVATE_IP[:PUBLIC_DNS[:PRIVATE_DNS]]], only for raw and stacki")
+	scaleCmd.PersistentFlags().Bool("force", false, "do not confirm or ask to the user before remove nodes")
+	addCertFlags(scaleCmd)
+	cli.AddParallelFlag(scaleCmd)
+
 	scaleCmd.AddCommand(scaleClusterCmd)
+	addCertFlags(scaleClusterCmd)
+	cli.AddParallelFlag(scaleClusterCmd)
+}
+
+func scaleClusterRun(cmd *cobra.Command, args []string) error {
+	opts, warns, err := cli.ScaleGetOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	if len(warns) != 0 {
+		for _, w := range warns {
+			config.UI.Log.Warn(w)
+		}
+	}
+
+	// the cluster config file must exists. This command should be executed after 'apply' otherwise will fail
+	cluster, err := loadCluster(opts.ClusterName)
+	if err != nil {
+		return err
+	}
+	cluster.SetParallel(opts.Parallel)
+	if err := cluster.ValidateScaleNodes(opts.Nodes); err != nil {
+		return err
+	}
+
+	current, err := cluster.PoolSize(opts.Pool)
+	if err != nil {
+		return err
+	}
+	size, err := opts.NewSize(current)
+	if err != nil {
+		return err
+	}
+	if size == current {
+		fmt.Printf("the pool %q of cluster %q already has %d nodes\n", opts.Pool, opts.ClusterName, size)
+		return nil
+	}
+
+	if ok := opts.Confirm(current, size); !ok {
+		fmt.Printf("cluster %q was not scaled\n", opts.ClusterName)
+		return nil
+	}
+
+	// the SSH keys are required by the provisioner to create the new nodes
+	if err := cluster.HandleKeys(); err != nil {
+		return err
+	}
+
+	newHosts, errP := cluster.Scale(opts.Pool, size, opts.Nodes)
+	errS := cluster.Save()
+	if errP != nil && errS != nil {
+		return fmt.Errorf("failed to scale the cluster and to save the cluster configuration file.\n%s\n%s", errP, errS)
+	}
+	if errP != nil {
+		return errP
+	}
+	if errS != nil {
+		return errS
+	}
+
+	if len(newHosts) == 0 {
+		return nil
+	}
+
+	platform := cluster.Platform()
+	if platform == "eks" || platform == "aks" {
+		return nil
+	}
+
+	// The new nodes require their own certificates, the CA certificates are the
+	// existing ones unless new ones are given
+	userCACertsFiles, err := cli.GetCertFlags(cmd)
+	if err != nil {
+		return err
+	}
+	if err := initCertificates(opts.ClusterName, cluster, false, userCACertsFiles); err != nil {
+		return err
+	}
+	if err := cluster.LoadState(); err != nil {
+		return err
+	}
+	if err := cluster.CreateKubeConfigFile(); err != nil {
+		return err
+	}
+
+	// A new master changes the API server certificates and the etcd cluster, so
+	// the entire cluster has to be configured again
+	if len(newHosts.FilterByRolePrefix("master")) != 0 {
+		return configure(cluster)
+	}
+
+	addresses := make([]string, 0, len(newHosts))
+	for _, h := range newHosts {
+		addresses = append(addresses, h.PublicIP)
+	}
+
+	return configureNodes(cluster, addresses...)
+}
+
+func configureNodes(cluster *kluster.Kluster, nodes ...string) error {
+	errC := cluster.ConfigureNodes(nodes...)
+	errS := cluster.Save()
+	if errC != nil && errS != nil {
+		return fmt.Errorf("failed to configure Kubernetes on the nodes and to save the cluster configuration file.\n%s\n%s", errC, errS)
+	}
+	if errC != nil {
+		return errC
+	}
+	return errS
 }
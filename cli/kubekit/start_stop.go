@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/liferaft/kubekit/cli"
 	"github.com/liferaft/kubekit/pkg/server"
 	"github.com/liferaft/kubekit/pkg/service"
 	homedir "github.com/mitchellh/go-homedir"
@@ -15,80 +16,56 @@ import (
 
 // startCmd represents the start command
 var startCmd = &cobra.Command{
-	Hidden: true,
-	Use:    "start [cluster] NAME[,NAME ...]",
-	Short:  "Starts a cluster or nodes",
+	Use:   "start [cluster] NAME[,NAME ...]",
+	Short: "Starts a cluster or nodes",
 	Long: `Starts a cluster, a single or multiple nodes of a cluster filtered by node name,
 IP, DNS or by the pool name.`,
-	RunE: func(cmd *cobra.Command, args []string) error {
-		fmt.Println("[ERROR] command 'start' still not implemented")
-		return nil
-	},
+	RunE: startClusterRun,
 }
 
 // stopCmd represents the stop command
 var stopCmd = &cobra.Command{
-	Hidden: true,
-	Use:    "stop [cluster] NAME[,NAME ...]",
-	Short:  "Stop a cluster or nodes",
+	Use:   "stop [cluster] NAME[,NAME ...]",
+	Short: "Stop a cluster or nodes",
 	Long: `Stops a cluster, a single or multiple nodes of a cluster filtered by node name,
 IP, DNS or by the pool name.`,
-	RunE: func(cmd *cobra.Command, args []string) error {
-		fmt.Println("[ERROR] command 'stop' still not implemented")
-		return nil
-	},
+	RunE: stopClusterRun,
 }
 
 // restartCmd represents the restart command
 var restartCmd = &cobra.Command{
-	Hidden: true,
-	Use:    "restart [cluster] NAME[,NAME ...]",
-	Short:  "Restart a cluster or nodes",
+	Use:   "restart [cluster] NAME[,NAME ...]",
+	Short: "Restart a cluster or nodes",
 	Long: `Restarts a cluster, a single or multiple nodes of a cluster filtered by node
 name, IP, DNS or by the pool name.`,
-	RunE: func(cmd *cobra.Command, args []string) error {
-		fmt.Println("[ERROR] command 'restart' still not implemented")
-		return nil
-	},
+	RunE: restartClusterRun,
 }
 
 // startClusterCmd represents the start custer command
 var startClusterCmd = &cobra.Command{
-	Hidden: true,
-	Use:    "cluster NAME[,NAME ...]",
-	Short:  "Starts a cluster or nodes",
+	Use:   "cluster NAME[,NAME ...]",
+	Short: "Starts a cluster or nodes",
 	Long: `Starts a cluster, a single or multiple nodes of a cluster filtered by node name,
 IP, DNS or by the pool name.`,
-	RunE: func(cmd *cobra.Command, args []string) error {
-		fmt.Println("[ERROR] command 'start cluster' still not implemented")
-		return nil
-	},
+	RunE: startClusterRun,
 }
 
 // stopClusterCmd represents the stop cluster command
 var stopClusterCmd = &cobra.Command{
-	Hidden: true,
-	Use:    "cluster NAME[,NAME ...]",
-	Short:  "Stop a cluster or nodes",
+	Use:   "cluster NAME[,NAME ...]",
+	Short: "Stop a cluster or nodes",
 	Long: `Stops a cluster, a single or multiple nodes of a cluster filtered by node name,
 IP, DNS or by the pool name.`,
-	RunE: func(cmd *cobra.Command, args []string) error {
-		fmt.Println("[ERROR] command 'stop cluster' still not implemented")
-		return nil
-	},
+	RunE: stopClusterRun,
 }
 
 // restartCmd represents the restart cluster command
 var restartClusterCmd = &cobra.Command{
-	Hidden: true,
-	Use:    "cluster NAME[,NAME ...]",
-	Short:  "Restart a cluster or nodes",
+	Use:   "cluster NAME[,NAME ...]",
+	Short: "Restart a cluster or nodes",
 	Long: `Restarts a cluster, a single or multiple nodes of a cluster filtered by node
 name, IP, DNS or by the pool name.`,
-	RunE: func(cmd *cobra.Command, args []string) error {
-		fmt.Println("[ERROR] command 'restart cluster' still not implemented")
-		return nil
-	},
+	RunE: restartClusterRun,
 }
 
 // startServerCmd represents the start server command
@@ -174,6 +151,56 @@ func addStartStopCmd() {
 	restartCmd.AddCommand(restartServerCmd)
 }
 
+func startClusterRun(cmd *cobra.Command, args []string) error {
+	return startStopClusterRun(cmd, args, "start")
+}
+
+func stopClusterRun(cmd *cobra.Command, args []string) error {
+	return startStopClusterRun(cmd, args, "stop")
+}
+
+func restartClusterRun(cmd *cobra.Command, args []string) error {
+	return startStopClusterRun(cmd, args, "restart")
+}
+
+func startStopClusterRun(cmd *cobra.Command, args []string, action string) error {
+	opts, warns, err := cli.StartStopGetOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	if len(warns) != 0 {
+		for _, w := range warns {
+			config.UI.Log.Warn(w)
+		}
+	}
+
+	for _, clusterName := range opts.ClustersName {
+		cluster, err := loadCluster(clusterName)
+		if err != nil {
+			return err
+		}
+
+		switch action {
+		case "start":
+			err = cluster.Start(opts.Nodes, opts.Pools)
+		case "stop":
+			err = cluster.Stop(opts.Nodes, opts.Pools)
+		case "restart":
+			err = cluster.Restart(opts.Nodes, opts.Pools)
+		}
+		if errSave := cluster.Save(); errSave != nil {
+			config.UI.Log.Errorf("failed to save the cluster %q. %s", clusterName, errSave)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to %s the cluster %q. %s", action, clusterName, err)
+		}
+
+		config.UI.Log.Infof("cluster %q %s completed", clusterName, action)
+	}
+
+	return nil
+}
+
 func startServerRun(cmd *cobra.Command, args []string) error {
 	host := cmd.Flags().Lookup("host").Value.String()
 	// TODO: validation using TCP IP functions
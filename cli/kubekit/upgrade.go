@@ -0,0 +1,91 @@
+package kubekit
+
+import (
+	"fmt"
+
+	"github.com/liferaft/kubekit/cli"
+	"github.com/liferaft/kubekit/pkg/kluster"
+	"github.com/spf13/cobra"
+)
+
+// upgradeCmd represents the upgrade command
+var upgradeCmd = &cobra.Command{
+	Use:   "upgrade [cluster] NAME",
+	Short: "upgrades a cluster to a new release",
+	Long: `Upgrades a running cluster to the release of this KubeKit, or the one given
+with '--to'. The upgrade path is validated with the KubeKit manifest, then the
+KubeKit package is installed on the nodes, etcd is upgraded on every master, the
+masters are upgraded one at a time and finally the workers in batches, cordoned
+and drained before being upgraded.
+
+If the upgrade fails, it stops and the progress is saved. Execute the upgrade
+again to resume it from the failed step, the package is not installed again.`,
+	RunE: upgradeClusterRun,
+}
+
+// upgradeClusterCmd represents the upgrade cluster command
+var upgradeClusterCmd = &cobra.Command{
+	Use:   "cluster NAME",
+	Short: "upgrades a cluster to a new release",
+	Long: `Upgrades a running cluster to the release of this KubeKit, or the one given
+with '--to'. The upgrade path is validated with the KubeKit manifest, then the
+KubeKit package is installed on the nodes, etcd is upgraded on every master, the
+masters are upgraded one at a time and finally the workers in batches, cordoned
+and drained before being upgraded.
+
+If the upgrade fails, it stops and the progress is saved. Execute the upgrade
+again to resume it from the failed step, the package is not installed again.`,
+	RunE: upgradeClusterRun,
+}
+
+func addUpgradeCmd() {
+	// upgrade [cluster] NAME --to VERSION --batch-size N --package-file FILE --force-pkg
+	RootCmd.AddCommand(upgradeCmd)
+	upgradeCmd.PersistentFlags().String("to", "", "release to upgrade the cluster to. By default is the release of this KubeKit")
+	upgradeCmd.PersistentFlags().Int("batch-size", kluster.DefaultUpgradeBatchSize, "number of workers to upgrade at the same time")
+	upgradeCmd.PersistentFlags().StringP("package-file", "f", "", "package to install before upgrade. By default will be at the cluster directory named 'kubekit.rpm' or '.deb'")
+	upgradeCmd.PersistentFlags().Bool("force-pkg", false, "force install of package")
+
+	upgradeCmd.AddCommand(upgradeClusterCmd)
+}
+
+func upgradeClusterRun(cmd *cobra.Command, args []string) error {
+	opts, warns, err := cli.UpgradeGetOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	if len(warns) != 0 {
+		for _, w := range warns {
+			config.UI.Log.Warn(w)
+		}
+	}
+
+	// the cluster config file must exists. This command should be executed after 'apply' otherwise will fail
+	cluster, err := loadCluster(opts.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	// validate the upgrade before installing the package, the package is not
+	// installed again when a failed upgrade is resumed
+	checkpoint, err := cluster.ValidateUpgrade(opts.To)
+	if err != nil {
+		return err
+	}
+	if checkpoint != nil && !checkpoint.PackageInstalled {
+		if err := copyAndExecPackage(cluster, opts.PkgFilename, opts.ForcePkg); err != nil {
+			return err
+		}
+		checkpoint.PackageInstalled = true
+	}
+
+	errU := cluster.Upgrade(opts.To, opts.BatchSize)
+	errS := cluster.Save()
+	if errU != nil && errS != nil {
+		return fmt.Errorf("failed to upgrade the cluster and to save the cluster configuration file.\n%s\n%s", errU, errS)
+	}
+	if errU != nil {
+		return errU
+	}
+	return errS
+}
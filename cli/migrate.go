@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// MigrateOpts encapsulate all the CLI parameters received from the `migrate` command
+type MigrateOpts struct {
+	ClusterName string
+	DryRun      bool
+}
+
+// MigrateGetOpts get the `migrate` command parameters from the cobra commands and arguments
+func MigrateGetOpts(cmd *cobra.Command, args []string) (opts *MigrateOpts, warns []string, err error) {
+	warns = make([]string, 0)
+
+	// cluster_name
+	clusterName, err := GetOneClusterName(cmd, args, false)
+	if err != nil {
+		return nil, warns, err
+	}
+
+	// Get the flag `--dry-run`
+	dryRun := false
+	if dryRunFlag := cmd.Flags().Lookup("dry-run"); dryRunFlag != nil {
+		dryRun = dryRunFlag.Value.String() == "true"
+	}
+
+	opts = &MigrateOpts{
+		ClusterName: clusterName,
+		DryRun:      dryRun,
+	}
+
+	return opts, warns, nil
+}
@@ -0,0 +1,28 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+)
+
+// AddParallelFlag adds the flag to the given command to limit the number of
+// nodes to connect to at the same time
+func AddParallelFlag(cmd *cobra.Command) {
+	cmd.Flags().Int("parallel", 0, "maximum number of nodes to connect to at the same time. By default all the nodes are used at once, set it with large clusters to not exhaust the file descriptors")
+}
+
+// GetParallelFlag returns the maximum number of nodes to connect to at the
+// same time, zero if the flag is not set
+func GetParallelFlag(cmd *cobra.Command) (int, error) {
+	parallelFlag := cmd.Flags().Lookup("parallel")
+	if parallelFlag == nil {
+		return 0, nil
+	}
+	parallel, err := strconv.Atoi(parallelFlag.Value.String())
+	if err != nil || parallel < 0 {
+		return 0, fmt.Errorf("the value of 'parallel' (%q) should be a positive number", parallelFlag.Value.String())
+	}
+	return parallel, nil
+}
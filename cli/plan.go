@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// PlanOpts encapsulate the CLI parameters received from the `apply` and
+// `delete` commands to print or save the plan
+type PlanOpts struct {
+	Output  string
+	PlanOut string
+}
+
+// PlanGetOpts get the plan parameters of the `apply` and `delete` commands from
+// the cobra commands
+func PlanGetOpts(cmd *cobra.Command) (opts *PlanOpts, err error) {
+	// Get the flags `--output` and `--plan-out`
+	output := "text"
+	if outputFlag := cmd.Flags().Lookup("output"); outputFlag != nil {
+		output = outputFlag.Value.String()
+	}
+	switch output {
+	case "text", "json", "yaml":
+	default:
+		return nil, fmt.Errorf("unknown or unsupported plan format %q. Available formats: 'text', 'json' and 'yaml'", output)
+	}
+	var planOut string
+	if planOutFlag := cmd.Flags().Lookup("plan-out"); planOutFlag != nil {
+		planOut = planOutFlag.Value.String()
+	}
+
+	opts = &PlanOpts{
+		Output:  output,
+		PlanOut: planOut,
+	}
+
+	return opts, nil
+}
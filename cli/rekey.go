@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// RekeyOpts encapsulate all the CLI parameters received from the `rekey` command
+type RekeyOpts struct {
+	ClustersName []string
+	GenerateKey  bool
+	OldKeyFile   string
+	Decrypt      bool
+}
+
+// RekeyGetOpts get the `rekey` command parameters from the cobra commands and arguments
+func RekeyGetOpts(cmd *cobra.Command, args []string) (opts *RekeyOpts, warns []string, err error) {
+	warns = make([]string, 0)
+
+	// the clusters name are optional, all the clusters are re-encrypted if not given
+	clustersName := args
+
+	// Get the flag `--generate-key`
+	generateKey := false
+	if generateKeyFlag := cmd.Flags().Lookup("generate-key"); generateKeyFlag != nil {
+		generateKey = generateKeyFlag.Value.String() == "true"
+	}
+
+	// Get the flag `--old-key-file`
+	var oldKeyFile string
+	if oldKeyFileFlag := cmd.Flags().Lookup("old-key-file"); oldKeyFileFlag != nil {
+		oldKeyFile = oldKeyFileFlag.Value.String()
+	}
+
+	// Get the flag `--decrypt`
+	decrypt := false
+	if decryptFlag := cmd.Flags().Lookup("decrypt"); decryptFlag != nil {
+		decrypt = decryptFlag.Value.String() == "true"
+	}
+
+	if generateKey && decrypt {
+		return nil, warns, fmt.Errorf("the flags '--generate-key' and '--decrypt' cannot be used together")
+	}
+	// a new key makes unreadable the files of the clusters not re-encrypted
+	if generateKey && len(clustersName) != 0 {
+		return nil, warns, fmt.Errorf("a new key requires to re-encrypt all the clusters, do not use the flag '--generate-key' with clusters name")
+	}
+	if generateKey && len(oldKeyFile) != 0 {
+		warns = append(warns, "the current key file is the old key when a new key is generated, the flag '--old-key-file' is also used to decrypt")
+	}
+
+	opts = &RekeyOpts{
+		ClustersName: clustersName,
+		GenerateKey:  generateKey,
+		OldKeyFile:   oldKeyFile,
+		Decrypt:      decrypt,
+	}
+
+	return opts, warns, nil
+}
@@ -0,0 +1,150 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/liferaft/kubekit/pkg/provisioner/state"
+	"github.com/spf13/cobra"
+)
+
+// ScaleOpts encapsulate all the CLI parameters received from the `scale` command
+type ScaleOpts struct {
+	ClusterName string
+	Pool        string
+	Size        int
+	Relative    bool
+	Nodes       []*state.Node
+	Force       bool
+	Parallel    int
+}
+
+// ScaleGetOpts get the `scale` command parameters from the cobra commands and arguments
+func ScaleGetOpts(cmd *cobra.Command, args []string) (opts *ScaleOpts, warns []string, err error) {
+	warns = make([]string, 0)
+
+	if len(args) != 2 {
+		return nil, warns, fmt.Errorf("requires a cluster name and the pool size as POOL-NAME=[+|-]NUMBER, received %d arguments. %v", len(args), args)
+	}
+
+	// cluster_name
+	clusterName, err := GetOneClusterName(cmd, args[:1], false)
+	if err != nil {
+		return nil, warns, err
+	}
+
+	// POOL-NAME=[+|-]NUMBER
+	pool, size, relative, err := ParsePoolSize(args[1])
+	if err != nil {
+		return nil, warns, err
+	}
+
+	// Get the flags `--addresses` and `--force`
+	var nodes []*state.Node
+	if addressesFlag := cmd.Flags().Lookup("addresses"); addressesFlag != nil {
+		addresses, err := StringToArray(addressesFlag.Value.String())
+		if err != nil {
+			return nil, warns, fmt.Errorf("failed to parse the list of addresses")
+		}
+		for _, address := range addresses {
+			node, err := ParseNodeAddress(address)
+			if err != nil {
+				return nil, warns, err
+			}
+			nodes = append(nodes, node)
+		}
+	}
+	force := false
+	forceFlag := cmd.Flags().Lookup("force")
+	if forceFlag != nil {
+		force = forceFlag.Value.String() == "true"
+	}
+
+	// --parallel
+	parallel, err := GetParallelFlag(cmd)
+	if err != nil {
+		return nil, warns, err
+	}
+
+	opts = &ScaleOpts{
+		ClusterName: clusterName,
+		Pool:        pool,
+		Size:        size,
+		Relative:    relative,
+		Nodes:       nodes,
+		Force:       force,
+		Parallel:    parallel,
+	}
+
+	return opts, warns, nil
+}
+
+// ParsePoolSize parses a pool size in the form `POOL-NAME=[+|-]NUMBER`. It
+// returns the pool name, the number and if the number is relative to the
+// current pool size (it has a sign) or absolute
+func ParsePoolSize(poolSize string) (pool string, size int, relative bool, err error) {
+	kv := strings.SplitN(poolSize, "=", 2)
+	if len(kv) != 2 || len(strings.TrimSpace(kv[0])) == 0 || len(strings.TrimSpace(kv[1])) == 0 {
+		return "", 0, false, fmt.Errorf("invalid pool size %q, the format is POOL-NAME=[+|-]NUMBER", poolSize)
+	}
+	pool = strings.TrimSpace(kv[0])
+	value := strings.TrimSpace(kv[1])
+
+	relative = strings.HasPrefix(value, "+") || strings.HasPrefix(value, "-")
+
+	size, err = strconv.Atoi(value)
+	if err != nil {
+		return "", 0, false, fmt.Errorf("invalid number of nodes %q for pool %q", value, pool)
+	}
+
+	return pool, size, relative, nil
+}
+
+// ParseNodeAddress parses the address of a node in the form
+// `PUBLIC_IP[:PRIVATE_IP[:PUBLIC_DNS[:PRIVATE_DNS]]]`. If the private IP is not
+// given, it's the same as the public IP
+func ParseNodeAddress(address string) (*state.Node, error) {
+	fields := strings.Split(address, ":")
+	if len(fields) > 4 || len(fields[0]) == 0 {
+		return nil, fmt.Errorf("invalid node address %q, the format is PUBLIC_IP[:PRIVATE_IP[:PUBLIC_DNS[:PRIVATE_DNS]]]", address)
+	}
+	for len(fields) < 4 {
+		fields = append(fields, "")
+	}
+
+	node := &state.Node{
+		PublicIP:   fields[0],
+		PrivateIP:  fields[1],
+		PublicDNS:  fields[2],
+		PrivateDNS: fields[3],
+	}
+	if len(node.PrivateIP) == 0 {
+		node.PrivateIP = node.PublicIP
+	}
+
+	return node, nil
+}
+
+// NewSize returns the new size of the pool given its current size
+func (opts *ScaleOpts) NewSize(current int) (int, error) {
+	size := opts.Size
+	if opts.Relative {
+		size = current + opts.Size
+	}
+	if size < 0 {
+		return 0, fmt.Errorf("cannot scale the pool %q from %d to %d nodes", opts.Pool, current, size)
+	}
+	return size, nil
+}
+
+// Confirm ask to the user to confirm to remove nodes from the cluster
+func (opts *ScaleOpts) Confirm(current, size int) bool {
+	scaleIt := true
+	if !opts.Force && size < current {
+		question := fmt.Sprintf("Do you want to remove %d nodes from the pool %q of cluster %q", current-size, opts.Pool, opts.ClusterName)
+		scaleIt = HardConfirmation(question, "yes")
+	}
+
+	return scaleIt
+}
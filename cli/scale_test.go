@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/liferaft/kubekit/pkg/provisioner/state"
+)
+
+func TestParsePoolSize(t *testing.T) {
+	tests := []struct {
+		name         string
+		poolSize     string
+		wantPool     string
+		wantSize     int
+		wantRelative bool
+		wantErr      bool
+	}{
+		{"absolute", "worker=3", "worker", 3, false, false},
+		{"add", "worker=+2", "worker", 2, true, false},
+		{"remove", "worker=-1", "worker", -1, true, false},
+		{"spaces", " worker = 3 ", "worker", 3, false, false},
+		{"zero", "worker=0", "worker", 0, false, false},
+		{"no size", "worker=", "", 0, false, true},
+		{"no pool", "=3", "", 0, false, true},
+		{"no equal", "worker", "", 0, false, true},
+		{"not a number", "worker=three", "", 0, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotPool, gotSize, gotRelative, err := ParsePoolSize(tt.poolSize)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParsePoolSize() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotPool != tt.wantPool || gotSize != tt.wantSize || gotRelative != tt.wantRelative {
+				t.Errorf("ParsePoolSize() = (%q, %d, %v), want (%q, %d, %v)", gotPool, gotSize, gotRelative, tt.wantPool, tt.wantSize, tt.wantRelative)
+			}
+		})
+	}
+}
+
+func TestParseNodeAddress(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+		want    *state.Node
+		wantErr bool
+	}{
+		{"public ip", "10.0.0.1", &state.Node{PublicIP: "10.0.0.1", PrivateIP: "10.0.0.1"}, false},
+		{"public and private ip", "10.0.0.1:192.168.0.1", &state.Node{PublicIP: "10.0.0.1", PrivateIP: "192.168.0.1"}, false},
+		{"all", "10.0.0.1:192.168.0.1:node1.example.com:node1", &state.Node{PublicIP: "10.0.0.1", PrivateIP: "192.168.0.1", PublicDNS: "node1.example.com", PrivateDNS: "node1"}, false},
+		{"empty", "", nil, true},
+		{"too many fields", "1:2:3:4:5", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseNodeAddress(tt.address)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseNodeAddress() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseNodeAddress() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScaleOpts_NewSize(t *testing.T) {
+	tests := []struct {
+		name     string
+		size     int
+		relative bool
+		current  int
+		want     int
+		wantErr  bool
+	}{
+		{"absolute", 5, false, 3, 5, false},
+		{"add", 2, true, 3, 5, false},
+		{"remove", -2, true, 3, 1, false},
+		{"remove too many", -4, true, 3, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := &ScaleOpts{Pool: "worker", Size: tt.size, Relative: tt.relative}
+			got, err := opts.NewSize(tt.current)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ScaleOpts.NewSize() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ScaleOpts.NewSize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// StartStopOpts encapsulate all the CLI parameters received from the `start`,
+// `stop` and `restart` commands
+type StartStopOpts struct {
+	ClustersName []string
+	Nodes        []string
+	Pools        []string
+}
+
+// StartStopGetOpts get the `start`, `stop` and `restart` command parameters
+// from the cobra commands and arguments
+func StartStopGetOpts(cmd *cobra.Command, args []string) (opts *StartStopOpts, warns []string, err error) {
+	warns = make([]string, 0)
+
+	clustersName, err := GetMultipleClustersName(cmd, args)
+	if err != nil {
+		return nil, warns, err
+	}
+
+	// Nodes:
+	nodesStr := cmd.Flags().Lookup("nodes").Value.String()
+	nodes, err := StringToArray(nodesStr)
+	if err != nil {
+		return nil, warns, fmt.Errorf("failed to parse the list of nodes")
+	}
+
+	// Pools:
+	poolsStr := cmd.Flags().Lookup("pools").Value.String()
+	pools, err := StringToArray(poolsStr)
+	if err != nil {
+		return nil, warns, fmt.Errorf("failed to parse the list of pools")
+	}
+
+	if len(nodes) != 0 && len(pools) != 0 {
+		return nil, warns, fmt.Errorf("'nodes' and 'pools' flags are mutually exclusive, use --nodes or --pools but not both in the same command")
+	}
+
+	return &StartStopOpts{
+		ClustersName: clustersName,
+		Nodes:        nodes,
+		Pools:        pools,
+	}, warns, nil
+}
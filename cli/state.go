@@ -0,0 +1,138 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/liferaft/kubekit/pkg/kluster"
+	"github.com/spf13/cobra"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// StateHistoryOpts encapsulate all the CLI parameters received from the
+// `get state-history` command
+type StateHistoryOpts struct {
+	ClusterName string
+	Output      string
+	Pp          bool
+}
+
+// StateHistoryGetOpts get the `get state-history` command parameters from the
+// cobra commands and arguments
+func StateHistoryGetOpts(cmd *cobra.Command, args []string) (opts *StateHistoryOpts, warns []string, err error) {
+	warns = make([]string, 0)
+
+	// cluster_name
+	clusterName, err := GetOneClusterName(cmd, args, false)
+	if err != nil {
+		return nil, warns, err
+	}
+
+	// Get the flags `--output` and `--pp`
+	var output string
+	if outputFlag := cmd.Flags().Lookup("output"); outputFlag != nil {
+		output = outputFlag.Value.String()
+	}
+	pp := false
+	if ppFlag := cmd.Flags().Lookup("pp"); ppFlag != nil {
+		pp = ppFlag.Value.String() == "true"
+	}
+
+	return &StateHistoryOpts{
+		ClusterName: clusterName,
+		Output:      output,
+		Pp:          pp,
+	}, warns, nil
+}
+
+// StateHistoryInfo is the list of versions in the state history of a cluster
+type StateHistoryInfo []kluster.StateVersion
+
+// Sprintf returns a string to print in the given format. Pretty Print (`pp`)
+// applies only for JSON
+func (shi StateHistoryInfo) Sprintf(format string, pp bool) (string, error) {
+	switch format {
+	case "", "wide", "w":
+		return "", shi.Table()
+	case "json":
+		var (
+			output []byte
+			err    error
+		)
+		if pp {
+			output, err = json.MarshalIndent(shi, "", "  ")
+		} else {
+			output, err = json.Marshal(shi)
+		}
+		return string(output), err
+	case "yaml":
+		output, err := yaml.Marshal(shi)
+		return string(output), err
+	default:
+		return "", fmt.Errorf("unknown format %q", format)
+	}
+}
+
+// Table prints the state versions as a table
+func (shi StateHistoryInfo) Table() error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintf(w, "Version\tCreated\tSize\n")
+	for _, v := range shi {
+		fmt.Fprintf(w, "%s\t%s\t%d\n", v.ID, v.Created.Format(time.RFC3339), v.Size)
+	}
+
+	return w.Flush()
+}
+
+// RestoreStateOpts encapsulate all the CLI parameters received from the
+// `restore state` command
+type RestoreStateOpts struct {
+	ClusterName string
+	Version     string
+	Force       bool
+}
+
+// RestoreStateGetOpts get the `restore state` command parameters from the cobra
+// commands and arguments
+func RestoreStateGetOpts(cmd *cobra.Command, args []string) (opts *RestoreStateOpts, warns []string, err error) {
+	warns = make([]string, 0)
+
+	// cluster_name
+	clusterName, err := GetOneClusterName(cmd, args, false)
+	if err != nil {
+		return nil, warns, err
+	}
+
+	// Get the flags `--version` and `--force`
+	var version string
+	if versionFlag := cmd.Flags().Lookup("version"); versionFlag != nil {
+		version = versionFlag.Value.String()
+	}
+	if len(version) == 0 {
+		return nil, warns, fmt.Errorf("the state version to restore is required, use the flag '--version'. Get the versions with 'kubekit get state-history %s'", clusterName)
+	}
+	force := false
+	if forceFlag := cmd.Flags().Lookup("force"); forceFlag != nil {
+		force = forceFlag.Value.String() == "true"
+	}
+
+	return &RestoreStateOpts{
+		ClusterName: clusterName,
+		Version:     version,
+		Force:       force,
+	}, warns, nil
+}
+
+// Confirm ask to the user to confirm to replace the cluster state with the
+// state version
+func (opts *RestoreStateOpts) Confirm() bool {
+	if opts.Force {
+		return true
+	}
+	question := fmt.Sprintf("Do you want to replace the state of cluster %q with the state version %s", opts.ClusterName, opts.Version)
+	return HardConfirmation(question, "yes")
+}
@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+)
+
+// UpgradeOpts encapsulate all the CLI parameters received from the `upgrade` command
+type UpgradeOpts struct {
+	ClusterName string
+	To          string
+	BatchSize   int
+	PkgFilename string
+	ForcePkg    bool
+}
+
+// UpgradeGetOpts get the `upgrade` command parameters from the cobra commands and arguments
+func UpgradeGetOpts(cmd *cobra.Command, args []string) (opts *UpgradeOpts, warns []string, err error) {
+	warns = make([]string, 0)
+
+	// cluster_name
+	clusterName, err := GetOneClusterName(cmd, args, false)
+	if err != nil {
+		return nil, warns, err
+	}
+
+	// Get the flags `--to`, `--batch-size`, `--package-file` and `--force-pkg`
+	var to string
+	if toFlag := cmd.Flags().Lookup("to"); toFlag != nil {
+		to = toFlag.Value.String()
+	}
+	batchSize := 0
+	if batchSizeFlag := cmd.Flags().Lookup("batch-size"); batchSizeFlag != nil {
+		if batchSize, err = strconv.Atoi(batchSizeFlag.Value.String()); err != nil || batchSize < 1 {
+			return nil, warns, fmt.Errorf("the batch size has to be a number greater than 0, received %q", batchSizeFlag.Value.String())
+		}
+	}
+	var pkgFilename string
+	if pkgFileFlag := cmd.Flags().Lookup("package-file"); pkgFileFlag != nil {
+		pkgFilename = pkgFileFlag.Value.String()
+	}
+	forcePkg := false
+	if forcePkgFlag := cmd.Flags().Lookup("force-pkg"); forcePkgFlag != nil {
+		forcePkg = forcePkgFlag.Value.String() == "true"
+	}
+
+	opts = &UpgradeOpts{
+		ClusterName: clusterName,
+		To:          to,
+		BatchSize:   batchSize,
+		PkgFilename: pkgFilename,
+		ForcePkg:    forcePkg,
+	}
+
+	return opts, warns, nil
+}
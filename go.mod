@@ -12,6 +12,7 @@ require (
 	github.com/go-ini/ini v1.48.0
 	github.com/golang/protobuf v1.3.2
 	github.com/golang/snappy v0.0.1 // indirect
+	github.com/gophercloud/gophercloud v0.4.1-0.20190920074709-6e93a6ba3b09
 	github.com/grpc-ecosystem/grpc-gateway v1.11.3
 	github.com/hashicorp/hcl v1.0.0
 	github.com/hashicorp/terraform v0.12.20
@@ -29,6 +30,7 @@ require (
 	github.com/nu7hatch/gouuid v0.0.0-20131221200532-179d4d0c4d8d
 	github.com/pelletier/go-toml v1.4.0
 	github.com/pkg/sftp v1.10.1
+	github.com/pmezard/go-difflib v1.0.0
 	github.com/shiena/ansicolor v0.0.0-20151119151921-a422bbe96644
 	github.com/sirupsen/logrus v1.4.2
 	github.com/spf13/cobra v0.0.5
@@ -40,10 +42,10 @@ require (
 	github.com/terraform-providers/terraform-provider-openstack v1.23.0
 	github.com/terraform-providers/terraform-provider-template v1.0.1-0.20190501175038-5333ad92003c
 	github.com/terraform-providers/terraform-provider-vsphere v1.13.0
+	github.com/vmware/govmomi v0.21.0
 	github.com/zclconf/go-cty v1.2.1
-	golang.org/x/crypto v0.0.0-20191029031824-8986dd9e96cf
-	golang.org/x/net v0.0.0-20191009170851-d66e71096ffb
-	golang.org/x/sys v0.0.0-20191210023423-ac6580df4449 // indirect
+	golang.org/x/crypto v0.0.0-20211117183948-ae814b36b871
+	golang.org/x/net v0.0.0-20211112202133-69e39bad7dc2
 	// golang.org/x/crypto v0.0.0-20191002192127-34f69633bfdc
 	// golang.org/x/net v0.0.0-20191009170851-d66e71096ffb
 	google.golang.org/genproto v0.0.0-20191002211648-c459b9ce5143
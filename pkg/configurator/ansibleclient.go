@@ -11,6 +11,7 @@ import (
 
 	"github.com/johandry/log"
 	"github.com/kraken/ui"
+	"github.com/liferaft/kubekit/pkg/configurator/ssh"
 )
 
 // Ansible status possible values
@@ -20,7 +21,7 @@ const (
 )
 
 // KubeKitConfiguratorPort is the port used by the KubeKit Ansible callback to
-// expose the Ansible playbook logs
+// expose the Ansible playbook logs. It's reached through a SSH tunnel to the host
 const KubeKitConfiguratorPort = 1080
 
 // Maximum number retries and frecuency to get tasks and status
@@ -111,6 +112,7 @@ func (t AnsibleTask) Report(uI *ui.UI) {
 	if !t.Ok() {
 		// If a task fail (not an item, tasks contain " : "), report it as complete
 		uI.Notify(t.Node, ui.Red+taskNames[0], "</"+taskNames[0]+">", "")
+		uI.Emit(ui.Event{Type: ui.HostTaskFinished, Host: t.Node, Task: taskNames[0], Status: ui.StatusFailed})
 	}
 
 	// Only prints the tasks with tags
@@ -125,6 +127,16 @@ func (t AnsibleTask) Report(uI *ui.UI) {
 	task := fmt.Sprintf("%s%s", color, taskNames[0])
 
 	uI.Notify(t.Node, task, taskNames[1], "", ui.Configure)
+
+	// the failed tasks were already reported as finished
+	if !t.Ok() {
+		return
+	}
+	event := ui.Event{Type: ui.HostTaskStarted, Host: t.Node, Task: taskNames[0]}
+	if strings.HasPrefix(taskNames[1], "</") {
+		event.Type, event.Status = ui.HostTaskFinished, ui.StatusOk
+	}
+	uI.Emit(event)
 }
 
 // AnsibleHostStat contain the Ansible stats per host
@@ -208,6 +220,8 @@ type AnsibleClient struct {
 	Tasks              []AnsibleTask
 	Stats              *AnsibleStats
 	mu                 sync.Mutex
+	tunnel             *ssh.Tunnel
+	httpClient         *http.Client
 	reqTasks           *http.Request
 	reqStats           *http.Request
 	retriesEmptyTasks  int
@@ -218,22 +232,41 @@ type AnsibleClient struct {
 }
 
 func newAnsibleClient(host Host, ui *ui.UI, stop *chan bool) (*AnsibleClient, error) {
-	reqTasks := getHTTPRequest(host.PublicIP, "/tasks")
+	if host.ssh == nil {
+		return nil, fmt.Errorf("SSH session not configured for %s (%s)", host.RoleName, host.PublicIP)
+	}
+
+	reqTasks := getHTTPRequest("/tasks")
 	if reqTasks == nil {
 		return nil, fmt.Errorf("failed to create a get request to get the ansible tasks from %s (%s)", host.RoleName, host.PublicIP)
 	}
 
-	reqStats := getHTTPRequest(host.PublicIP, "/stats")
+	reqStats := getHTTPRequest("/stats")
 	if reqStats == nil {
 		return nil, fmt.Errorf("failed to create a get request to get the ansible stats from %s (%s)", host.RoleName, host.PublicIP)
 	}
 
+	// the Ansible callback API is reached with port forwarding through SSH, so
+	// it works even if the host is behind a bastion or a firewall
+	tunnel, err := host.ssh.Tunnel()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open a SSH tunnel to %s (%s). %s", host.RoleName, host.PublicIP, err)
+	}
+	httpClient := &http.Client{
+		Timeout: time.Second * 2,
+		Transport: &http.Transport{
+			Dial: tunnel.Dial,
+		},
+	}
+
 	return &AnsibleClient{
-		Hostname: host.RoleName,
-		reqTasks: reqTasks,
-		reqStats: reqStats,
-		stop:     stop,
-		ui:       ui,
+		Hostname:   host.RoleName,
+		tunnel:     tunnel,
+		httpClient: httpClient,
+		reqTasks:   reqTasks,
+		reqStats:   reqStats,
+		stop:       stop,
+		ui:         ui,
 	}, nil
 }
 
@@ -272,6 +305,7 @@ func (a *AnsibleClient) PrintLogs() {
 	var tickStats *time.Ticker
 	var tickStatsCh <-chan time.Time
 	tickTasks := time.NewTicker(SecondsTickTasks * time.Second).C
+	defer a.tunnel.Close()
 
 	// Workflow:
 	// 1 - If there isn't any task in the latest 3 times (MaxEmptyTaskRetries), start requesting stats if you aren't
@@ -336,8 +370,10 @@ func (a *AnsibleClient) PrintLogs() {
 	}
 }
 
-func getHTTPRequest(ipAddress, urlPath string) *http.Request {
-	baseURL := fmt.Sprintf("http://%s:%d", ipAddress, KubeKitConfiguratorPort)
+// getHTTPRequest returns a request to the Ansible callback API. The requests
+// are sent through the SSH tunnel, so the API is in the localhost
+func getHTTPRequest(urlPath string) *http.Request {
+	baseURL := fmt.Sprintf("http://localhost:%d", KubeKitConfiguratorPort)
 
 	request, err := http.NewRequest(http.MethodGet, baseURL+urlPath, nil)
 	if err != nil {
@@ -348,15 +384,12 @@ func getHTTPRequest(ipAddress, urlPath string) *http.Request {
 	return request
 }
 
-func unmarshall(req *http.Request, v interface{}) error {
-	client := http.Client{
-		Timeout: time.Second * 2,
-	}
-
-	res, err := client.Do(req)
+func (a *AnsibleClient) unmarshall(req *http.Request, v interface{}) error {
+	res, err := a.httpClient.Do(req)
 	if err != nil {
 		return fmt.Errorf("failed to do the request to %s", req.URL)
 	}
+	defer res.Body.Close()
 	body, err := ioutil.ReadAll(res.Body)
 	if err != nil {
 		return fmt.Errorf("failed to read the content from request to %s", req.URL)
@@ -387,7 +420,7 @@ func (a *AnsibleClient) printTasks(tasks []AnsibleTask) {
 
 func (a *AnsibleClient) getStats() error {
 	stats := &AnsibleStats{}
-	if err := unmarshall(a.reqStats, stats); err != nil {
+	if err := a.unmarshall(a.reqStats, stats); err != nil {
 		return err
 	}
 	if !stats.Empty() {
@@ -399,7 +432,7 @@ func (a *AnsibleClient) getStats() error {
 func (a *AnsibleClient) getLatestTasks() ([]AnsibleTask, error) {
 	lastestTasks := []AnsibleTask{}
 
-	if err := unmarshall(a.reqTasks, &lastestTasks); err != nil {
+	if err := a.unmarshall(a.reqTasks, &lastestTasks); err != nil {
 		return lastestTasks, err
 	}
 
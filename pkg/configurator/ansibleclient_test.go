@@ -0,0 +1,53 @@
+package configurator
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/johandry/log"
+	"github.com/kraken/ui"
+)
+
+func TestAnsibleTask_Report(t *testing.T) {
+	tests := []struct {
+		name string
+		task AnsibleTask
+		want []ui.Event
+	}{
+		{"role started", AnsibleTask{Name: "journald : <journald>", Node: "master000"}, []ui.Event{
+			{Type: ui.HostTaskStarted, Cluster: "foo", Host: "master000", Task: "journald"},
+		}},
+		{"role finished", AnsibleTask{Name: "journald : </journald>", Status: AnsibleStatusOk, Node: "master000"}, []ui.Event{
+			{Type: ui.HostTaskFinished, Cluster: "foo", Host: "master000", Task: "journald", Status: ui.StatusOk},
+		}},
+		{"task failed", AnsibleTask{Name: "journald : set storage", Status: AnsibleStatusFailed, Node: "worker000"}, []ui.Event{
+			{Type: ui.HostTaskFinished, Cluster: "foo", Host: "worker000", Task: "journald", Status: ui.StatusFailed},
+		}},
+		{"regular task", AnsibleTask{Name: "journald : set storage", Status: AnsibleStatusOk, Node: "master000"}, []ui.Event{}},
+		{"item", AnsibleTask{Name: "set storage", Status: AnsibleStatusOk, Node: "master000"}, []ui.Event{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uI := ui.New(false, log.NewDefault())
+			uI.SetCluster("foo")
+			got := []ui.Event{}
+			uI.OnEvent(func(event ui.Event) {
+				got = append(got, event)
+			})
+
+			tt.task.Report(uI)
+			uI.TerminateAllNotifications("")
+
+			for i := range got {
+				if got[i].Time.IsZero() {
+					t.Errorf("Report() event %d has no time", i)
+				}
+				got[i].Time = time.Time{}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Report() events = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
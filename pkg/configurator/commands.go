@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -20,11 +21,16 @@ import (
 // Command is a command to execute in a cluster node or to/from it
 type Command struct {
 	Hosts Hosts
-	ui    *ui.UI
+	// Parallel is the maximum number of hosts to execute the command at the
+	// same time, if zero the command is executed in all the hosts at once
+	Parallel int
+	pool     *ssh.Pool
+	ui       *ui.UI
 }
 
-// NewCommand returns a new command
-func NewCommand(hosts Hosts, platformConfig interface{}, ui *ui.UI) (*Command, error) {
+// NewCommand returns a new command. The host keys of the hosts are verified
+// with the given known hosts
+func NewCommand(hosts Hosts, platformConfig interface{}, knownHosts *ssh.KnownHosts, ui *ui.UI) (*Command, error) {
 	config := make(map[string]interface{})
 	configB, err := json.Marshal(platformConfig)
 	if err != nil {
@@ -35,35 +41,34 @@ func NewCommand(hosts Hosts, platformConfig interface{}, ui *ui.UI) (*Command, e
 		return nil, fmt.Errorf("failed to unmarshal the platform configuration. %s", err)
 	}
 
-	username, ok := config["username"]
-	if !ok {
-		return nil, fmt.Errorf("not found username in platform configuration")
-	}
-	privKey, err := GetPrivateKey(config)
+	settings, err := NewSSHSettings(config, knownHosts)
 	if err != nil {
 		return nil, err
 	}
-	var password string
-	if p, ok := config["password"]; ok {
-		password = p.(string)
-	}
-
-	if err := hosts.Config(username.(string), privKey, password, false); err != nil {
+	settings.Pool = ssh.NewPool()
+	if err := hosts.Config(settings, false); err != nil {
 		return nil, err
 	}
 
 	return &Command{
 		Hosts: hosts,
+		pool:  settings.Pool,
 		ui:    ui,
 	}, nil
 }
 
+// Close closes the SSH connections to the hosts
+func (c *Command) Close() error {
+	if c.pool == nil {
+		return nil
+	}
+	return c.pool.Close()
+}
+
 func (c *Command) executeFnInHosts(hosts Hosts, wg *sync.WaitGroup, fn func(Host)) {
 	wg.Add(len(hosts))
 
-	for _, host := range hosts {
-		go fn(host)
-	}
+	hosts.forEach(c.Parallel, fn)
 
 	wg.Wait()
 }
@@ -122,18 +127,21 @@ func (c *Command) CopyFrom(from, to string, forceFiles, backupFiles, sudoFiles b
 	}
 
 	errMsg := []string{}
+	var errMu sync.Mutex
 	var wg sync.WaitGroup
 	c.executeFnInAllHosts(&wg, func(host Host) {
 		defer wg.Done()
 		defer host.ssh.Close()
 
 		handleError := func(err error, msg string, a ...interface{}) {
+			errMu.Lock()
+			defer errMu.Unlock()
 			errMsg = append(errMsg, fmt.Sprintf("%s (%s)", host.PublicIP, err))
 			fmt.Printf(msg+"\n", a...)
 		}
 
 		targetDir := filepath.Join(to, host.PublicIP)
-		err = os.MkdirAll(targetDir, 0755)
+		err := os.MkdirAll(targetDir, 0755)
 		if err != nil {
 			handleError(err, "failed to create the directory for host %s on %s", host.PublicIP, to)
 			return
@@ -165,6 +173,7 @@ func (c *Command) CopyFrom(from, to string, forceFiles, backupFiles, sudoFiles b
 		fmt.Printf("file %q, %d bytes, copied from host %s%s\n", targetFile, length, host.PublicIP, bkpMsg)
 	})
 	if len(errMsg) != 0 {
+		sort.Strings(errMsg)
 		return fmt.Errorf("failed to copy the file %q from the following hosts: %s", from, strings.Join(errMsg, ", "))
 	}
 	return nil
@@ -199,6 +208,7 @@ func (c *Command) CopyTo(from, to string, forceFiles, backupFiles, sudoFiles boo
 	}
 
 	errMsg := []string{}
+	var errMu sync.Mutex
 	var wg sync.WaitGroup
 	c.executeFnInAllHosts(&wg, func(host Host) {
 		defer wg.Done()
@@ -214,6 +224,8 @@ func (c *Command) CopyTo(from, to string, forceFiles, backupFiles, sudoFiles boo
 		}()
 
 		handleError := func(err error, msg string, a ...interface{}) {
+			errMu.Lock()
+			defer errMu.Unlock()
 			errMsg = append(errMsg, fmt.Sprintf("%s (%s)", host.PublicIP, err))
 			fmt.Printf(msg+"\n", a...)
 		}
@@ -275,6 +287,7 @@ func (c *Command) CopyTo(from, to string, forceFiles, backupFiles, sudoFiles boo
 		}
 	})
 	if len(errMsg) != 0 {
+		sort.Strings(errMsg)
 		return fmt.Errorf("failed to copy the file %q to the following hosts: %s", from, strings.Join(errMsg, ", "))
 	}
 	return nil
@@ -305,18 +318,27 @@ func (c *Command) Exec(command, script string, sudoExec bool) (*ssh.CommandResul
 	}
 
 	errMsg := []string{}
+	var errMu sync.Mutex
 	var wg sync.WaitGroup
 	c.executeFnInAllHosts(&wg, func(host Host) {
 		defer wg.Done()
 		defer host.ssh.Close()
 
-		handleError := func(err error, msg string, a ...interface{}) {
+		handleError := func(err error, msg string) {
+			errMu.Lock()
+			defer errMu.Unlock()
 			errMsg = append(errMsg, fmt.Sprintf("%s (%s) %s", host.PublicIP, err, msg))
 		}
 
 		if len(content) != 0 {
 			if err := host.ssh.CreateFile(targetFile, string(content), 0700); err != nil {
-				handleError(err, "failed to copy the script %q to host %s", targetFile, host.PublicIP)
+				msg := fmt.Sprintf("failed to copy the script %q to host %s", targetFile, host.PublicIP)
+				handleError(err, msg)
+				result.Hosts.Store(host.PublicIP, &ssh.HostCommandResult{
+					ExitStatus: -1,
+					Error:      fmt.Sprintf("%s. %s", msg, err),
+				})
+				atomic.AddUint32(&result.Failures, 1)
 				return
 			}
 		}
@@ -327,18 +349,22 @@ func (c *Command) Exec(command, script string, sudoExec bool) (*ssh.CommandResul
 		}
 
 		outCmdMsg, errCmdMsg, exitStat, err := host.ssh.ExecAndWait(execCmd)
-		if err != nil {
-			handleError(err, "failed to execute command %q at host %s", execCmd, host.PublicIP)
-		}
-
-		result.Hosts.Store(host.PublicIP, &ssh.HostCommandResult{
+		hostResult := &ssh.HostCommandResult{
 			Stdout:     outCmdMsg,
 			Stderr:     errCmdMsg,
 			ExitStatus: exitStat,
-		})
+		}
+		if err != nil {
+			msg := fmt.Sprintf("failed to execute command %q at host %s", execCmd, host.PublicIP)
+			handleError(err, msg)
+			hostResult.ExitStatus = -1
+			hostResult.Error = fmt.Sprintf("%s. %s", msg, err)
+		}
+
+		result.Hosts.Store(host.PublicIP, hostResult)
 
 		// var outputMsg string
-		if exitStat == 0 {
+		if hostResult.ExitStatus == 0 {
 			atomic.AddUint32(&result.Success, 1)
 			// outputMsg = "command %q successfuly executed at host %s"
 		} else {
@@ -349,6 +375,7 @@ func (c *Command) Exec(command, script string, sudoExec bool) (*ssh.CommandResul
 	})
 	var err error
 	if len(errMsg) != 0 {
+		sort.Strings(errMsg)
 		err = fmt.Errorf("failed to execute the command %q at the following hosts: %s", command, strings.Join(errMsg, ", "))
 	}
 	return &result, err
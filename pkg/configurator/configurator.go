@@ -15,7 +15,6 @@ import (
 	"github.com/liferaft/kubekit/pkg/configurator/resources"
 
 	"github.com/johandry/log"
-	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/kraken/ui"
@@ -46,6 +45,15 @@ const ConfiguratorBaseDir = KubekitBaseDir + "/configurator"
 // every node
 const ConfiguratorLogDir = "/var/log/kubekit"
 
+// Phases of the cluster configuration reported with the PhaseChanged events
+const (
+	PhaseSetup        = "setup"
+	PhaseCertificates = "certificates"
+	PhaseConfigure    = "configure"
+	PhaseResources    = "resources"
+	PhaseValidation   = "validation"
+)
+
 //go:generate go run codegen/ansible/main.go --src ./templates/ansible --dst code.go --exclude *.bak
 
 // variables containing the Ansible files such as the roles (in zip format),
@@ -65,6 +73,10 @@ type Configurator struct {
 	address        string
 	port           int
 	Hosts          Hosts
+	targets        Hosts
+	tags           []string
+	parallel       int
+	pool           *ssh.Pool
 	stateData      map[string]interface{}
 	platformConfig map[string]interface{}
 	platform       string
@@ -167,34 +179,39 @@ func New(clusterName, platform, address string, port int, hosts Hosts, stateData
 	// DEBUG:
 	// ui.Log.Debugf("resources loaded: %v", conf.resources.Names())
 
-	username, ok := conf.platformConfig["username"]
-	if !ok {
-		return &conf, fmt.Errorf("not found username in %q platform configuration", platform)
-	}
-	privKey, err := GetPrivateKey(conf.platformConfig)
+	settings, err := NewSSHSettings(conf.platformConfig, ssh.NewKnownHosts(filepath.Join(basePath, ssh.KnownHostsFilename)))
 	if err != nil {
 		return &conf, err
 	}
-	var password string
-	passwordPtr, ok := conf.platformConfig["password"]
-	if ok {
-		password = passwordPtr.(string)
-	}
 
-	if len(privKey) == 0 && len(password) == 0 {
-		return &conf, fmt.Errorf("not found password neither private key in %q platform configuration", platform)
+	if len(settings.Auth.PrivateKeys) == 0 && len(settings.Auth.Password) == 0 && !settings.Auth.HasMethod(ssh.AgentAuth) {
+		return &conf, fmt.Errorf("not found password, private key neither SSH agent authentication in %q platform configuration", platform)
 	}
 
-	// DEBUG:
-	// parentLogger.Debugf("Password: %q\tKey: %q", password, privKey)
+	settings.Pool = ssh.NewPool()
+	conf.pool = settings.Pool
 
-	if err := conf.Hosts.Config(username.(string), privKey, password, true); err != nil {
+	if err := conf.Hosts.Config(settings, true); err != nil {
 		return nil, err
 	}
 
 	return &conf, nil
 }
 
+// SetParallel sets the maximum number of hosts to configure at the same time,
+// if zero all the hosts are configured at once
+func (c *Configurator) SetParallel(parallel int) {
+	c.parallel = parallel
+}
+
+// Close closes the SSH connections to the hosts
+func (c *Configurator) Close() error {
+	if c.pool == nil {
+		return nil
+	}
+	return c.pool.Close()
+}
+
 // GetPrivateKey return the private key from the cluster platform configuration
 func GetPrivateKey(platformConfig map[string]interface{}) (string, error) {
 	if privateKey, ok := platformConfig["private_key"]; ok {
@@ -231,13 +248,21 @@ func (c *Configurator) Configure() error {
 	switch c.platform {
 	case "eks", "aks":
 	default:
-		errConfig = c.configureWithAnsible()
+		errConfig = c.configureHosts()
 	}
 
+	// too many nodes failed, the cluster may look healthy but it's incomplete
+	if rolloutErr, ok := errConfig.(*RolloutError); ok {
+		c.ui.Notify("kubernetes", fmt.Sprintf("%sconfiguration", ui.Red), "Kubernetes configuration failed", "")
+		return rolloutErr
+	}
+
+	c.ui.EmitPhase(PhaseResources)
 	if errResources := c.ApplyResources(false); errResources != nil {
 		return errResources
 	}
 
+	c.ui.EmitPhase(PhaseValidation)
 	// Ignore error, the validation may get the same error
 	err := c.waitClusterReady()
 	if err != nil {
@@ -584,14 +609,57 @@ func (c *Configurator) validateCluster() error {
 	return nil
 }
 
-func (c *Configurator) configureWithAnsible() error {
+// ConfigureNodes configures Kubernetes only on the given nodes, identified by
+// IP or DNS, without reconfiguring the rest of the cluster. The inventory
+// still contains every cluster host so the selected nodes can join the
+// existing cluster
+func (c *Configurator) ConfigureNodes(nodes ...string) error {
+	targets := c.Hosts.FilterByNode(nodes...)
+	if len(targets) == 0 {
+		return fmt.Errorf("none of the nodes %v were found in the cluster %q", nodes, c.clusterName)
+	}
+
+	c.targets = targets
+	defer func() { c.targets = nil }()
+
+	switch c.platform {
+	case "eks", "aks":
+		c.ui.Log.Debugf("the %s nodes are configured by the platform, there is nothing to configure", c.platform)
+	default:
+		if err := c.configureHosts(); err != nil {
+			return err
+		}
+	}
+
+	return c.waitClusterReady()
+}
+
+// ConfigureNodesWithTags is like ConfigureNodes but only executes the Ansible
+// roles with the given tags
+func (c *Configurator) ConfigureNodesWithTags(tags []string, nodes ...string) error {
+	c.tags = tags
+	defer func() { c.tags = nil }()
+
+	return c.ConfigureNodes(nodes...)
+}
+
+// configureHosts configures the target hosts with the executor selected in
+// the configuration
+func (c *Configurator) configureHosts() error {
 	defer c.ui.TerminateAllNotifications("")
 
-	if err := c.Setup(); err != nil {
+	executor, err := c.executor()
+	if err != nil {
+		return err
+	}
+	c.ui.EmitPhase(PhaseSetup)
+	if err := executor.Setup(); err != nil {
 		return err
 	}
+	c.ui.EmitPhase(PhaseCertificates)
 	c.UploadCerts()
-	return c.RunPlaybook()
+	c.ui.EmitPhase(PhaseConfigure)
+	return c.RunPlaybook(executor)
 }
 
 // UpdateHosts get the hosts from a Terraform state file
@@ -607,15 +675,24 @@ func (c *Config) UpdateHosts(tfStateFile string) error {
 func (c *Configurator) executeInHosts(hosts Hosts, wg *sync.WaitGroup, f func(host Host, logger *log.Logger)) {
 	wg.Add(len(hosts))
 
-	for _, host := range hosts {
-		go f(host, c.ui.Log)
-	}
+	hosts.forEach(c.parallel, func(host Host) {
+		f(host, c.ui.Log)
+	})
 
 	wg.Wait()
 }
 
 func (c *Configurator) executeInAllHosts(wg *sync.WaitGroup, f func(host Host, logger *log.Logger)) {
-	c.executeInHosts(c.Hosts, wg, f)
+	c.executeInHosts(c.targetHosts(), wg, f)
+}
+
+// targetHosts returns the hosts selected to be configured, if there is no
+// selection all the cluster hosts are returned
+func (c *Configurator) targetHosts() Hosts {
+	if len(c.targets) != 0 {
+		return c.targets
+	}
+	return c.Hosts
 }
 
 // Setup installs and configures Ansible and upload the Ansible roles and inventory
@@ -730,51 +807,61 @@ func configureAnsible(host Host, logger *log.Logger, name string) {
 	}
 	logger.Debugf("[%s] Ansible version %q", host.RoleName, verOutputFields[1])
 
+	if !prepareHost(host, logger, name) {
+		return
+	}
+
+	ansibleCfgFile := filepath.Join(ConfiguratorBaseDir, "ansible.cfg")
+
+	if err := host.ssh.CreateFile(ansibleCfgFile, AnsibleCfg, 0644); err != nil {
+		logger.Errorf("[%s] failed to create the ansible configuration file %q: %s", host.RoleName, ansibleCfgFile, err)
+		return
+	}
+	logger.Debugf("[%s] created Ansible configuration file %s", host.RoleName, ansibleCfgFile)
+
+	callbackFile := filepath.Join(ConfiguratorBaseDir, "kubekit.py")
+
+	if err := host.ssh.CreateFile(callbackFile, Callback, 0644); err != nil {
+		logger.Errorf("[%s] failed to create the ansible callback file %q: %s", host.RoleName, callbackFile, err)
+		return
+	}
+	logger.Debugf("[%s] created Ansible callback file %s", host.RoleName, callbackFile)
+}
+
+// prepareHost creates the configurator directory owned by the user and the
+// kube group. It returns false if it fails
+func prepareHost(host Host, logger *log.Logger, name string) bool {
 	if err := host.ssh.SudoMkDir(ConfiguratorBaseDir); err != nil {
 		logger.Errorf("[%s] failed to sudo create the configurator home directory %q: %s", host.RoleName, ConfiguratorBaseDir, err)
-		return
+		return false
 	}
 	logger.Infof("[%s] created configurator base directory on %s", host.RoleName, ConfiguratorBaseDir)
 
 	if err := host.ssh.CreateGroup("kube"); err != nil {
 		logger.Errorf("[%s] failed to create group 'kube'  %s", host.RoleName, err)
-		return
+		return false
 	}
 	logger.Infof("[%s] created group: kube", host.RoleName)
 
 	if err := host.ssh.UserMod(name, "kube"); err != nil {
 		logger.Errorf("[%s] failed to add user to 'kube' group %s", host.RoleName, err)
-		return
+		return false
 	}
 	logger.Infof("[%s] added user %s to 'kube' group", host.RoleName, name)
 
 	if err := host.ssh.SetChown(KubekitBaseDir, name+":kube"); err != nil {
 		logger.Errorf("[%s] failed to set ownership %s:kube on kubekit installation directory %q: %s", host.RoleName, name, KubekitBaseDir, err)
-		return
+		return false
 	}
 	logger.Debugf("[%s] kubekit installation directory ownership permssion '%s' set on %s", host.RoleName, name+":kube", KubekitBaseDir)
 
 	if err := host.ssh.SetChown(ConfiguratorBaseDir, name+":kube"); err != nil {
 		logger.Errorf("[%s] failed to set ownership %s:kube on configurator home directory %q: %s", host.RoleName, name, ConfiguratorBaseDir, err)
-		return
+		return false
 	}
 	logger.Debugf("[%s] configurator base directory ownership permssion '%s' set on %s", host.RoleName, name+":kube", ConfiguratorBaseDir)
 
-	ansibleCfgFile := filepath.Join(ConfiguratorBaseDir, "ansible.cfg")
-
-	if err := host.ssh.CreateFile(ansibleCfgFile, AnsibleCfg, 0644); err != nil {
-		logger.Errorf("[%s] failed to create the ansible configuration file %q: %s", host.RoleName, ansibleCfgFile, err)
-		return
-	}
-	logger.Debugf("[%s] created Ansible configuration file %s", host.RoleName, ansibleCfgFile)
-
-	callbackFile := filepath.Join(ConfiguratorBaseDir, "kubekit.py")
-
-	if err := host.ssh.CreateFile(callbackFile, Callback, 0644); err != nil {
-		logger.Errorf("[%s] failed to create the ansible callback file %q: %s", host.RoleName, callbackFile, err)
-		return
-	}
-	logger.Debugf("[%s] created Ansible callback file %s", host.RoleName, callbackFile)
+	return true
 }
 
 // uploadRoles uploads the Ansible playbook, roles and other required files to
@@ -925,6 +1012,16 @@ func (c *Configurator) UploadCerts() {
 			"kubelet.key",
 		}
 
+		// Certificates uploaded only if they exists, depending on the configuration
+		certFilesOptional := []string{
+			"oidc_ca.crt",
+		}
+		for _, fileName := range certFilesOptional {
+			if _, err := os.Stat(filepath.Join(certsDir, fileName)); err == nil {
+				certFiles = append(certFiles, fileName)
+			}
+		}
+
 		for _, fileName := range certFiles {
 			filePath := filepath.Join(certsDir, fileName)
 
@@ -994,7 +1091,7 @@ func (c *Configurator) UploadCerts() {
 		}
 	})
 
-	masters := c.Hosts.FilterByRole("master")
+	masters := c.targetHosts().FilterByRole("master")
 
 	c.executeInHosts(masters, &wg, func(host Host, logger *log.Logger) {
 		defer wg.Done()
@@ -1034,111 +1131,127 @@ func (c *Configurator) UploadCerts() {
 	})
 }
 
-// RunPlaybook will execute ansible remotely to configure the host to have
-// Kubernetes up and running.
-func (c *Configurator) RunPlaybook() error {
-	var wg sync.WaitGroup
+// RunPlaybook executes the configuration with the given executor to have
+// Kubernetes up and running on the hosts. The hosts are configured in batches
+// following the rollout settings, if more hosts than allowed fail the next
+// batches are skipped and a RolloutError is returned
+func (c *Configurator) RunPlaybook(executor Executor) error {
 	globalStats := NewAnsibleStatsMap(len(c.Hosts))
 
-	name := c.platformConfig["username"].(string)
-
-	c.executeInAllHosts(&wg, func(host Host, logger *log.Logger) {
-		defer wg.Done()
-		defer host.ssh.Close()
-
-		if err := host.ssh.SudoMkDir(ConfiguratorLogDir); err != nil {
-			logger.Errorf("[%s] failed to create the logs directory %q: %s", host.RoleName, ConfiguratorLogDir, err)
-			return
-		}
-
-		if err := host.ssh.SetChown(ConfiguratorLogDir, name); err != nil {
-			logger.Errorf("[%s] failed to set ownership %s on configurator log directory %q: %s", host.RoleName, name, ConfiguratorLogDir, err)
-			return
-		}
-		logger.Debugf("[%s] configurator log directory ownership permssion set on %s", host.RoleName, ConfiguratorBaseDir)
-
-		touchLogFile := &ssh.Command{Command: fmt.Sprintf("echo -ne '----------------\n\tNEW EXECUTION ( '$(date)' ) \n----------------\n' | sudo tee --append %s/configurator.log >/dev/null && echo OK", ConfiguratorLogDir)}
-		err := host.ssh.Start(touchLogFile)
-		if err != nil {
-			logger.Errorf("[%s] failed to clean configurator logs: %s", host.RoleName, err)
-			return
-		}
-		touchOutput := strings.TrimRight(touchLogFile.Stdout.String(), "\n")
-		if touchOutput == "OK" {
-			logger.Debugf("[%s] configurator log files cleaned", host.RoleName)
-		} else {
-			logger.Errorf("[%s] failed to clean configurator logs", host.RoleName)
-		}
-
-		// Notify(host.RoleName, "configuration", "Configuring...")
-
-		doneAnsibleCh := make(chan bool, 1)
-		ansible, err := newAnsibleClient(host, c.ui, &doneAnsibleCh)
-		if err != nil {
-			logger.Errorf("[%s] failed to create the Ansible client. %s", host.RoleName, err)
-			return
-		}
-		go ansible.PrintLogs()
-
-		ansibleDebug := ""
-		if logger.Level == logrus.DebugLevel {
-			ansibleDebug = "-vvv"
-		}
-
-		// Execute the playbook and make sure it's not running when finish
-		ansibleCMD := `cd %s && \
-			ansible-playbook %s -i inventory.yml -l %s kubekit.yml | sudo tee --append %s/configurator.log >/dev/null; \
-			pid=$(ps -fea | grep [a]nsible | grep -v bash | awk '{print $2}'); \
-			test -z ${pid} || kill -9 ${pid}`
-
-		executePlaybook := &ssh.Command{Command: fmt.Sprintf(ansibleCMD, ConfiguratorBaseDir, ansibleDebug, host.RoleName, ConfiguratorLogDir)}
+	hosts := c.targetHosts()
+	batches, err := c.config.rolloutBatches(hosts)
+	if err != nil {
+		return err
+	}
+	maxFailures, err := c.config.maxFailures(len(hosts))
+	if err != nil {
+		return err
+	}
 
-		err = host.ssh.Start(executePlaybook)
-		doneAnsibleCh <- true
+	runPlaybook := func(host Host) {
+		stats, err := executor.Run(host)
 		if err != nil {
-			logger.Errorf("[%s] failed to run the Ansible playbook: %s", host.RoleName, err)
+			c.ui.Log.Errorf("[%s] %s", host.RoleName, err)
+			c.ui.Emit(ui.Event{Type: ui.HostFailed, Host: host.RoleName, Message: err.Error()})
 			return
 		}
-		globalStats.Store(host.RoleName, ansible.Stats)
+		globalStats.Store(host.RoleName, stats)
 
 		configMsg := "Configuration complete on %s"
 		color := ui.Green
-		if ansible.Stats == nil {
+		if stats == nil {
 			color = ui.Yellow
 			configMsg = "Configuration complete on %s without stats"
-		} else if !ansible.Stats.Ok() {
+		} else if !stats.Ok() {
 			color = ui.Red
 			configMsg = "Configuration fail on %s"
 		}
 		configMsg = fmt.Sprintf(configMsg, host.RoleName)
-		if ansible.Stats != nil && !ansible.Stats.Empty() {
-			configMsg = fmt.Sprintf("%s after %gs", configMsg, ansible.Stats.Duration)
+		if stats != nil && !stats.Empty() {
+			configMsg = fmt.Sprintf("%s after %gs", configMsg, stats.Duration)
 		}
 
 		task := fmt.Sprintf("%sconfiguration", color)
 
 		c.ui.Notify(host.RoleName, task, configMsg, "")
-	})
+	}
 
-	var ok bool
-	for role, stat := range globalStats.GetSnapshot() {
-		if stat != nil && stat.Ok() {
-			c.ui.Log.Infof("[%s] is ready for Kubernetes. Duration: %gs", role, stat.Duration)
-			ok = true
+	results := []hostResult{}
+	failed := []string{}
+	var configured, missing int
+	for i, batch := range batches {
+		if len(failed) > maxFailures {
+			for _, host := range batch {
+				results = append(results, hostResult{host: host, batch: i, status: hostSkipped})
+			}
 			continue
 		}
 
-		if stat == nil {
-			c.ui.Log.Warnf("[%s] stats are missing, may failed to configure Kubernetes", role)
-			continue
+		// the masters wait for each other to form the control plane, so all of
+		// them are configured at the same time
+		parallel := c.parallel
+		if masters := batch.FilterByRolePrefix("master"); parallel > 0 && parallel < len(masters) {
+			c.ui.Log.Warnf("configuring %d master nodes at the same time, more than the %d parallel nodes requested", len(masters), parallel)
+			parallel = len(masters)
+		}
+		if len(batches) > 1 {
+			c.ui.Log.Infof("configuring batch %d of %d with %d nodes", i+1, len(batches), len(batch))
 		}
 
-		if !stat.Ok() {
-			c.ui.Log.Errorf("[%s] failed to configure Kubernetes. Duration: %gs", role, stat.Duration)
+		batch.forEach(parallel, runPlaybook)
+
+		stats := globalStats.GetSnapshot()
+		for _, host := range batch {
+			role := host.RoleName
+			result := hostResult{host: host, batch: i}
+			stat, found := stats[role]
+			switch {
+			case !found:
+				c.ui.Log.Errorf("[%s] failed to run the configuration", role)
+				result.status = hostFailed
+			case stat == nil:
+				// the host may be unreachable, it's counted as failed
+				c.ui.Log.Errorf("[%s] stats are missing, may failed to configure Kubernetes", role)
+				result.status = hostNoStats
+			case stat.Ok():
+				c.ui.Log.Infof("[%s] is ready for Kubernetes. Duration: %gs", role, stat.Duration)
+				result.status, result.duration = hostConfigured, stat.Duration
+			default:
+				c.ui.Log.Errorf("[%s] failed to configure Kubernetes. Duration: %gs", role, stat.Duration)
+				c.ui.Emit(ui.Event{Type: ui.HostFailed, Host: role, Message: "failed to configure Kubernetes"})
+				result.status, result.duration = hostFailed, stat.Duration
+			}
+
+			switch result.status {
+			case hostConfigured:
+				configured++
+			case hostNoStats:
+				missing++
+				failed = append(failed, role)
+			case hostFailed:
+				failed = append(failed, role)
+			}
+			results = append(results, result)
+		}
+
+		if len(failed) > maxFailures && i < len(batches)-1 {
+			c.ui.Log.Errorf("%d nodes failed, more than the %d allowed, the remaining batches are skipped", len(failed), maxFailures)
 		}
 	}
 
-	if !ok {
+	summary := fmt.Sprintf("configured %d of %d nodes", configured, len(hosts))
+	if missing != 0 {
+		summary += fmt.Sprintf(", %d without stats", missing)
+	}
+	if len(failed) != 0 {
+		summary += fmt.Sprintf(", failed on: %s", strings.Join(failed, ", "))
+	}
+	c.ui.Log.Infof("%s\n%s", summary, rolloutSummary(results))
+
+	if len(failed) > maxFailures {
+		return &RolloutError{Failed: failed, Total: len(hosts), MaxFailures: maxFailures}
+	}
+	if configured == 0 {
 		return fmt.Errorf("failed to configure Kubernetes on the cluster")
 	}
 	return nil
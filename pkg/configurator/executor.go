@@ -0,0 +1,120 @@
+package configurator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/liferaft/kubekit/pkg/configurator/ssh"
+	"github.com/sirupsen/logrus"
+)
+
+// Executors to configure the hosts, selected with the `executor` parameter
+const (
+	ExecutorAnsible = "ansible"
+	ExecutorNative  = "native"
+)
+
+// Executor configures the hosts to have Kubernetes up and running
+type Executor interface {
+	// Setup prepares the target hosts to be configured
+	Setup() error
+	// Run configures the host and returns the stats of the execution, the
+	// stats are nil if they could not be collected
+	Run(host Host) (*AnsibleStats, error)
+}
+
+// executor returns the Executor selected in the configuration, Ansible if
+// there is none
+func (c *Configurator) executor() (Executor, error) {
+	name := ExecutorAnsible
+	if c.config != nil && len(c.config.Executor) != 0 {
+		name = c.config.Executor
+	}
+
+	switch name {
+	case ExecutorAnsible:
+		return &ansibleExecutor{c: c}, nil
+	case ExecutorNative:
+		return newNativeExecutor(c)
+	default:
+		return nil, fmt.Errorf("unknown executor %q, the valid executors are %q and %q", name, ExecutorAnsible, ExecutorNative)
+	}
+}
+
+// ansibleExecutor configures the hosts executing the Ansible playbook on
+// every host. The progress is reported by the KubeKit Ansible callback
+type ansibleExecutor struct {
+	c *Configurator
+	// skipTags are the tags of the roles executed by other executor
+	skipTags []string
+}
+
+// Setup installs and configures Ansible and upload the Ansible roles and
+// inventory
+func (e *ansibleExecutor) Setup() error {
+	return e.c.Setup()
+}
+
+// Run executes the Ansible playbook on the host
+func (e *ansibleExecutor) Run(host Host) (*AnsibleStats, error) {
+	logger := e.c.ui.Log
+	defer host.ssh.Close()
+
+	name := e.c.platformConfig["username"].(string)
+
+	if err := host.ssh.SudoMkDir(ConfiguratorLogDir); err != nil {
+		return nil, fmt.Errorf("failed to create the logs directory %q: %s", ConfiguratorLogDir, err)
+	}
+
+	if err := host.ssh.SetChown(ConfiguratorLogDir, name); err != nil {
+		return nil, fmt.Errorf("failed to set ownership %s on configurator log directory %q: %s", name, ConfiguratorLogDir, err)
+	}
+	logger.Debugf("[%s] configurator log directory ownership permssion set on %s", host.RoleName, ConfiguratorBaseDir)
+
+	touchLogFile := &ssh.Command{Command: fmt.Sprintf("echo -ne '----------------\n\tNEW EXECUTION ( '$(date)' ) \n----------------\n' | sudo tee --append %s/configurator.log >/dev/null && echo OK", ConfiguratorLogDir)}
+	err := host.ssh.Start(touchLogFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to clean configurator logs: %s", err)
+	}
+	touchOutput := strings.TrimRight(touchLogFile.Stdout.String(), "\n")
+	if touchOutput == "OK" {
+		logger.Debugf("[%s] configurator log files cleaned", host.RoleName)
+	} else {
+		logger.Errorf("[%s] failed to clean configurator logs", host.RoleName)
+	}
+
+	doneAnsibleCh := make(chan bool, 1)
+	ansible, err := newAnsibleClient(host, e.c.ui, &doneAnsibleCh)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the Ansible client. %s", err)
+	}
+	go ansible.PrintLogs()
+
+	ansibleDebug := ""
+	if logger.Level == logrus.DebugLevel {
+		ansibleDebug = "-vvv"
+	}
+	ansibleArgs := ansibleDebug
+	if len(e.c.tags) != 0 {
+		ansibleArgs = strings.TrimSpace(ansibleArgs + " --tags " + strings.Join(e.c.tags, ","))
+	}
+	if len(e.skipTags) != 0 {
+		ansibleArgs = strings.TrimSpace(ansibleArgs + " --skip-tags " + strings.Join(e.skipTags, ","))
+	}
+
+	// Execute the playbook and make sure it's not running when finish
+	ansibleCMD := `cd %s && \
+		ansible-playbook %s -i inventory.yml -l %s kubekit.yml | sudo tee --append %s/configurator.log >/dev/null; \
+		pid=$(ps -fea | grep [a]nsible | grep -v bash | awk '{print $2}'); \
+		test -z ${pid} || kill -9 ${pid}`
+
+	executePlaybook := &ssh.Command{Command: fmt.Sprintf(ansibleCMD, ConfiguratorBaseDir, ansibleArgs, host.RoleName, ConfiguratorLogDir)}
+
+	err = host.ssh.Start(executePlaybook)
+	doneAnsibleCh <- true
+	if err != nil {
+		return nil, fmt.Errorf("failed to run the Ansible playbook: %s", err)
+	}
+
+	return ansible.Stats, nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"sync"
 
 	"github.com/liferaft/kubekit/pkg/configurator/ssh"
 )
@@ -24,13 +25,17 @@ func (h *Host) GetSSHConfig() *ssh.Config {
 	return h.ssh
 }
 
-// Config configures a host
-func (h *Host) Config(roleName, username, privateKey, password string) error {
-	h.RoleName = roleName
-	sshConf, err := ssh.New(username, h.PublicIP, privateKey, password)
+// Config configures a host with the given role name and SSH settings
+func (h *Host) Config(roleName string, settings *SSHSettings) error {
+	pool := h.Pool
+	if len(pool) == 0 {
+		pool = h.RoleName
+	}
+	sshConf, err := settings.sshConfig(h, pool)
 	if err != nil {
 		return err
 	}
+	h.RoleName = roleName
 	h.ssh = sshConf
 
 	return nil
@@ -47,8 +52,8 @@ func (h *Host) StartShell(in io.Reader, out, err io.Writer) error {
 // Hosts is a slice of Host
 type Hosts []Host
 
-// Config configures the hosts
-func (hs Hosts) Config(username, privateKey, password string, applyRoleNameFormat bool) error {
+// Config configures the hosts with the given SSH settings
+func (hs Hosts) Config(settings *SSHSettings, applyRoleNameFormat bool) error {
 	var newRoleName string
 	roleNameFormat := fmt.Sprintf("%%s%%0%dd", ZeroPadLen)
 	counter := map[string]int{}
@@ -63,7 +68,7 @@ func (hs Hosts) Config(username, privateKey, password string, applyRoleNameForma
 		} else {
 			newRoleName = host.RoleName
 		}
-		if err := host.Config(newRoleName, username, privateKey, password); err != nil {
+		if err := host.Config(newRoleName, settings); err != nil {
 			return err
 		}
 		hs[i] = host
@@ -71,6 +76,28 @@ func (hs Hosts) Config(username, privateKey, password string, applyRoleNameForma
 	return nil
 }
 
+// forEach calls fn for every host concurrently, with at most parallel hosts at
+// the same time. If parallel is zero all the hosts run at once. It returns
+// when fn returns for all the hosts
+func (hs Hosts) forEach(parallel int, fn func(Host)) {
+	if parallel <= 0 || parallel > len(hs) {
+		parallel = len(hs)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(len(hs))
+	sem := make(chan struct{}, parallel)
+	for _, host := range hs {
+		sem <- struct{}{}
+		go func(host Host) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			fn(host)
+		}(host)
+	}
+	wg.Wait()
+}
+
 // FilterByRole returns all the hosts with the given roles
 func (hs Hosts) FilterByRole(roles ...string) Hosts {
 	newHosts := Hosts{}
@@ -113,3 +140,43 @@ func (hs Hosts) FilterByNode(ipOrDNS ...string) Hosts {
 
 	return newHosts
 }
+
+// FilterByPool returns all the hosts in the given node pools. The platforms
+// that do not report the pool name use the role name as the pool name
+func (hs Hosts) FilterByPool(pools ...string) Hosts {
+	newHosts := Hosts{}
+	for _, h := range hs {
+		pool := h.Pool
+		if len(pool) == 0 {
+			pool = h.RoleName
+		}
+		for _, p := range pools {
+			if pool == p {
+				newHosts = append(newHosts, h)
+				break
+			}
+		}
+	}
+
+	return newHosts
+}
+
+// Difference returns the hosts that are not in the given list of hosts. The
+// hosts are compared by their IP addresses
+func (hs Hosts) Difference(others Hosts) Hosts {
+	newHosts := Hosts{}
+	for _, h := range hs {
+		found := false
+		for _, o := range others {
+			if h.PublicIP == o.PublicIP && h.PrivateIP == o.PrivateIP {
+				found = true
+				break
+			}
+		}
+		if !found {
+			newHosts = append(newHosts, h)
+		}
+	}
+
+	return newHosts
+}
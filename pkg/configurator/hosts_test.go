@@ -0,0 +1,56 @@
+package configurator
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestHosts_forEach(t *testing.T) {
+	hosts := Hosts{}
+	for i := 0; i < 10; i++ {
+		hosts = append(hosts, Host{RoleName: "worker", PublicIP: fmt.Sprintf("10.0.0.%d", i)})
+	}
+
+	tests := []struct {
+		name     string
+		parallel int
+		wantMax  int
+	}{
+		{"unlimited", 0, len(hosts)},
+		{"one at a time", 1, 1},
+		{"three at a time", 3, 3},
+		{"more than hosts", 20, len(hosts)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			running, max := 0, 0
+			visited := map[string]bool{}
+
+			hosts.forEach(tt.parallel, func(host Host) {
+				mu.Lock()
+				running++
+				if running > max {
+					max = running
+				}
+				visited[host.PublicIP] = true
+				mu.Unlock()
+
+				time.Sleep(20 * time.Millisecond)
+
+				mu.Lock()
+				running--
+				mu.Unlock()
+			})
+
+			if len(visited) != len(hosts) {
+				t.Errorf("forEach() visited %d hosts, want %d", len(visited), len(hosts))
+			}
+			if max > tt.wantMax {
+				t.Errorf("forEach() ran %d hosts at the same time, want at most %d", max, tt.wantMax)
+			}
+		})
+	}
+}
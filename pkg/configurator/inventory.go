@@ -148,6 +148,11 @@ type InventoryVariables struct {
 	TerminatedPodGCThreshold                int         `json:"terminated_pod_gc_threshold,omitempty" yaml:"terminated_pod_gc_threshold" mapstructure:"terminated_pod_gc_threshold"`
 	AdditionalRSharedMountPoints            []string    `json:"additional_rshared_mount_points,omitempty" yaml:"additional_rshared_mount_points,omitempty" mapstructure:"additional_rshared_mount_points"`
 	SysctlSettings                          interface{} `json:"sysctl_settings,omitempty" yaml:"sysctl_settings,omitempty" mapstructure:"sysctl_settings"`
+	OIDCIssuerURL                           string      `json:"oidc_issuer_url,omitempty" yaml:"oidc_issuer_url,omitempty" mapstructure:"oidc_issuer_url"`
+	OIDCClientID                            string      `json:"oidc_client_id,omitempty" yaml:"oidc_client_id,omitempty" mapstructure:"oidc_client_id"`
+	OIDCUsernameClaim                       string      `json:"oidc_username_claim,omitempty" yaml:"oidc_username_claim,omitempty" mapstructure:"oidc_username_claim"`
+	OIDCGroupsClaim                         string      `json:"oidc_groups_claim,omitempty" yaml:"oidc_groups_claim,omitempty" mapstructure:"oidc_groups_claim"`
+	OIDCCAFile                              string      `json:"oidc_ca_file,omitempty" yaml:"oidc_ca_file,omitempty" mapstructure:"oidc_ca_file"`
 }
 
 // AllInventory contain all the variables and childrens of the Ansible inventory
@@ -185,7 +190,6 @@ var defaultInventoryVariables = InventoryVariables{
 	KubeletSerializeImagePulls:         false,
 	KubeClusterCidr:                    "172.24.0.0/16",
 	KubeServicesCidr:                   "172.21.0.0/16",
-	KubeServiceIP:                      "172.21.0.1",
 	KubeAdvertiseAddress:               "{{ ansible_eth0.ipv4.address }}",
 	DisableMasterHA:                    true,
 	KubeVirtualIPApi:                   "",
@@ -269,7 +273,7 @@ func (c *Configurator) Inventory() (*Inventory, error) {
 
 	for _, host := range c.Hosts {
 
-		roleNameGroup := strings.NewReplacer("-", "_", ".", "_", " ", "_").Replace(strings.ToLower(host.RoleName[:len(host.RoleName)-ZeroPadLen]))
+		roleNameGroup := roleNameGroup(host)
 
 		tempLabels, err := getListFromNodePool(c.platformConfig, "kubelet_node_labels", roleNameGroup)
 		if err != nil {
@@ -466,6 +470,39 @@ func (iv *InventoryVariables) Map() (map[string]interface{}, error) {
 	return bucket.(map[string]interface{}), nil
 }
 
+// NodeLabelsAndTaints returns the Kubelet labels and taints of the given host,
+// as they are defined for its node pool in the given platform configuration
+func NodeLabelsAndTaints(platformConfig interface{}, host Host) (labels, taints []string, err error) {
+	pConfigB, err := json.Marshal(platformConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	var pConfig map[string]interface{}
+	if err := json.Unmarshal(pConfigB, &pConfig); err != nil {
+		return nil, nil, err
+	}
+
+	group := roleNameGroup(host)
+	if labels, err = getListFromNodePool(pConfig, "kubelet_node_labels", group); err != nil {
+		return nil, nil, fmt.Errorf("unable to extract kubelet_node_labels. %s", err)
+	}
+	if taints, err = getListFromNodePool(pConfig, "kubelet_node_taints", group); err != nil {
+		return nil, nil, fmt.Errorf("unable to extract kubelet_node_taints. %s", err)
+	}
+
+	return labels, taints, nil
+}
+
+// roleNameGroup returns the inventory group of the given host, it's the node
+// pool name taken from the host role name
+func roleNameGroup(host Host) string {
+	roleName := host.RoleName
+	if len(roleName) > ZeroPadLen {
+		roleName = roleName[:len(roleName)-ZeroPadLen]
+	}
+	return strings.NewReplacer("-", "_", ".", "_", " ", "_").Replace(strings.ToLower(roleName))
+}
+
 func getListFromNodePool(m map[string]interface{}, key string, pool string) ([]string, error) {
 	switch pool {
 	case "default_node_pool":
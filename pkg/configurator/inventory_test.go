@@ -0,0 +1,49 @@
+package configurator
+
+import (
+	"testing"
+
+	"github.com/johandry/log"
+	"github.com/kraken/ui"
+)
+
+func TestConfigurator_Inventory_oidc(t *testing.T) {
+	config := &Config{
+		OIDCIssuerURL:     "https://issuer.example.com",
+		OIDCClientID:      "kubekit",
+		OIDCUsernameClaim: "email",
+		OIDCGroupsClaim:   "groups",
+		OIDCCAFile:        "/tmp/oidc_ca.crt",
+	}
+	c := &Configurator{
+		clusterName:    "kkdemo",
+		platform:       "ec2",
+		Hosts:          newHosts(1, 1),
+		config:         config,
+		platformConfig: map[string]interface{}{},
+		ui:             ui.New(false, log.NewDefault()),
+	}
+
+	inventory, err := c.Inventory()
+	if err != nil {
+		t.Fatalf("Configurator.Inventory() error = %v", err)
+	}
+
+	vars := inventory.All.Variables
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"oidc_issuer_url", vars.OIDCIssuerURL, config.OIDCIssuerURL},
+		{"oidc_client_id", vars.OIDCClientID, config.OIDCClientID},
+		{"oidc_username_claim", vars.OIDCUsernameClaim, config.OIDCUsernameClaim},
+		{"oidc_groups_claim", vars.OIDCGroupsClaim, config.OIDCGroupsClaim},
+		{"oidc_ca_file", vars.OIDCCAFile, config.OIDCCAFile},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("Configurator.Inventory() %s = %q, want %q", tt.name, tt.got, tt.want)
+		}
+	}
+}
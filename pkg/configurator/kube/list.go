@@ -150,3 +150,12 @@ func (c *Client) ListJobs(namespace string) (*batchv1.JobList, error) {
 	}
 	return c.clientset.BatchV1().Jobs(namespace).List(metav1.ListOptions{})
 }
+
+// ListEvents returns a list of events for a namespace, set to empty string to get from all
+func (c *Client) ListEvents(namespace string) (*corev1.EventList, error) {
+	err := c.ClientSet()
+	if err != nil {
+		return nil, err
+	}
+	return c.clientset.CoreV1().Events(namespace).List(metav1.ListOptions{})
+}
@@ -1,8 +1,15 @@
 package kube
 
 import (
+	"fmt"
+	"time"
+
 	v1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 // Nodes returns the list of nodes
@@ -21,13 +28,167 @@ func (c *Client) NodesReady() (int, int, error) {
 	}
 	readyCount := 0
 	for _, n := range nodes.Items {
-		for _, c := range n.Status.Conditions {
-			if c.Type == "Ready" && c.Status == "True" {
-				readyCount++
+		if isNodeReady(n) {
+			readyCount++
+		}
+	}
+
+	return readyCount, len(nodes.Items), nil
+}
+
+// NodesNotReady returns the name of the nodes that are not ready
+func (c *Client) NodesNotReady() ([]string, error) {
+	nodes, err := c.ListNodes()
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes.Items) == 0 {
+		return nil, fmt.Errorf("there are no nodes in the cluster")
+	}
+	notReady := []string{}
+	for _, n := range nodes.Items {
+		if !isNodeReady(n) {
+			notReady = append(notReady, n.Name)
+		}
+	}
+
+	return notReady, nil
+}
+
+// NodeReady returns true if the node with the given name is ready
+func (c *Client) NodeReady(name string) (bool, error) {
+	if err := c.ClientSet(); err != nil {
+		return false, err
+	}
+	node, err := c.clientset.CoreV1().Nodes().Get(name, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+	return isNodeReady(*node), nil
+}
+
+func isNodeReady(node v1.Node) bool {
+	for _, c := range node.Status.Conditions {
+		if c.Type == "Ready" && c.Status == "True" {
+			return true
+		}
+	}
+	return false
+}
+
+// NodeName returns the name of the Kubernetes node with any of the given
+// addresses (IP or DNS). Returns an empty name if the node is not found
+func (c *Client) NodeName(addresses ...string) (string, error) {
+	nodes, err := c.ListNodes()
+	if err != nil {
+		return "", err
+	}
+
+	for _, n := range nodes.Items {
+		for _, address := range addresses {
+			if len(address) == 0 {
+				continue
+			}
+			if n.Name == address {
+				return n.Name, nil
+			}
+			for _, nodeAddress := range n.Status.Addresses {
+				if nodeAddress.Address == address {
+					return n.Name, nil
+				}
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// CordonNode marks the given node as unschedulable, or schedulable if
+// `unschedulable` is false
+func (c *Client) CordonNode(name string, unschedulable bool) error {
+	if err := c.ClientSet(); err != nil {
+		return err
+	}
+	patch := fmt.Sprintf(`{"spec":{"unschedulable":%t}}`, unschedulable)
+	_, err := c.clientset.CoreV1().Nodes().Patch(name, types.StrategicMergePatchType, []byte(patch))
+	return err
+}
+
+// DrainNode cordons the given node and evicts all the pods running on it,
+// except the mirror pods and the pods managed by a DaemonSet. It waits until
+// all the evicted pods are gone or the timeout is reached
+func (c *Client) DrainNode(name string, timeout time.Duration) error {
+	if err := c.CordonNode(name, true); err != nil {
+		return err
+	}
+
+	pods, err := c.clientset.CoreV1().Pods("").List(metav1.ListOptions{
+		FieldSelector: fields.SelectorFromSet(fields.Set{"spec.nodeName": name}).String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	evicted := []v1.Pod{}
+	for _, pod := range pods.Items {
+		if _, isMirror := pod.Annotations[v1.MirrorPodAnnotationKey]; isMirror {
+			continue
+		}
+		if ownedByDaemonSet(pod) {
+			continue
+		}
+		if pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+			continue
+		}
+
+		eviction := &policyv1beta1.Eviction{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      pod.Name,
+				Namespace: pod.Namespace,
+			},
+		}
+		if err := c.clientset.CoreV1().Pods(pod.Namespace).Evict(eviction); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to evict pod %s/%s from node %s. %s", pod.Namespace, pod.Name, name, err)
+		}
+		c.ui.Log.Debugf("evicting pod %s/%s from node %s", pod.Namespace, pod.Name, name)
+		evicted = append(evicted, pod)
+	}
+
+	deadline := time.Now().Add(timeout)
+	for _, pod := range evicted {
+		for {
+			p, err := c.clientset.CoreV1().Pods(pod.Namespace).Get(pod.Name, metav1.GetOptions{})
+			if errors.IsNotFound(err) || (err == nil && p.UID != pod.UID) {
 				break
 			}
+			if time.Now().After(deadline) {
+				return fmt.Errorf("timeout after %s waiting for pod %s/%s to be evicted from node %s", timeout, pod.Namespace, pod.Name, name)
+			}
+			time.Sleep(2 * time.Second)
 		}
 	}
 
-	return readyCount, len(nodes.Items), nil
+	return nil
+}
+
+// DeleteNode deletes the node with the given name from the cluster. It's not
+// an error if the node does not exists
+func (c *Client) DeleteNode(name string) error {
+	if err := c.ClientSet(); err != nil {
+		return err
+	}
+	err := c.clientset.CoreV1().Nodes().Delete(name, &metav1.DeleteOptions{})
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func ownedByDaemonSet(pod v1.Pod) bool {
+	for _, owner := range pod.OwnerReferences {
+		if owner.Kind == "DaemonSet" {
+			return true
+		}
+	}
+	return false
 }
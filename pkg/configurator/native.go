@@ -0,0 +1,611 @@
+package configurator
+
+import (
+	"archive/zip"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/johandry/log"
+	"github.com/kraken/ui"
+	"github.com/liferaft/kubekit/pkg/configurator/ssh"
+	"github.com/liferaft/kubekit/pkg/manifest"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Default values of the native roles, the same as the Ansible roles defaults
+var (
+	defaultTimeServers = []string{"0.pool.ntp.org", "1.pool.ntp.org", "2.pool.ntp.org", "3.pool.ntp.org"}
+	journaldMaxSize    = "1G"
+	journaldLogSize    = "10M"
+	dockerBIP          = "172.17.0.1/16"
+	dockerMTU          = 1500
+)
+
+// nativeRoles are the Ansible roles implemented in Go by the native executor
+var nativeRoles = map[string]func(e *nativeExecutor) []nativeTask{
+	"manifest":       (*nativeExecutor).manifestTasks,
+	"timesyncd":      (*nativeExecutor).timesyncdTasks,
+	"journald":       (*nativeExecutor).journaldTasks,
+	"root-cert":      (*nativeExecutor).rootCertTasks,
+	"docker/systemd": (*nativeExecutor).dockerTasks,
+}
+
+// errTaskSkipped is returned by a task when its condition is not met
+var errTaskSkipped = errors.New("skipped")
+
+// runner executes a command on a host, it's implemented by the host SSH client
+type runner interface {
+	ExecAndWait(command string) (stdout string, stderr string, exitStatus int, err error)
+}
+
+// nativeTask is an idempotent step of a native role. It returns true if it
+// changed the host. It's skipped if the `when` command fails and, if any task
+// changes the host, the services in `notify` are restarted at the end of the
+// role, like the Ansible handlers
+type nativeTask struct {
+	name   string
+	when   string
+	notify string
+	run    func(r runner) (bool, error)
+}
+
+// playbookRole is a role of the Ansible playbook with its tags
+type playbookRole struct {
+	Name string   `yaml:"role"`
+	Tags []string `yaml:"tags"`
+}
+
+// playbookRoles returns the roles of the Ansible playbook, in the order they
+// are executed
+func playbookRoles() ([]playbookRole, error) {
+	plays := []struct {
+		Roles []playbookRole `yaml:"roles"`
+	}{}
+	if err := yaml.Unmarshal([]byte(Playbook), &plays); err != nil {
+		return nil, fmt.Errorf("failed to read the roles from the playbook. %s", err)
+	}
+
+	roles := []playbookRole{}
+	for _, play := range plays {
+		roles = append(roles, play.Roles...)
+	}
+	return roles, nil
+}
+
+// selectRoles returns the roles with any of the given tags, or all the roles
+// if there are no tags
+func selectRoles(roles []playbookRole, tags []string) []playbookRole {
+	if len(tags) == 0 {
+		return roles
+	}
+
+	selected := []playbookRole{}
+	for _, role := range roles {
+		for _, tag := range tags {
+			if contains(role.Tags, tag) {
+				selected = append(selected, role)
+				break
+			}
+		}
+	}
+	return selected
+}
+
+// nativeExecutor configures the hosts executing the native roles directly
+// through SSH, so the hosts do not require Python or Ansible. The selected
+// roles that are not implemented natively are executed with Ansible after the
+// native roles
+type nativeExecutor struct {
+	c     *Configurator
+	vars  InventoryVariables
+	roles []string
+	// ansible executes the roles not implemented natively, it's nil if all
+	// the selected roles are native
+	ansible *ansibleExecutor
+}
+
+func newNativeExecutor(c *Configurator) (*nativeExecutor, error) {
+	roles, err := playbookRoles()
+	if err != nil {
+		return nil, err
+	}
+
+	e := &nativeExecutor{c: c}
+	others := []string{}
+	for _, role := range selectRoles(roles, c.tags) {
+		if _, ok := nativeRoles[role.Name]; ok {
+			e.roles = append(e.roles, role.Name)
+			continue
+		}
+		if role.Name != "precheck" {
+			others = append(others, role.Name)
+		}
+	}
+
+	if len(others) != 0 {
+		c.ui.Log.Infof("the roles %s are not implemented by the native executor, they are executed with Ansible", strings.Join(others, ", "))
+		// the manifest role is executed by Ansible as well, the other roles
+		// use the facts it sets
+		skipTags := []string{}
+		for _, role := range e.roles {
+			if role != "manifest" {
+				skipTags = append(skipTags, role)
+			}
+		}
+		e.ansible = &ansibleExecutor{c: c, skipTags: skipTags}
+	}
+
+	return e, nil
+}
+
+// Setup prepares the configurator directory to upload the certificates, and
+// setup Ansible if there are roles that are not implemented natively
+func (e *nativeExecutor) Setup() error {
+	inventory, err := e.c.Inventory()
+	if err != nil {
+		return err
+	}
+	e.vars = inventory.All.Variables
+
+	if e.ansible != nil {
+		return e.ansible.Setup()
+	}
+
+	username := e.c.platformConfig["username"].(string)
+
+	var wg sync.WaitGroup
+	e.c.executeInAllHosts(&wg, func(host Host, logger *log.Logger) {
+		defer wg.Done()
+		defer host.ssh.Close()
+
+		e.c.ui.Notify(host.RoleName, "setup", "<setup>", "", ui.Setup)
+		defer e.c.ui.Notify(host.RoleName, "setup", "</setup>", "", ui.Setup)
+
+		checkTime(host, logger)
+
+		prepareHost(host, logger, username)
+	})
+
+	return nil
+}
+
+// Run executes the native roles on the host, then the roles not implemented
+// natively with Ansible. The result of every task is reported as it's done
+func (e *nativeExecutor) Run(host Host) (*AnsibleStats, error) {
+	defer host.ssh.Close()
+
+	start := time.Now()
+	hostStats := AnsibleHostStat{}
+	for _, role := range e.roles {
+		if !e.runRole(host, host.ssh, role, nativeRoles[role](e), &hostStats) {
+			break
+		}
+	}
+
+	stats := &AnsibleStats{
+		Duration: float32(time.Since(start).Round(10 * time.Millisecond).Seconds()),
+		Status:   AnsibleStatusOk,
+		Stats:    map[string]AnsibleHostStat{host.RoleName: hostStats},
+	}
+	if hostStats.Failures != 0 {
+		stats.Status = AnsibleStatusFailed
+		return stats, nil
+	}
+	if e.ansible == nil {
+		return stats, nil
+	}
+
+	ansibleStats, err := e.ansible.Run(host)
+	if err != nil || ansibleStats == nil {
+		return ansibleStats, err
+	}
+	ansibleStats.Duration += stats.Duration
+	if s, ok := ansibleStats.Stats[host.RoleName]; ok {
+		s.Ok += hostStats.Ok
+		s.Changed += hostStats.Changed
+		s.Skipped += hostStats.Skipped
+		ansibleStats.Stats[host.RoleName] = s
+	}
+	return ansibleStats, nil
+}
+
+// runRole executes the tasks of a role and restarts the services notified by
+// the tasks that changed the host. Every task is reported like the KubeKit
+// Ansible callback does. It returns false if a task failed
+func (e *nativeExecutor) runRole(host Host, r runner, role string, tasks []nativeTask, stats *AnsibleHostStat) bool {
+	mark := func(tag string) {
+		AnsibleTask{Name: role + " : " + tag, Status: AnsibleStatusOk, Node: host.RoleName}.Report(e.c.ui)
+	}
+
+	mark("<" + role + ">")
+
+	notified := []string{}
+	for _, task := range tasks {
+		changed, ok := e.runTask(host, r, role, task, stats)
+		if !ok {
+			return false
+		}
+		if changed && len(task.notify) != 0 && !contains(notified, task.notify) {
+			notified = append(notified, task.notify)
+		}
+	}
+	for _, service := range notified {
+		if _, ok := e.runTask(host, r, role, restartTask(service), stats); !ok {
+			return false
+		}
+	}
+
+	mark("</" + role + ">")
+
+	return true
+}
+
+// runTask executes a task, reports it and updates the stats. It returns true
+// if the task changed the host and false as second value if the task failed
+func (e *nativeExecutor) runTask(host Host, r runner, role string, task nativeTask, stats *AnsibleHostStat) (bool, bool) {
+	name := role + " : " + task.name
+
+	changed, err := task.exec(r)
+	switch {
+	case err == errTaskSkipped:
+		stats.Skipped++
+		e.c.ui.Log.Debugf("[%s] TASK %q skipped", host.RoleName, name)
+		return false, true
+	case err != nil:
+		stats.Failures++
+		AnsibleTask{Name: name, Status: AnsibleStatusFailed, Node: host.RoleName}.Report(e.c.ui)
+		e.c.ui.Log.Errorf("[%s] %s", host.RoleName, err)
+		return false, false
+	}
+
+	stats.Ok++
+	if changed {
+		stats.Changed++
+	}
+	AnsibleTask{Name: name, Status: AnsibleStatusOk, Changed: changed, Node: host.RoleName}.Report(e.c.ui)
+	return changed, true
+}
+
+// exec executes the task if the `when` command succeeds, otherwise returns
+// errTaskSkipped
+func (t nativeTask) exec(r runner) (bool, error) {
+	if len(t.when) != 0 {
+		ok, err := succeeds(r, t.when)
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			return false, errTaskSkipped
+		}
+	}
+	return t.run(r)
+}
+
+func (e *nativeExecutor) manifestTasks() []nativeTask {
+	return []nativeTask{
+		{
+			name: "assert the KubeKit version is in the manifest",
+			run: func(r runner) (bool, error) {
+				if _, ok := manifest.KubeManifest.Releases[manifest.Version]; !ok {
+					return false, fmt.Errorf("the KubeKit version %s is not in the manifest", manifest.Version)
+				}
+				return false, nil
+			},
+		},
+	}
+}
+
+func (e *nativeExecutor) timesyncdTasks() []nativeTask {
+	if e.vars.CloudProvider == "stacki" {
+		return nil
+	}
+
+	timeServers := e.vars.TimeServers
+	if len(timeServers) == 0 {
+		timeServers = defaultTimeServers
+	}
+	chronyServers := []string{}
+	for _, server := range timeServers {
+		chronyServers = append(chronyServers, fmt.Sprintf("server %s iburst", server))
+	}
+
+	// the time servers are set only if the time is not synchronized by ntpd
+	// or the VMware tools
+	const notSynced = "! systemctl is-active -q ntpd && ! ( vmware-toolbox-cmd timesync status 2>/dev/null | grep -q Enabled )"
+
+	tasks := []nativeTask{}
+	if tz := e.vars.HostTimeZone; len(tz) != 0 {
+		tasks = append(tasks, shellTask("set timezone", fmt.Sprintf("test $(readlink -f /etc/localtime) = /usr/share/zoneinfo/%[1]s || { timedatectl set-timezone %[1]s && echo changed; }", ssh.ShellQuote(tz)), ""))
+	}
+	return append(tasks,
+		linesTask("set timesyncd time servers", "/etc/systemd/timesyncd.conf", `^NTP=`, []string{"NTP=" + strings.Join(timeServers, " ")}, notSynced+" && test -f /etc/systemd/timesyncd.conf", "systemd-timesyncd"),
+		linesTask("set chrony time servers", "/etc/chrony.conf", `^server `, chronyServers, notSynced+" && test -f /etc/chrony.conf", "chronyd"),
+	)
+}
+
+func (e *nativeExecutor) journaldTasks() []nativeTask {
+	const journaldConf = "/etc/systemd/journald.conf"
+	settings := []struct{ key, value string }{
+		{"Storage", "persistent"},
+		{"RuntimeMaxUse", journaldMaxSize},
+		{"SystemMaxUse", journaldMaxSize},
+		{"RuntimeMaxFileSize", journaldLogSize},
+		{"SystemMaxFileSize", journaldLogSize},
+	}
+
+	tasks := []nativeTask{dirTask("/var/log/journal", 0755)}
+	for _, s := range settings {
+		tasks = append(tasks, linesTask("set journald "+s.key, journaldConf, "^"+s.key+"=", []string{s.key + "=" + s.value}, "", "systemd-journald"))
+	}
+	return tasks
+}
+
+func (e *nativeExecutor) rootCertTasks() []nativeTask {
+	return []nativeTask{
+		shellTask("ensure openssl is installed", "openssl version >/dev/null", ""),
+		dirTask(filepath.Join(TLSDirectory, "trust", "anchors"), 0755),
+		// the API server is restarted to load the CA certificates only if they
+		// changed and it's running
+		shellTask("update CA certificates", fmt.Sprintf(`before=$(%[1]s); update-ca-certificates >/dev/null || exit 1; if test "$(%[1]s)" != "$before" && systemctl is-active -q kube-apiserver; then echo changed; fi`, caBundleSum), "kube-apiserver"),
+	}
+}
+
+// caBundleSum is the command to get the checksum of the CA bundle generated by
+// update-ca-certificates, in SUSE and Debian based distributions
+const caBundleSum = "cat /var/lib/ca-certificates/ca-bundle.pem /etc/ssl/certs/ca-certificates.crt 2>/dev/null | sha256sum"
+
+func (e *nativeExecutor) dockerTasks() []nativeTask {
+	return []nativeTask{
+		dirTask("/etc/docker", 0755),
+		roleFileTask("docker/systemd", "usr/lib/systemd/system/logrotate.timer", "logrotate.timer"),
+		shellTask("enforce logrotate started", "systemctl is-enabled -q logrotate.timer && systemctl is-active -q logrotate.timer || { systemctl enable --now logrotate.timer >/dev/null && echo changed; }", ""),
+		roleFileTask("docker/systemd", "etc/rsyslog.d/30-docker.conf", "rsyslog"),
+		{
+			name:   "create /etc/docker/daemon.json",
+			notify: "docker",
+			run: func(r runner) (bool, error) {
+				mtu := dockerMTU
+				device := strings.TrimPrefix(e.vars.ClusterIfaceName, "ansible_")
+				if out, err := sudo(r, fmt.Sprintf("cat /sys/class/net/%s/mtu", device)); err == nil {
+					if n, err := strconv.Atoi(strings.TrimSpace(out)); err == nil {
+						mtu = n
+					}
+				}
+				config, err := json.MarshalIndent(e.dockerDaemonConfig(mtu), "", "    ")
+				if err != nil {
+					return false, fmt.Errorf("failed to create the docker configuration. %s", err)
+				}
+				return writeFile(r, "/etc/docker/daemon.json", string(config)+"\n", 0644)
+			},
+		},
+		shellTask("enforce docker started", "systemctl is-enabled -q docker && systemctl is-active -q docker || { systemctl enable --now docker >/dev/null && echo changed; }", ""),
+	}
+}
+
+// dockerDaemonConfig returns the Docker daemon configuration, the same
+// rendered by the Ansible role
+func (e *nativeExecutor) dockerDaemonConfig(mtu int) map[string]interface{} {
+	dns, dnsSearch := []string{}, []string{}
+	if e.vars.CloudProvider != "stacki" {
+		dns = append(dns, e.vars.DNSServers...)
+		dnsSearch = append(dnsSearch, e.vars.DNSSearch...)
+	}
+	maxDownloads, maxUploads := e.vars.DockerMaxConcurrentDownloads, e.vars.DockerMaxConcurrentUploads
+	if maxDownloads == 0 {
+		maxDownloads = defaultInventoryVariables.DockerMaxConcurrentDownloads
+	}
+	if maxUploads == 0 {
+		maxUploads = defaultInventoryVariables.DockerMaxConcurrentUploads
+	}
+
+	return map[string]interface{}{
+		"bip":        dockerBIP,
+		"dns":        dns,
+		"dns-opts":   []string{},
+		"dns-search": dnsSearch,
+		"iptables":   false,
+		"log-opts": map[string]string{
+			"max-size": e.vars.DockerLogMaxSize,
+			"max-file": e.vars.DockerLogMaxFiles,
+		},
+		"storage-driver":           "overlay2",
+		"live-restore":             true,
+		"ip-masq":                  false,
+		"mtu":                      mtu,
+		"max-concurrent-downloads": maxDownloads,
+		"max-concurrent-uploads":   maxUploads,
+	}
+}
+
+// shellTask executes a command, the host is changed if it prints "changed"
+func shellTask(name, command, notify string) nativeTask {
+	return nativeTask{
+		name:   name,
+		notify: notify,
+		run: func(r runner) (bool, error) {
+			out, err := sudo(r, command)
+			if err != nil {
+				return false, err
+			}
+			return strings.Contains(out, "changed"), nil
+		},
+	}
+}
+
+// dirTask creates a directory if it does not exists
+func dirTask(path string, mode os.FileMode) nativeTask {
+	return shellTask("create "+path, fmt.Sprintf("test -d %[1]s || { mkdir -p %[1]s && chmod %#o %[1]s && echo changed; }", path, mode), "")
+}
+
+// linesTask sets the lines matching the regular expression in a file
+func linesTask(name, path, expr string, lines []string, when, notify string) nativeTask {
+	re := regexp.MustCompile(expr)
+	return nativeTask{
+		name:   name,
+		when:   when,
+		notify: notify,
+		run: func(r runner) (bool, error) {
+			content, found, err := readFile(r, path)
+			if err != nil {
+				return false, err
+			}
+			if !found {
+				return false, fmt.Errorf("not found the file %s", path)
+			}
+			return writeFile(r, path, setLines(content, re, lines), 0644)
+		},
+	}
+}
+
+// roleFileTask copies a file of an Ansible role to the host, the file is
+// taken from the roles files embedded in KubeKit
+func roleFileTask(role, file, notify string) nativeTask {
+	target := "/" + file
+	return nativeTask{
+		name:   "copy " + target,
+		notify: notify,
+		run: func(r runner) (bool, error) {
+			content, err := roleFile(role, file)
+			if err != nil {
+				return false, err
+			}
+			return writeFile(r, target, content, 0644)
+		},
+	}
+}
+
+// restartTask reloads the systemd units and restarts a service
+func restartTask(service string) nativeTask {
+	return nativeTask{
+		name: "reload and restart " + service,
+		run: func(r runner) (bool, error) {
+			_, err := sudo(r, fmt.Sprintf("systemctl daemon-reload && systemctl restart %s", service))
+			return err == nil, err
+		},
+	}
+}
+
+// roleFile returns the content of a file of an Ansible role
+func roleFile(role, file string) (string, error) {
+	zr, err := zip.NewReader(strings.NewReader(Data), int64(len(Data)))
+	if err != nil {
+		return "", fmt.Errorf("failed to read the Ansible roles. %s", err)
+	}
+	name := filepath.Join("roles", role, "files", file)
+	for _, f := range zr.File {
+		if f.Name != name {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return "", fmt.Errorf("failed to open the file %s. %s", name, err)
+		}
+		defer rc.Close()
+		content, err := ioutil.ReadAll(rc)
+		if err != nil {
+			return "", fmt.Errorf("failed to read the file %s. %s", name, err)
+		}
+		return string(content), nil
+	}
+	return "", fmt.Errorf("not found the file %s in the Ansible roles", name)
+}
+
+// setLines replaces the first line matching the regular expression with the
+// given lines and removes the other matching lines. If no line matches, the
+// lines are appended
+func setLines(content string, re *regexp.Regexp, lines []string) string {
+	current := []string{}
+	if len(content) != 0 {
+		current = strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+	}
+
+	result := []string{}
+	replaced := false
+	for _, line := range current {
+		if !re.MatchString(line) {
+			result = append(result, line)
+			continue
+		}
+		if !replaced {
+			result = append(result, lines...)
+			replaced = true
+		}
+	}
+	if !replaced {
+		result = append(result, lines...)
+	}
+
+	return strings.Join(result, "\n") + "\n"
+}
+
+// sudo executes a command as root and returns its output, or an error if it
+// fails
+func sudo(r runner, command string) (string, error) {
+	stdout, stderr, exitStatus, err := r.ExecAndWait("sudo sh -c " + ssh.ShellQuote(command))
+	if err != nil {
+		return "", fmt.Errorf("failed to execute %q. %s", command, err)
+	}
+	if exitStatus != 0 {
+		return "", fmt.Errorf("failed to execute %q, exit status %d. %s", command, exitStatus, strings.TrimSpace(stderr))
+	}
+	return stdout, nil
+}
+
+// succeeds executes a command as root and returns true if it succeeds
+func succeeds(r runner, command string) (bool, error) {
+	_, _, exitStatus, err := r.ExecAndWait("sudo sh -c " + ssh.ShellQuote(command))
+	if err != nil {
+		return false, fmt.Errorf("failed to execute %q. %s", command, err)
+	}
+	return exitStatus == 0, nil
+}
+
+// readFile returns the content of a file, false if the file does not exists
+func readFile(r runner, path string) (string, bool, error) {
+	// the content is enclosed in '+' to keep the trailing new lines
+	out, err := sudo(r, fmt.Sprintf("test -f %[1]s || exit 0; printf +; cat %[1]s; printf +", path))
+	if err != nil {
+		return "", false, err
+	}
+	if len(out) == 0 {
+		return "", false, nil
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(out, "+"), "+"), true, nil
+}
+
+// writeFile writes the content to a file if it's different, it returns true
+// if the file was changed
+func writeFile(r runner, path, content string, mode os.FileMode) (bool, error) {
+	current, found, err := readFile(r, path)
+	if err != nil {
+		return false, err
+	}
+	if found && current == content {
+		return false, nil
+	}
+
+	data := base64.StdEncoding.EncodeToString([]byte(content))
+	if _, err := sudo(r, fmt.Sprintf("echo %s | base64 -d > %s && chmod %#o %s", data, path, mode, path)); err != nil {
+		return false, fmt.Errorf("failed to write the file %s. %s", path, err)
+	}
+	return true, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,208 @@
+package configurator
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/johandry/log"
+	"github.com/kraken/ui"
+)
+
+// localRunner executes the commands on the local host, without sudo
+type localRunner struct{}
+
+func (localRunner) ExecAndWait(command string) (string, string, int, error) {
+	cmd := exec.Command("sh", "-c", strings.TrimPrefix(command, "sudo "))
+	var stdout, stderr strings.Builder
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	err := cmd.Run()
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		return stdout.String(), stderr.String(), exitErr.ExitCode(), nil
+	}
+	return strings.TrimRight(stdout.String(), "\n"), stderr.String(), 0, err
+}
+
+// fakeRunner records the commands and fails the commands containing fail
+type fakeRunner struct {
+	commands []string
+	fail     string
+}
+
+func (r *fakeRunner) ExecAndWait(command string) (string, string, int, error) {
+	r.commands = append(r.commands, command)
+	if len(r.fail) != 0 && strings.Contains(command, r.fail) {
+		return "", "failed", 1, nil
+	}
+	if strings.Contains(command, "echo changed") {
+		return "changed", "", 0, nil
+	}
+	return "", "", 0, nil
+}
+
+func TestSelectRoles(t *testing.T) {
+	roles, err := playbookRoles()
+	if err != nil {
+		t.Fatalf("playbookRoles() error = %v", err)
+	}
+
+	names := func(roles []playbookRole) []string {
+		n := []string{}
+		for _, role := range roles {
+			n = append(n, role.Name)
+		}
+		return n
+	}
+
+	tests := []struct {
+		name string
+		tags []string
+		want []string
+	}{
+		{"no tags", nil, names(roles)},
+		{"one role", []string{"journald"}, []string{"journald"}},
+		{"shared tag", []string{"docker"}, []string{"docker/systemd", "docker/registry"}},
+		{"role tag", []string{"docker/systemd"}, []string{"docker/systemd"}},
+		{"several tags", []string{"root-cert", "manifest"}, []string{"manifest", "root-cert"}},
+		{"unknown tag", []string{"foo"}, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := names(selectRoles(roles, tt.tags)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("selectRoles() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetLines(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		expr    string
+		lines   []string
+		want    string
+	}{
+		{"empty", "", `^NTP=`, []string{"NTP=a b"}, "NTP=a b\n"},
+		{"append", "[Time]\n#NTP=\n", `^NTP=`, []string{"NTP=a b"}, "[Time]\n#NTP=\nNTP=a b\n"},
+		{"replace", "[Time]\nNTP=c\nFallbackNTP=d\n", `^NTP=`, []string{"NTP=a b"}, "[Time]\nNTP=a b\nFallbackNTP=d\n"},
+		{"same", "[Time]\nNTP=a b\n", `^NTP=`, []string{"NTP=a b"}, "[Time]\nNTP=a b\n"},
+		{"several lines", "driftfile x\nserver a iburst\nserver b iburst\nmakestep 1\n", `^server `, []string{"server c iburst", "server d iburst", "server e iburst"}, "driftfile x\nserver c iburst\nserver d iburst\nserver e iburst\nmakestep 1\n"},
+		{"no trailing new line", "Storage=auto", `^Storage=`, []string{"Storage=persistent"}, "Storage=persistent\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := setLines(tt.content, regexp.MustCompile(tt.expr), tt.lines); got != tt.want {
+				t.Errorf("setLines() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLinesTask(t *testing.T) {
+	dir, err := ioutil.TempDir("", "native")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "journald.conf")
+	if err := ioutil.WriteFile(path, []byte("[Journal]\n#Storage=auto\nStorage=volatile\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	task := linesTask("set journald Storage", path, `^Storage=`, []string{"Storage=persistent"}, "", "")
+
+	tests := []struct {
+		name        string
+		wantChanged bool
+	}{
+		{"first execution", true},
+		{"second execution", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changed, err := task.exec(localRunner{})
+			if err != nil {
+				t.Fatalf("exec() error = %v", err)
+			}
+			if changed != tt.wantChanged {
+				t.Errorf("exec() changed = %v, want %v", changed, tt.wantChanged)
+			}
+			content, _ := ioutil.ReadFile(path)
+			if want := "[Journal]\n#Storage=auto\nStorage=persistent\n"; string(content) != want {
+				t.Errorf("content = %q, want %q", content, want)
+			}
+		})
+	}
+
+	missing := linesTask("set time servers", filepath.Join(dir, "chrony.conf"), `^server `, []string{"server a iburst"}, "test -f "+filepath.Join(dir, "chrony.conf"), "")
+	if _, err := missing.exec(localRunner{}); err != errTaskSkipped {
+		t.Errorf("exec() error = %v, want %v", err, errTaskSkipped)
+	}
+}
+
+func TestNativeExecutor_runRole(t *testing.T) {
+	tasks := []nativeTask{
+		shellTask("change", "echo changed", "foo"),
+		shellTask("no change", "true", "bar"),
+		shellTask("change again", "echo changed", "foo"),
+	}
+
+	tests := []struct {
+		name      string
+		fail      string
+		want      bool
+		wantStats AnsibleHostStat
+		wantLast  string
+	}{
+		{"restart the notified services", "", true, AnsibleHostStat{Ok: 4, Changed: 3}, "systemctl restart foo"},
+		{"stop when a task fails", "true", false, AnsibleHostStat{Ok: 1, Changed: 1, Failures: 1}, "true"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &nativeExecutor{c: &Configurator{ui: ui.New(false, log.NewDefault())}}
+			r := &fakeRunner{fail: tt.fail}
+			stats := AnsibleHostStat{}
+
+			if got := e.runRole(Host{RoleName: "worker"}, r, "test", tasks, &stats); got != tt.want {
+				t.Errorf("runRole() = %v, want %v", got, tt.want)
+			}
+			if stats != tt.wantStats {
+				t.Errorf("runRole() stats = %+v, want %+v", stats, tt.wantStats)
+			}
+			if last := r.commands[len(r.commands)-1]; !strings.Contains(last, tt.wantLast) {
+				t.Errorf("runRole() last command = %q, want it to contain %q", last, tt.wantLast)
+			}
+		})
+	}
+}
+
+func TestRoleFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		role    string
+		file    string
+		wantErr bool
+	}{
+		{"logrotate timer", "docker/systemd", "usr/lib/systemd/system/logrotate.timer", false},
+		{"rsyslog", "docker/systemd", "etc/rsyslog.d/30-docker.conf", false},
+		{"missing", "docker/systemd", "etc/missing", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := roleFile(tt.role, tt.file)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("roleFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && len(got) == 0 {
+				t.Errorf("roleFile() is empty")
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package configurator
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// Results of the configuration of a host
+const (
+	hostConfigured = "configured"
+	hostFailed     = "failed"
+	hostNoStats    = "no stats"
+	hostSkipped    = "skipped"
+)
+
+// hostResult is the outcome of the configuration of a host
+type hostResult struct {
+	host     Host
+	batch    int
+	status   string
+	duration float32
+}
+
+// RolloutError is returned when the number of hosts that failed to be
+// configured exceeds the maximum failure percentage of the rollout
+type RolloutError struct {
+	Failed      []string
+	Total       int
+	MaxFailures int
+}
+
+func (e *RolloutError) Error() string {
+	return fmt.Sprintf("failed to configure %d of %d nodes (%s), more than the %d failures allowed by rollout_max_fail_percentage", len(e.Failed), e.Total, strings.Join(e.Failed, ", "), e.MaxFailures)
+}
+
+// batchSize returns the number of hosts to configure at the same time out of
+// the given total, set in `rollout_batch_size` as a number or a percentage
+// such as "25%". All the hosts are configured at the same time if it's not set
+func (c *Config) batchSize(total int) (int, error) {
+	if c == nil || len(c.RolloutBatchSize) == 0 {
+		return total, nil
+	}
+
+	size := strings.TrimSpace(c.RolloutBatchSize)
+	if strings.HasSuffix(size, "%") {
+		pct, err := strconv.ParseFloat(strings.TrimSuffix(size, "%"), 64)
+		if err != nil || pct <= 0 || pct > 100 {
+			return 0, fmt.Errorf("invalid rollout_batch_size %q, the percentage has to be greater than 0%% and up to 100%%", c.RolloutBatchSize)
+		}
+		n := int(float64(total) * pct / 100)
+		if n == 0 {
+			n = 1
+		}
+		return n, nil
+	}
+
+	n, err := strconv.Atoi(size)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid rollout_batch_size %q, it has to be a number of nodes greater than 0 or a percentage such as 25%%", c.RolloutBatchSize)
+	}
+	return n, nil
+}
+
+// maxFailures returns the number of hosts out of the given total that can
+// fail before the rollout is stopped
+func (c *Config) maxFailures(total int) (int, error) {
+	if c == nil {
+		return 0, nil
+	}
+	if c.RolloutMaxFailPercentage < 0 || c.RolloutMaxFailPercentage > 100 {
+		return 0, fmt.Errorf("invalid rollout_max_fail_percentage %d, it has to be between 0 and 100", c.RolloutMaxFailPercentage)
+	}
+	return total * c.RolloutMaxFailPercentage / 100, nil
+}
+
+// mastersFirst returns true if the masters are configured in their own batch
+// before the other nodes, the default
+func (c *Config) mastersFirst() bool {
+	return c == nil || c.RolloutMastersFirst == nil || *c.RolloutMastersFirst
+}
+
+// rolloutBatches splits the hosts in the batches to configure one after the
+// other. The masters wait for each other to form the control plane, so all of
+// them are always in the first batch, alone if `rollout_masters_first` is
+// true, or with the first nodes otherwise
+func (c *Config) rolloutBatches(hosts Hosts) ([]Hosts, error) {
+	size, err := c.batchSize(len(hosts))
+	if err != nil {
+		return nil, err
+	}
+
+	masters := hosts.FilterByRolePrefix("master")
+	others := hosts.Difference(masters)
+
+	batches := []Hosts{}
+	if len(masters) != 0 {
+		first := masters
+		if !c.mastersFirst() {
+			n := size - len(masters)
+			if n < 0 {
+				n = 0
+			}
+			if n > len(others) {
+				n = len(others)
+			}
+			first = append(first, others[:n]...)
+			others = others[n:]
+		}
+		batches = append(batches, first)
+	}
+
+	for len(others) != 0 {
+		n := size
+		if n > len(others) {
+			n = len(others)
+		}
+		batches = append(batches, others[:n])
+		others = others[n:]
+	}
+
+	return batches, nil
+}
+
+// rolloutSummary returns a table with the result of the configuration of
+// every host
+func rolloutSummary(results []hostResult) string {
+	var b bytes.Buffer
+	w := tabwriter.NewWriter(&b, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "NODE\tIP\tBATCH\tRESULT\tDURATION")
+	for _, r := range results {
+		duration := "-"
+		if r.status == hostConfigured || r.status == hostFailed {
+			duration = fmt.Sprintf("%gs", r.duration)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n", r.host.RoleName, r.host.PublicIP, r.batch+1, r.status, duration)
+	}
+	w.Flush()
+
+	return b.String()
+}
@@ -0,0 +1,147 @@
+package configurator
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/johandry/log"
+	"github.com/kraken/ui"
+)
+
+func newHosts(masters, workers int) Hosts {
+	hosts := Hosts{}
+	for i := 0; i < masters; i++ {
+		hosts = append(hosts, Host{RoleName: fmt.Sprintf("master%03d", i), PublicIP: fmt.Sprintf("10.0.0.%d", i)})
+	}
+	for i := 0; i < workers; i++ {
+		hosts = append(hosts, Host{RoleName: fmt.Sprintf("worker%03d", i), PublicIP: fmt.Sprintf("10.0.1.%d", i)})
+	}
+	return hosts
+}
+
+func roleNames(batches []Hosts) [][]string {
+	names := [][]string{}
+	for _, batch := range batches {
+		batchNames := []string{}
+		for _, host := range batch {
+			batchNames = append(batchNames, host.RoleName)
+		}
+		names = append(names, batchNames)
+	}
+	return names
+}
+
+func TestConfig_rolloutBatches(t *testing.T) {
+	notMastersFirst := false
+
+	tests := []struct {
+		name    string
+		config  *Config
+		hosts   Hosts
+		want    [][]string
+		wantErr bool
+	}{
+		{"no config", nil, newHosts(1, 2), [][]string{{"master000"}, {"worker000", "worker001"}}, false},
+		{"all at once", &Config{}, newHosts(2, 2), [][]string{{"master000", "master001"}, {"worker000", "worker001"}}, false},
+		{"batches of 2", &Config{RolloutBatchSize: "2"}, newHosts(1, 5), [][]string{{"master000"}, {"worker000", "worker001"}, {"worker002", "worker003"}, {"worker004"}}, false},
+		{"masters are not split", &Config{RolloutBatchSize: "1"}, newHosts(3, 1), [][]string{{"master000", "master001", "master002"}, {"worker000"}}, false},
+		{"percentage", &Config{RolloutBatchSize: "50%"}, newHosts(2, 2), [][]string{{"master000", "master001"}, {"worker000", "worker001"}}, false},
+		{"small percentage", &Config{RolloutBatchSize: "10%"}, newHosts(1, 2), [][]string{{"master000"}, {"worker000"}, {"worker001"}}, false},
+		{"masters with workers", &Config{RolloutBatchSize: "3", RolloutMastersFirst: &notMastersFirst}, newHosts(1, 4), [][]string{{"master000", "worker000", "worker001"}, {"worker002", "worker003"}}, false},
+		{"only workers", &Config{RolloutBatchSize: "2"}, newHosts(0, 3), [][]string{{"worker000", "worker001"}, {"worker002"}}, false},
+		{"invalid number", &Config{RolloutBatchSize: "0"}, newHosts(1, 1), nil, true},
+		{"invalid percentage", &Config{RolloutBatchSize: "150%"}, newHosts(1, 1), nil, true},
+		{"not a number", &Config{RolloutBatchSize: "half"}, newHosts(1, 1), nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.config.rolloutBatches(tt.hosts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Config.rolloutBatches() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if names := roleNames(got); !reflect.DeepEqual(names, tt.want) {
+				t.Errorf("Config.rolloutBatches() = %v, want %v", names, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfig_maxFailures(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *Config
+		total   int
+		want    int
+		wantErr bool
+	}{
+		{"no config", nil, 10, 0, false},
+		{"no failures allowed", &Config{}, 10, 0, false},
+		{"25 percent", &Config{RolloutMaxFailPercentage: 25}, 10, 2, false},
+		{"all", &Config{RolloutMaxFailPercentage: 100}, 10, 10, false},
+		{"invalid", &Config{RolloutMaxFailPercentage: 101}, 10, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.config.maxFailures(tt.total)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Config.maxFailures() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Config.maxFailures() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// fakeExecutor returns the stats of the hosts in the map, the hosts without
+// stats are reported as unreachable
+type fakeExecutor struct {
+	stats map[string]*AnsibleStats
+}
+
+func (e *fakeExecutor) Setup() error { return nil }
+
+func (e *fakeExecutor) Run(host Host) (*AnsibleStats, error) {
+	return e.stats[host.RoleName], nil
+}
+
+func TestConfigurator_RunPlaybook(t *testing.T) {
+	ok := &AnsibleStats{Duration: 1, Status: AnsibleStatusOk, Stats: map[string]AnsibleHostStat{"localhost": {Ok: 1}}}
+	hosts := newHosts(1, 3)
+
+	tests := []struct {
+		name       string
+		config     *Config
+		stats      map[string]*AnsibleStats
+		wantFailed []string
+		wantErr    bool
+	}{
+		{"all configured", &Config{}, map[string]*AnsibleStats{"master000": ok, "worker000": ok, "worker001": ok, "worker002": ok}, nil, false},
+		{"unreachable", &Config{}, map[string]*AnsibleStats{"master000": ok, "worker000": ok, "worker001": ok}, []string{"worker002"}, true},
+		{"unreachable allowed", &Config{RolloutMaxFailPercentage: 25}, map[string]*AnsibleStats{"master000": ok, "worker000": ok, "worker001": ok}, nil, false},
+		{"unreachable not allowed", &Config{RolloutMaxFailPercentage: 25}, map[string]*AnsibleStats{"master000": ok, "worker000": ok}, []string{"worker001", "worker002"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Configurator{Hosts: hosts, config: tt.config, ui: ui.New(false, log.NewDefault())}
+			err := c.RunPlaybook(&fakeExecutor{stats: tt.stats})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Configurator.RunPlaybook() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil {
+				return
+			}
+			rolloutErr, isRolloutErr := err.(*RolloutError)
+			if !isRolloutErr {
+				t.Fatalf("Configurator.RunPlaybook() error = %v, want a RolloutError", err)
+			}
+			if !reflect.DeepEqual(rolloutErr.Failed, tt.wantFailed) {
+				t.Errorf("Configurator.RunPlaybook() failed = %v, want %v", rolloutErr.Failed, tt.wantFailed)
+			}
+		})
+	}
+}
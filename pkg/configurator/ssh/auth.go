@@ -0,0 +1,268 @@
+package ssh
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// Authentication methods to use in the platform parameter `ssh_auth_methods`
+const (
+	AgentAuth      = "agent"
+	PrivateKeyAuth = "private_key"
+	PasswordAuth   = "password"
+)
+
+// DefaultAuthMethods are the authentication methods to use, in order, when the
+// cluster does not set them
+var DefaultAuthMethods = []string{PrivateKeyAuth, PasswordAuth}
+
+// PassphraseEnv is the environment variable with the passphrase of the
+// encrypted private keys. If not set, the passphrase is asked in the terminal
+const PassphraseEnv = "KUBEKIT_SSH_KEY_PASSPHRASE"
+
+// Auth are the credentials to authenticate to the hosts
+type Auth struct {
+	// Methods are the authentication methods to try, in order. If empty, the
+	// DefaultAuthMethods are used
+	Methods []string
+	// PrivateKeys are the decrypted private keys to try, in order
+	PrivateKeys []string
+	Password    string
+}
+
+var (
+	agentMu     sync.Mutex
+	agentSocket string
+	agentClient agent.Agent
+
+	passphraseMu sync.Mutex
+	passphrases  = map[string][]byte{}
+)
+
+// ValidateAuthMethods returns an error if any of the given authentication
+// methods is unknown
+func ValidateAuthMethods(methods []string) error {
+	for _, method := range methods {
+		switch method {
+		case AgentAuth, PrivateKeyAuth, PasswordAuth:
+		default:
+			return fmt.Errorf("unknown SSH authentication method %q, the valid methods are: %s, %s and %s", method, AgentAuth, PrivateKeyAuth, PasswordAuth)
+		}
+	}
+	return nil
+}
+
+// HasMethod returns true if the given authentication method is used
+func (a *Auth) HasMethod(method string) bool {
+	for _, m := range a.methods() {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *Auth) methods() []string {
+	if len(a.Methods) == 0 {
+		return DefaultAuthMethods
+	}
+	return a.Methods
+}
+
+// authMethods returns the SSH authentication methods in the order set in the
+// credentials, skipping the methods without credentials
+func (a *Auth) authMethods() ([]ssh.AuthMethod, error) {
+	if err := ValidateAuthMethods(a.Methods); err != nil {
+		return nil, err
+	}
+
+	auth := []ssh.AuthMethod{}
+	for _, method := range a.methods() {
+		switch method {
+		case AgentAuth:
+			sshAgent, err := Agent()
+			if err != nil {
+				return nil, err
+			}
+			auth = append(auth, ssh.PublicKeysCallback(sshAgent.Signers))
+		case PrivateKeyAuth:
+			signers := []ssh.Signer{}
+			for _, key := range a.PrivateKeys {
+				if len(key) == 0 {
+					continue
+				}
+				signer, err := ssh.ParsePrivateKey([]byte(key))
+				if err != nil {
+					return nil, err
+				}
+				signers = append(signers, signer)
+			}
+			if len(signers) != 0 {
+				auth = append(auth, ssh.PublicKeys(signers...))
+			}
+		case PasswordAuth:
+			if len(a.Password) == 0 {
+				continue
+			}
+			password := a.Password
+			sshInteractive := func(user, instruction string, questions []string, echos []bool) (answers []string, err error) {
+				answers = make([]string, len(questions))
+				for n := range questions {
+					answers[n] = password
+				}
+
+				return answers, nil
+			}
+
+			auth = append(auth, ssh.Password(password))
+			// In case 'PasswordAuthentication' is set to No in /etc/ssh/sshd_config at the server, try with interactive method
+			auth = append(auth, ssh.KeyboardInteractive(sshInteractive))
+		}
+	}
+
+	return auth, nil
+}
+
+// Agent returns the client of the SSH agent listening in the socket set in
+// the environment variable SSH_AUTH_SOCK. The connection is shared by all the
+// hosts
+func Agent() (agent.Agent, error) {
+	socket := os.Getenv("SSH_AUTH_SOCK")
+	if len(socket) == 0 {
+		return nil, fmt.Errorf("the SSH agent authentication requires a running SSH agent, SSH_AUTH_SOCK is not set")
+	}
+
+	agentMu.Lock()
+	defer agentMu.Unlock()
+
+	if agentClient != nil && agentSocket == socket {
+		return agentClient, nil
+	}
+
+	conn, err := net.Dial("unix", socket)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the SSH agent at %s. %s", socket, err)
+	}
+	agentSocket, agentClient = socket, agent.NewClient(conn)
+
+	return agentClient, nil
+}
+
+// AgentPublicKey returns the first public key held by the SSH agent in the
+// authorized keys format
+func AgentPublicKey() ([]byte, error) {
+	sshAgent, err := Agent()
+	if err != nil {
+		return nil, err
+	}
+	keys, err := sshAgent.List()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the SSH agent keys. %s", err)
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("the SSH agent does not have any key, add one with 'ssh-add'")
+	}
+	return ssh.MarshalAuthorizedKey(keys[0]), nil
+}
+
+// IsEncryptedPrivateKey returns true if the given private key is protected with
+// a passphrase, either in the PEM or the OpenSSH format
+func IsEncryptedPrivateKey(key []byte) bool {
+	_, err := ssh.ParseRawPrivateKey(key)
+	_, ok := err.(*ssh.PassphraseMissingError)
+	return ok
+}
+
+// DecryptPrivateKey returns the given private key decrypted with the
+// passphrase from the environment variable PassphraseEnv or the terminal. The
+// name identifies the key when the passphrase is requested. The key is
+// returned as it is if it's not encrypted
+func DecryptPrivateKey(name string, key []byte) ([]byte, error) {
+	if !IsEncryptedPrivateKey(key) {
+		return key, nil
+	}
+
+	passphrase, err := Passphrase(name)
+	if err != nil {
+		return nil, err
+	}
+	rawKey, err := ssh.ParseRawPrivateKeyWithPassphrase(key, passphrase)
+	if err != nil {
+		forgetPassphrase(name)
+		return nil, fmt.Errorf("failed to decrypt the private key %s. %s", name, err)
+	}
+
+	return marshalPrivateKey(rawKey)
+}
+
+// marshalPrivateKey returns the given decrypted private key in the PEM format,
+// or the OpenSSH format for the ed25519 keys
+func marshalPrivateKey(key interface{}) ([]byte, error) {
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return pem.EncodeToMemory(&pem.Block{
+			Type:  "RSA PRIVATE KEY",
+			Bytes: x509.MarshalPKCS1PrivateKey(k),
+		}), nil
+	case *ecdsa.PrivateKey:
+		der, err := x509.MarshalECPrivateKey(k)
+		if err != nil {
+			return nil, err
+		}
+		return pem.EncodeToMemory(&pem.Block{
+			Type:  "EC PRIVATE KEY",
+			Bytes: der,
+		}), nil
+	case *ed25519.PrivateKey:
+		return MarshalED25519PrivateKey(*k, "")
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+}
+
+// Passphrase returns the passphrase of the given private key, taken from the
+// environment variable PassphraseEnv or requested in the terminal. It's
+// requested only once per key
+func Passphrase(name string) ([]byte, error) {
+	if passphrase, ok := os.LookupEnv(PassphraseEnv); ok {
+		return []byte(passphrase), nil
+	}
+
+	passphraseMu.Lock()
+	defer passphraseMu.Unlock()
+
+	if passphrase, ok := passphrases[name]; ok {
+		return passphrase, nil
+	}
+
+	fd := int(os.Stdin.Fd())
+	if !terminal.IsTerminal(fd) {
+		return nil, fmt.Errorf("the private key %s is encrypted, set the passphrase in the environment variable %s", name, PassphraseEnv)
+	}
+	fmt.Fprintf(os.Stderr, "Enter passphrase for %s: ", name)
+	passphrase, err := terminal.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the passphrase of the private key %s. %s", name, err)
+	}
+	passphrases[name] = passphrase
+
+	return passphrase, nil
+}
+
+func forgetPassphrase(name string) {
+	passphraseMu.Lock()
+	defer passphraseMu.Unlock()
+	delete(passphrases, name)
+}
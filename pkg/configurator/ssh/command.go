@@ -3,6 +3,7 @@ package ssh
 import (
 	"bytes"
 	"encoding/json"
+	"strings"
 	"sync"
 
 	toml "github.com/pelletier/go-toml"
@@ -23,6 +24,9 @@ type HostCommandResult struct {
 	Stdout     string `json:"stdout" yaml:"stdout" toml:"stdout" mapstructure:"stdout"`
 	Stderr     string `json:"stderr" yaml:"stderr" toml:"stderr" mapstructure:"stderr"`
 	ExitStatus int    `json:"exitstatus" yaml:"exitstatus" toml:"exitstatus" mapstructure:"exitstatus"`
+	// Error is the reason the command could not be executed in the host, such as
+	// the host is not reachable. The ExitStatus is -1
+	Error string `json:"error,omitempty" yaml:"error,omitempty" toml:"error,omitempty" mapstructure:"error"`
 }
 
 // HostCommandResultMap is a type safe map wrapped with mutex locks around get/set calls
@@ -85,3 +89,9 @@ func (rm *HostCommandResultMap) GetSnapshot() map[string]*HostCommandResult {
 	defer rm.RUnlock()
 	return rm.Results
 }
+
+// ShellQuote returns the given string quoted with single quotes to be used as a
+// single word in a shell command line, the single quotes in it are escaped
+func ShellQuote(s string) string {
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+}
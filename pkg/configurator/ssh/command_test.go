@@ -0,0 +1,34 @@
+package ssh
+
+import (
+	"os/exec"
+	"testing"
+)
+
+func TestShellQuote(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"plain", "systemctl restart kubelet", `'systemctl restart kubelet'`},
+		{"empty", "", `''`},
+		{"single quotes", "echo 'it''s'", `'echo '\''it'\'''\''s'\'''`},
+		{"expansions", `echo "$HOME" $(id -u) \n`, `'echo "$HOME" $(id -u) \n'`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ShellQuote(tt.s)
+			if got != tt.want {
+				t.Errorf("ShellQuote() = %s, want %s", got, tt.want)
+			}
+			out, err := exec.Command("sh", "-c", "printf %s "+got).Output()
+			if err != nil {
+				t.Fatalf("failed to execute the quoted string. %s", err)
+			}
+			if string(out) != tt.s {
+				t.Errorf("ShellQuote() unquoted by the shell = %q, want %q", out, tt.s)
+			}
+		})
+	}
+}
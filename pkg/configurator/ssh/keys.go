@@ -0,0 +1,87 @@
+package ssh
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/pem"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// Types of SSH keys to generate, set in the platform parameter `ssh_key_type`
+const (
+	RSAKey     = "rsa"
+	ED25519Key = "ed25519"
+)
+
+// MarshalED25519PrivateKey returns the given ed25519 private key in the
+// OpenSSH private key format, not encrypted
+func MarshalED25519PrivateKey(key ed25519.PrivateKey, comment string) ([]byte, error) {
+	publicKey, err := ssh.NewPublicKey(key.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	var check uint32
+	if err := binary.Read(rand.Reader, binary.BigEndian, &check); err != nil {
+		return nil, err
+	}
+
+	privKey := struct {
+		Check1  uint32
+		Check2  uint32
+		Keytype string
+		Pub     []byte
+		Priv    []byte
+		Comment string
+		Pad     []byte `ssh:"rest"`
+	}{
+		Check1:  check,
+		Check2:  check,
+		Keytype: ssh.KeyAlgoED25519,
+		Pub:     key.Public().(ed25519.PublicKey),
+		Priv:    key,
+		Comment: comment,
+	}
+	// the private key block is padded to the block size of the cipher, 8 for none
+	padLen := (8 - len(ssh.Marshal(privKey))%8) % 8
+	for i := 0; i < padLen; i++ {
+		privKey.Pad = append(privKey.Pad, byte(i+1))
+	}
+
+	keyBlock := struct {
+		CipherName   string
+		KdfName      string
+		KdfOpts      string
+		NumKeys      uint32
+		PubKey       []byte
+		PrivKeyBlock []byte
+	}{
+		CipherName:   "none",
+		KdfName:      "none",
+		NumKeys:      1,
+		PubKey:       publicKey.Marshal(),
+		PrivKeyBlock: ssh.Marshal(privKey),
+	}
+
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  "OPENSSH PRIVATE KEY",
+		Bytes: append([]byte("openssh-key-v1\x00"), ssh.Marshal(keyBlock)...),
+	}), nil
+}
+
+// AuthorizedKey returns the public key of the given private key in the
+// authorized keys format. The name identifies the private key if it's
+// encrypted and the passphrase is requested
+func AuthorizedKey(name string, privateKey []byte) ([]byte, error) {
+	privateKey, err := DecryptPrivateKey(name, privateKey)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := ssh.ParsePrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return ssh.MarshalAuthorizedKey(signer.PublicKey()), nil
+}
@@ -0,0 +1,348 @@
+package ssh
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// KnownHostsFilename is the name of the file, in the cluster directory, with
+// the pinned SSH host keys of the cluster nodes
+const KnownHostsFilename = "known_hosts"
+
+const (
+	beginHostKeys = "-----BEGIN SSH HOST KEY KEYS-----"
+	endHostKeys   = "-----END SSH HOST KEY KEYS-----"
+)
+
+// KnownHosts is a store of the SSH host keys of the cluster nodes in the
+// OpenSSH known_hosts format. The key of a host is pinned the first time
+// KubeKit connects to it (trust on first use), later connections fail if the
+// host key is different
+type KnownHosts struct {
+	filename string
+	lines    []string
+	mu       sync.Mutex
+}
+
+// HostKey is a pinned host key
+type HostKey struct {
+	Host        string `json:"host" yaml:"host"`
+	Type        string `json:"type" yaml:"type"`
+	Fingerprint string `json:"fingerprint" yaml:"fingerprint"`
+}
+
+// HostKeyError is returned when the key of a host does not match the pinned key
+type HostKeyError struct {
+	Host        string
+	Type        string
+	Fingerprint string
+}
+
+func (e *HostKeyError) Error() string {
+	return fmt.Sprintf("the host key of %s has changed (%s %s), someone could be doing a man-in-the-middle attack. If the node was replaced remove the old key with 'kubekit delete hostkeys CLUSTER-NAME %s'", e.Host, e.Type, e.Fingerprint, e.Host)
+}
+
+// NewKnownHosts returns the known hosts store in the given file. If the
+// filename is empty the host keys are only kept in memory
+func NewKnownHosts(filename string) *KnownHosts {
+	return &KnownHosts{
+		filename: filename,
+	}
+}
+
+// HostKeyCallback returns the callback to verify the host key of a SSH server.
+// An unknown host key is added to the store, a known host with a different key
+// is rejected
+func (kh *KnownHosts) HostKeyCallback() ssh.HostKeyCallback {
+	return func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+		kh.mu.Lock()
+		defer kh.mu.Unlock()
+
+		host := knownhosts.Normalize(hostname)
+		lines, err := kh.read()
+		if err != nil {
+			return err
+		}
+
+		known := false
+		for _, line := range lines {
+			hosts, pubKey := parseLine(line)
+			if pubKey == nil || !contains(hosts, host) {
+				continue
+			}
+			if bytes.Equal(pubKey.Marshal(), key.Marshal()) {
+				return nil
+			}
+			known = true
+		}
+		if known {
+			return &HostKeyError{
+				Host:        host,
+				Type:        key.Type(),
+				Fingerprint: ssh.FingerprintSHA256(key),
+			}
+		}
+
+		return kh.write(append(lines, knownhosts.Line([]string{host}, key)))
+	}
+}
+
+// HostKeyAlgorithms returns the type of the pinned keys of the given host, so
+// the server offers one of them. Returns nil if the host is unknown
+func (kh *KnownHosts) HostKeyAlgorithms(address string) []string {
+	kh.mu.Lock()
+	defer kh.mu.Unlock()
+
+	lines, err := kh.read()
+	if err != nil {
+		return nil
+	}
+
+	host := knownhosts.Normalize(address)
+	var algorithms []string
+	for _, line := range lines {
+		hosts, pubKey := parseLine(line)
+		if pubKey != nil && contains(hosts, host) && !contains(algorithms, pubKey.Type()) {
+			algorithms = append(algorithms, pubKey.Type())
+		}
+	}
+
+	return algorithms
+}
+
+// Add pins the given keys, in the authorized_keys format, to the given host.
+// The keys replace the pinned keys of the same type, this is used to pre-seed
+// the store with the host keys reported by the platform
+func (kh *KnownHosts) Add(address string, authorizedKeys ...string) error {
+	keys := []ssh.PublicKey{}
+	for _, authorizedKey := range authorizedKeys {
+		key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(authorizedKey))
+		if err != nil {
+			return fmt.Errorf("failed to parse the host key of %s. %s", address, err)
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+
+	kh.mu.Lock()
+	defer kh.mu.Unlock()
+
+	lines, err := kh.read()
+	if err != nil {
+		return err
+	}
+
+	host := knownhosts.Normalize(address)
+	newLines := []string{}
+	for _, line := range lines {
+		hosts, pubKey := parseLine(line)
+		if pubKey != nil && contains(hosts, host) && hasType(keys, pubKey.Type()) {
+			continue
+		}
+		newLines = append(newLines, line)
+	}
+	for _, key := range keys {
+		newLines = append(newLines, knownhosts.Line([]string{host}, key))
+	}
+
+	return kh.write(newLines)
+}
+
+// Remove deletes the pinned keys of the given hosts. Returns the number of
+// deleted keys
+func (kh *KnownHosts) Remove(addresses ...string) (int, error) {
+	kh.mu.Lock()
+	defer kh.mu.Unlock()
+
+	lines, err := kh.read()
+	if err != nil {
+		return 0, err
+	}
+
+	hostsToRemove := make([]string, len(addresses))
+	for i, address := range addresses {
+		hostsToRemove[i] = knownhosts.Normalize(address)
+	}
+
+	removed := 0
+	newLines := []string{}
+	for _, line := range lines {
+		hosts, pubKey := parseLine(line)
+		if pubKey != nil && containsAny(hosts, hostsToRemove) {
+			removed++
+			continue
+		}
+		newLines = append(newLines, line)
+	}
+	if removed == 0 {
+		return 0, nil
+	}
+
+	return removed, kh.write(newLines)
+}
+
+// List returns the pinned host keys sorted by host
+func (kh *KnownHosts) List() ([]HostKey, error) {
+	kh.mu.Lock()
+	defer kh.mu.Unlock()
+
+	lines, err := kh.read()
+	if err != nil {
+		return nil, err
+	}
+
+	keys := []HostKey{}
+	for _, line := range lines {
+		hosts, pubKey := parseLine(line)
+		if pubKey == nil {
+			continue
+		}
+		for _, host := range hosts {
+			keys = append(keys, HostKey{
+				Host:        host,
+				Type:        pubKey.Type(),
+				Fingerprint: ssh.FingerprintSHA256(pubKey),
+			})
+		}
+	}
+	sort.SliceStable(keys, func(i, j int) bool {
+		return keys[i].Host < keys[j].Host
+	})
+
+	return keys, nil
+}
+
+// HostKeyAddress returns the address of the given host and SSH port as it's in
+// the known hosts store, i.e. `[10.0.0.5]:2222`. If the address has a port it's
+// not replaced, if the port is zero the DefaultPort is used
+func HostKeyAddress(address string, port int) string {
+	if _, _, err := net.SplitHostPort(address); err == nil {
+		return knownhosts.Normalize(address)
+	}
+	if port == 0 {
+		port = DefaultPort
+	}
+	return knownhosts.Normalize(net.JoinHostPort(strings.Trim(address, "[]"), strconv.Itoa(port)))
+}
+
+// ParseHostKeys returns the SSH host keys printed by cloud-init in the console
+// output of an instance, in the authorized_keys format
+func ParseHostKeys(output string) []string {
+	keys := []string{}
+	inKeys := false
+
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case strings.Contains(line, beginHostKeys):
+			inKeys = true
+		case strings.Contains(line, endHostKeys):
+			inKeys = false
+		case inKeys:
+			if _, _, _, _, err := ssh.ParseAuthorizedKey([]byte(line)); err == nil {
+				keys = append(keys, line)
+			}
+		}
+	}
+
+	return keys
+}
+
+// read returns the lines of the known hosts file, or the lines in memory if
+// there is no file
+func (kh *KnownHosts) read() ([]string, error) {
+	if len(kh.filename) == 0 {
+		return append([]string{}, kh.lines...), nil
+	}
+
+	data, err := ioutil.ReadFile(kh.filename)
+	if os.IsNotExist(err) {
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the known hosts file %s. %s", kh.filename, err)
+	}
+
+	lines := []string{}
+	for _, line := range strings.Split(string(data), "\n") {
+		if len(strings.TrimSpace(line)) != 0 {
+			lines = append(lines, line)
+		}
+	}
+
+	return lines, nil
+}
+
+// write replaces the content of the known hosts file, or the lines in memory
+// if there is no file
+func (kh *KnownHosts) write(lines []string) error {
+	if len(kh.filename) == 0 {
+		kh.lines = lines
+		return nil
+	}
+
+	content := ""
+	if len(lines) != 0 {
+		content = strings.Join(lines, "\n") + "\n"
+	}
+	if err := ioutil.WriteFile(kh.filename, []byte(content), 0600); err != nil {
+		return fmt.Errorf("failed to write the known hosts file %s. %s", kh.filename, err)
+	}
+
+	return nil
+}
+
+// parseLine returns the hosts and the key of a known hosts line. The key is
+// nil if the line is a comment, has a marker or cannot be parsed
+func parseLine(line string) ([]string, ssh.PublicKey) {
+	line = strings.TrimSpace(line)
+	if len(line) == 0 || strings.HasPrefix(line, "#") {
+		return nil, nil
+	}
+	marker, hosts, pubKey, _, _, err := ssh.ParseKnownHosts([]byte(line))
+	if err != nil || len(marker) != 0 {
+		return nil, nil
+	}
+	return hosts, pubKey
+}
+
+func contains(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAny(list []string, items []string) bool {
+	for _, item := range items {
+		if contains(list, item) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasType(keys []ssh.PublicKey, keyType string) bool {
+	for _, key := range keys {
+		if key.Type() == keyType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,166 @@
+package ssh
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+)
+
+func newHostKey(t *testing.T) ssh.PublicKey {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey, err := ssh.NewPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return publicKey
+}
+
+func TestKnownHosts_HostKeyCallback(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kubekit-known-hosts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, KnownHostsFilename)
+	node1, node2 := newHostKey(t), newHostKey(t)
+	addr := &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 22}
+
+	tests := []struct {
+		name     string
+		hostname string
+		key      ssh.PublicKey
+		wantErr  bool
+	}{
+		{"first connection is trusted", "10.0.0.1:22", node1, false},
+		{"same key", "10.0.0.1:22", node1, false},
+		{"different key", "10.0.0.1:22", node2, true},
+		{"another host", "10.0.0.2:22", node2, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// a new store every time, the keys are pinned in the file
+			callback := NewKnownHosts(filename).HostKeyCallback()
+			err := callback(tt.hostname, addr, tt.key)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("HostKeyCallback() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if _, ok := err.(*HostKeyError); tt.wantErr && !ok {
+				t.Errorf("HostKeyCallback() error = %T, want *HostKeyError", err)
+			}
+		})
+	}
+
+	kh := NewKnownHosts(filename)
+	if got := kh.HostKeyAlgorithms("10.0.0.1"); len(got) != 1 || got[0] != node1.Type() {
+		t.Errorf("HostKeyAlgorithms() = %v, want [%s]", got, node1.Type())
+	}
+	if got := kh.HostKeyAlgorithms("10.0.0.3"); got != nil {
+		t.Errorf("HostKeyAlgorithms() = %v, want nil for an unknown host", got)
+	}
+}
+
+func TestKnownHosts(t *testing.T) {
+	node1, node2, node3 := newHostKey(t), newHostKey(t), newHostKey(t)
+	authorizedKey := func(key ssh.PublicKey) string {
+		return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key)))
+	}
+
+	kh := NewKnownHosts("")
+	if err := kh.Add("10.0.0.1", authorizedKey(node1)); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	if err := kh.Add("10.0.0.2", authorizedKey(node2)); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	// the pre-seeded key replaces the pinned key of the same type
+	if err := kh.Add("10.0.0.2", authorizedKey(node3)); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	if err := kh.Add("10.0.0.3", "not a key"); err == nil {
+		t.Errorf("Add() expected an error with an invalid key")
+	}
+
+	got, err := kh.List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	want := []HostKey{
+		{Host: "10.0.0.1", Type: node1.Type(), Fingerprint: ssh.FingerprintSHA256(node1)},
+		{Host: "10.0.0.2", Type: node3.Type(), Fingerprint: ssh.FingerprintSHA256(node3)},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("List() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("List()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	removed, err := kh.Remove("10.0.0.2", "10.0.0.4")
+	if err != nil || removed != 1 {
+		t.Errorf("Remove() = %d, %v; want 1", removed, err)
+	}
+	// after the key is removed, the new key is trusted on the next connection
+	if err := kh.HostKeyCallback()("10.0.0.2:22", nil, node2); err != nil {
+		t.Errorf("HostKeyCallback() error = %v", err)
+	}
+}
+
+func TestParseHostKeys(t *testing.T) {
+	key := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(newHostKey(t))))
+
+	tests := []struct {
+		name   string
+		output string
+		want   int
+	}{
+		{"no keys", "Cloud-init v. 18.2 running 'modules:final'\n", 0},
+		{"keys", "ec2: \n-----BEGIN SSH HOST KEY KEYS-----\n" + key + " root@ip-10-0-0-1\n-----END SSH HOST KEY KEYS-----\n", 1},
+		{"invalid key", "-----BEGIN SSH HOST KEY KEYS-----\nssh-rsa AAAA root@ip-10-0-0-1\n-----END SSH HOST KEY KEYS-----\n", 0},
+		{"keys out of the block", key + "\n", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseHostKeys(tt.output); len(got) != tt.want {
+				t.Errorf("ParseHostKeys() = %v, want %d keys", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHostKeyAddress(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+		port    int
+		want    string
+	}{
+		{"default port", "10.0.0.5", 0, "10.0.0.5"},
+		{"port 22", "10.0.0.5", 22, "10.0.0.5"},
+		{"custom port", "10.0.0.5", 2222, "[10.0.0.5]:2222"},
+		{"address with port", "[10.0.0.5]:2200", 2222, "[10.0.0.5]:2200"},
+		{"IPv6", "fe80::1", 22, "[fe80::1]"},
+		{"IPv6 custom port", "fe80::1", 2222, "[fe80::1]:2222"},
+		{"DNS name", "node1.example.com", 2222, "[node1.example.com]:2222"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HostKeyAddress(tt.address, tt.port); got != tt.want {
+				t.Errorf("HostKeyAddress() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
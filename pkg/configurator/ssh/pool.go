@@ -0,0 +1,103 @@
+package ssh
+
+import (
+	"fmt"
+	"sync"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// Pool keeps the SSH connections to the hosts open so they are reused by all
+// the operations on the hosts, instead of opening a new connection for every
+// operation. The connections are closed when the pool is closed
+type Pool struct {
+	mu      sync.Mutex
+	clients map[string]*pooledClient
+}
+
+type pooledClient struct {
+	mu     sync.Mutex
+	client *ssh.Client
+}
+
+// NewPool returns an empty pool of SSH connections
+func NewPool() *Pool {
+	return &Pool{
+		clients: map[string]*pooledClient{},
+	}
+}
+
+// client returns the open connection to the host of the given configuration,
+// or a new connection if there is none or it was closed
+func (p *Pool) client(c *Config) (*ssh.Client, error) {
+	key := c.key()
+
+	p.mu.Lock()
+	pc, ok := p.clients[key]
+	if !ok {
+		pc = &pooledClient{}
+		p.clients[key] = pc
+	}
+	p.mu.Unlock()
+
+	// the connections to different hosts are opened concurrently
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+
+	if pc.client != nil {
+		if _, _, err := pc.client.SendRequest("keepalive@openssh.com", true, nil); err == nil {
+			return pc.client, nil
+		}
+		pc.client.Close()
+		pc.client = nil
+	}
+
+	client, err := c.connect()
+	if err != nil {
+		return nil, err
+	}
+	pc.client = client
+
+	return client, nil
+}
+
+// Len returns the number of open connections in the pool
+func (p *Pool) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	n := 0
+	for _, pc := range p.clients {
+		pc.mu.Lock()
+		if pc.client != nil {
+			n++
+		}
+		pc.mu.Unlock()
+	}
+	return n
+}
+
+// Close closes all the connections in the pool
+func (p *Pool) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for key, pc := range p.clients {
+		pc.mu.Lock()
+		if pc.client != nil {
+			pc.client.Close()
+		}
+		pc.mu.Unlock()
+		delete(p.clients, key)
+	}
+	return nil
+}
+
+// key identifies the connection to the host of this configuration
+func (c *Config) key() string {
+	key := fmt.Sprintf("%s@%s", c.config.User, c.hostPort())
+	if c.Bastion != nil {
+		key += " via " + c.Bastion.key()
+	}
+	return key
+}
@@ -1,53 +1,66 @@
 package ssh
 
 import (
+	"errors"
 	"fmt"
+	"net"
+	"strconv"
+	"strings"
 	"time"
 
 	"golang.org/x/crypto/ssh"
 )
 
+// Default SSH connection parameters
+const (
+	DefaultPort    = 22
+	DefaultTimeout = 5 * time.Second
+	DefaultRetries = 2
+)
+
+// retryBackoff is the time to wait before the first retry to connect to a
+// host, it's doubled on every retry
+var retryBackoff = time.Second
+
 // Config store the SSH configuration
 type Config struct {
 	Address string
-	config  *ssh.ClientConfig
-	client  *ssh.Client
+	// Port is the SSH port of the host, if not set the DefaultPort is used
+	Port int
+	// Bastion is the SSH configuration of the jump host to connect to this host
+	Bastion *Config
+	// Retries is the number of times to retry a failed connection to the host
+	Retries    int
+	config     *ssh.ClientConfig
+	client     *ssh.Client
+	knownHosts *KnownHosts
+	pool       *Pool
 }
 
-// New returns an instance of the SSH configuration
-func New(username, address, privateKey, password string) (*Config, error) {
-	// TODO: Should the HostKeyCallback be replace? this may not be recommended for production
+// New returns an instance of the SSH configuration to authenticate with the
+// given private key or password. The host key is verified with the given known
+// hosts, if nil the host key is pinned only in memory
+func New(username, address, privateKey, password string, knownHosts *KnownHosts) (*Config, error) {
+	return NewWithAuth(username, address, &Auth{PrivateKeys: []string{privateKey}, Password: password}, knownHosts)
+}
+
+// NewWithAuth returns an instance of the SSH configuration to authenticate
+// with the given credentials. The host key is verified with the given known
+// hosts, if nil the host key is pinned only in memory
+func NewWithAuth(username, address string, credentials *Auth, knownHosts *KnownHosts) (*Config, error) {
+	if knownHosts == nil {
+		knownHosts = NewKnownHosts("")
+	}
 	sshConfig := &ssh.ClientConfig{
 		User:            username,
-		Timeout:         5 * time.Second,
-		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+		Timeout:         DefaultTimeout,
+		HostKeyCallback: knownHosts.HostKeyCallback(),
 	}
 
-	auth := []ssh.AuthMethod{}
-
-	if len(privateKey) != 0 {
-		publicKey, err := publicKey(privateKey)
-		if err != nil {
-			return nil, err
-		}
-		auth = append(auth, publicKey)
-	}
-
-	if len(password) != 0 {
-		sshInteractive := func(user, instruction string, questions []string, echos []bool) (answers []string, err error) {
-			answers = make([]string, len(questions))
-			for n := range questions {
-				answers[n] = password
-			}
-
-			return answers, nil
-		}
-
-		auth = append(auth, ssh.Password(password))
-		// In case 'PasswordAuthentication' is set to No in /etc/ssh/sshd_config at the server, try with interactive method
-		auth = append(auth, ssh.KeyboardInteractive(sshInteractive))
+	auth, err := credentials.authMethods()
+	if err != nil {
+		return nil, err
 	}
-
 	if len(auth) == 0 {
 		return nil, fmt.Errorf("no authentication method defined for host %s", address)
 	}
@@ -56,34 +69,132 @@ func New(username, address, privateKey, password string) (*Config, error) {
 	sshConfig.SetDefaults()
 
 	return &Config{
-		Address: address,
-		config:  sshConfig,
+		Address:    address,
+		Retries:    DefaultRetries,
+		config:     sshConfig,
+		knownHosts: knownHosts,
 	}, nil
 }
 
-func publicKey(privateKey string) (ssh.AuthMethod, error) {
-	signer, err := ssh.ParsePrivateKey([]byte(privateKey))
-	if err != nil {
-		return nil, err
+// SetTimeout sets the maximum amount of time to wait for the connection to the
+// host, if zero the DefaultTimeout is used
+func (c *Config) SetTimeout(timeout time.Duration) {
+	if timeout == 0 {
+		timeout = DefaultTimeout
 	}
-	return ssh.PublicKeys(signer), nil
+	c.config.Timeout = timeout
+}
+
+// Timeout returns the maximum amount of time to wait for the connection
+func (c *Config) Timeout() time.Duration {
+	return c.config.Timeout
+}
+
+// SetPool sets the pool of connections to reuse the connection to the host.
+// With a pool, Close does not close the connection, the pool does
+func (c *Config) SetPool(pool *Pool) {
+	c.pool = pool
 }
 
 func (c *Config) setClient() error {
+	if c.pool != nil {
+		client, err := c.pool.client(c)
+		c.client = client
+		return err
+	}
+
 	if c.client != nil {
 		c.client.Close()
 		// return nil
 	}
 
-	client, err := ssh.Dial("tcp", c.Address+":22", c.config)
+	client, err := c.connect()
 	c.client = client
 	return err
 }
 
-// Close closes the client connection if exists
+// connect opens a new connection to the host, retrying with backoff if the
+// host is not reachable. Authentication and host key errors are not retried
+func (c *Config) connect() (*ssh.Client, error) {
+	backoff := retryBackoff
+	for attempt := 0; ; attempt++ {
+		client, err := c.dial()
+		if err == nil || attempt >= c.Retries || !retryable(err) {
+			return client, err
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// retryable returns false if the error won't be fixed retrying the connection,
+// such as a changed host key or invalid credentials. The handshake errors are
+// not wrapped by the ssh package, so they are identified by the message
+func retryable(err error) bool {
+	var hostKeyErr *HostKeyError
+	if errors.As(err, &hostKeyErr) {
+		return false
+	}
+	msg := err.Error()
+	return !strings.Contains(msg, "unable to authenticate") && !strings.Contains(msg, "the host key of")
+}
+
+// hostPort returns the address and port to connect to the host
+func (c *Config) hostPort() string {
+	port := c.Port
+	if port == 0 {
+		port = DefaultPort
+	}
+	return net.JoinHostPort(c.Address, strconv.Itoa(port))
+}
+
+// dial opens a new connection to the host, through the bastion if there is one
+func (c *Config) dial() (*ssh.Client, error) {
+	addr := c.hostPort()
+	// the server should offer the type of the pinned host keys
+	c.config.HostKeyAlgorithms = c.knownHosts.HostKeyAlgorithms(addr)
+
+	if c.Bastion == nil {
+		return ssh.Dial("tcp", addr, c.config)
+	}
+
+	bastionClient, err := c.Bastion.dial()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the bastion %s. %s", c.Bastion.Address, err)
+	}
+	conn, err := bastionClient.Dial("tcp", addr)
+	if err != nil {
+		bastionClient.Close()
+		return nil, fmt.Errorf("failed to connect to %s through the bastion %s. %s", addr, c.Bastion.Address, err)
+	}
+	conn.SetDeadline(time.Now().Add(c.config.Timeout))
+	clientConn, chans, reqs, err := ssh.NewClientConn(conn, addr, c.config)
+	if err != nil {
+		conn.Close()
+		bastionClient.Close()
+		return nil, err
+	}
+	conn.SetDeadline(time.Time{})
+
+	client := ssh.NewClient(clientConn, chans, reqs)
+	// the connection to the bastion is closed when the host connection is closed
+	go func() {
+		client.Wait()
+		bastionClient.Close()
+	}()
+
+	return client, nil
+}
+
+// Close closes the client connection if exists. If the connection is in a
+// pool it's kept open to be reused
 func (c *Config) Close() error {
 	if c.client == nil {
 		return nil
 	}
+	if c.pool != nil {
+		c.client = nil
+		return nil
+	}
 	return c.client.Close()
 }
@@ -0,0 +1,293 @@
+package ssh
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// testServer is a SSH server that replies to any command with the server name
+// and forwards TCP connections, so it can be used as host or bastion. It
+// accepts the user kubekit with password S3cr3t or the authorized keys
+type testServer struct {
+	name     string
+	listener net.Listener
+	hostKey  ssh.PublicKey
+	// conns is the number of accepted connections
+	conns int32
+}
+
+func newTestServer(t *testing.T, name string, authorized ...ssh.PublicKey) *testServer {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := ssh.NewSignerFromKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := &ssh.ServerConfig{
+		PasswordCallback: func(c ssh.ConnMetadata, pass []byte) (*ssh.Permissions, error) {
+			if c.User() == "kubekit" && string(pass) == "S3cr3t" {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("invalid user or password")
+		},
+		PublicKeyCallback: func(c ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			for _, k := range authorized {
+				if c.User() == "kubekit" && bytes.Equal(k.Marshal(), key.Marshal()) {
+					return nil, nil
+				}
+			}
+			return nil, fmt.Errorf("unauthorized key")
+		},
+	}
+	config.AddHostKey(signer)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &testServer{name: name, listener: listener, hostKey: signer.PublicKey()}
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			atomic.AddInt32(&s.conns, 1)
+			go s.serve(conn, config)
+		}
+	}()
+
+	return s
+}
+
+func (s *testServer) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *testServer) serve(conn net.Conn, config *ssh.ServerConfig) {
+	_, chans, reqs, err := ssh.NewServerConn(conn, config)
+	if err != nil {
+		return
+	}
+	go ssh.DiscardRequests(reqs)
+
+	for newChannel := range chans {
+		switch newChannel.ChannelType() {
+		case "session":
+			ch, requests, err := newChannel.Accept()
+			if err != nil {
+				continue
+			}
+			go func() {
+				for req := range requests {
+					if req.Type != "exec" {
+						req.Reply(false, nil)
+						continue
+					}
+					req.Reply(true, nil)
+					fmt.Fprintf(ch, "hello from %s\n", s.name)
+					ch.SendRequest("exit-status", false, ssh.Marshal(struct{ Status uint32 }{0}))
+					ch.Close()
+				}
+			}()
+		case "direct-tcpip":
+			var target struct {
+				Host       string
+				Port       uint32
+				OriginHost string
+				OriginPort uint32
+			}
+			if err := ssh.Unmarshal(newChannel.ExtraData(), &target); err != nil {
+				newChannel.Reject(ssh.ConnectionFailed, err.Error())
+				continue
+			}
+			targetConn, err := net.Dial("tcp", net.JoinHostPort(target.Host, strconv.Itoa(int(target.Port))))
+			if err != nil {
+				newChannel.Reject(ssh.ConnectionFailed, err.Error())
+				continue
+			}
+			ch, requests, err := newChannel.Accept()
+			if err != nil {
+				targetConn.Close()
+				continue
+			}
+			go ssh.DiscardRequests(requests)
+			go func() {
+				defer ch.Close()
+				defer targetConn.Close()
+				go io.Copy(targetConn, ch)
+				io.Copy(ch, targetConn)
+			}()
+		default:
+			newChannel.Reject(ssh.UnknownChannelType, "unknown channel type")
+		}
+	}
+}
+
+func TestConfig_Bastion(t *testing.T) {
+	host := newTestServer(t, "host")
+	defer host.listener.Close()
+	bastion := newTestServer(t, "bastion")
+	defer bastion.listener.Close()
+
+	knownHosts := NewKnownHosts("")
+	newConfig := func(port, bastionPort int, password string) *Config {
+		c, err := New("kubekit", "127.0.0.1", "", password, knownHosts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.Port = port
+		if bastionPort != 0 {
+			b, err := New("kubekit", "localhost", "", "S3cr3t", knownHosts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b.Port = bastionPort
+			c.Bastion = b
+		}
+		return c
+	}
+
+	prevBackoff := retryBackoff
+	retryBackoff = time.Millisecond
+	defer func() { retryBackoff = prevBackoff }()
+
+	tests := []struct {
+		name    string
+		config  *Config
+		want    string
+		wantErr bool
+	}{
+		{"direct", newConfig(host.port(), 0, "S3cr3t"), "hello from host", false},
+		{"through the bastion", newConfig(host.port(), bastion.port(), "S3cr3t"), "hello from host", false},
+		{"wrong password", newConfig(host.port(), bastion.port(), "secret"), "", true},
+		{"bastion not reachable", newConfig(host.port(), 1, "S3cr3t"), "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _, _, err := tt.config.ExecAndWait("hostname")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExecAndWait() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ExecAndWait() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	keys, err := knownHosts.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 {
+		t.Errorf("the host and bastion keys were not pinned: %v", keys)
+	}
+}
+
+func TestConfig_Tunnel(t *testing.T) {
+	host := newTestServer(t, "host")
+	defer host.listener.Close()
+
+	api, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer api.Close()
+	go http.Serve(api, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "[]")
+	}))
+
+	c, err := New("kubekit", "127.0.0.1", "", "S3cr3t", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Port = host.port()
+
+	tunnel, err := c.Tunnel()
+	if err != nil {
+		t.Fatalf("Tunnel() error = %v", err)
+	}
+	defer tunnel.Close()
+
+	client := &http.Client{Transport: &http.Transport{Dial: tunnel.Dial}}
+	res, err := client.Get(fmt.Sprintf("http://localhost:%d/tasks", api.Addr().(*net.TCPAddr).Port))
+	if err != nil {
+		t.Fatalf("failed to get through the tunnel. %s", err)
+	}
+	defer res.Body.Close()
+	body, _ := ioutil.ReadAll(res.Body)
+	if string(body) != "[]" {
+		t.Errorf("got %q through the tunnel, want %q", body, "[]")
+	}
+}
+
+func TestPool(t *testing.T) {
+	host := newTestServer(t, "host")
+	defer host.listener.Close()
+
+	pool := NewPool()
+	defer pool.Close()
+
+	for i := 0; i < 3; i++ {
+		c, err := New("kubekit", "127.0.0.1", "", "S3cr3t", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.Port = host.port()
+		c.SetPool(pool)
+		if _, _, _, err := c.ExecAndWait("hostname"); err != nil {
+			t.Fatalf("ExecAndWait() error = %v", err)
+		}
+		c.Close()
+	}
+
+	if got := atomic.LoadInt32(&host.conns); got != 1 {
+		t.Errorf("Pool opened %d connections, want 1", got)
+	}
+	if got := pool.Len(); got != 1 {
+		t.Errorf("Pool.Len() = %d, want 1", got)
+	}
+	pool.Close()
+	if got := pool.Len(); got != 0 {
+		t.Errorf("Pool.Len() after Close() = %d, want 0", got)
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	hostKeyErr := &HostKeyError{Host: "10.0.0.1:22", Type: "ssh-ed25519", Fingerprint: "SHA256:abc"}
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"connection refused", fmt.Errorf("dial tcp 10.0.0.1:22: connect: connection refused"), true},
+		{"timeout", fmt.Errorf("dial tcp 10.0.0.1:22: i/o timeout"), true},
+		{"host key error", hostKeyErr, false},
+		{"host key error in handshake", fmt.Errorf("ssh: handshake failed: %v", hostKeyErr), false},
+		{"invalid credentials", fmt.Errorf("ssh: handshake failed: ssh: unable to authenticate, attempted methods [none password], no supported methods remain"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := retryable(tt.err); got != tt.want {
+				t.Errorf("retryable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
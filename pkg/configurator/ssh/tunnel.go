@@ -0,0 +1,35 @@
+package ssh
+
+import (
+	"net"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// Tunnel is a SSH connection to a host, through the bastion if there is one,
+// used to forward TCP connections to the host or to the hosts it can reach
+type Tunnel struct {
+	client *ssh.Client
+}
+
+// Tunnel opens a new SSH connection to the host to forward TCP connections. It
+// does not share the connection used to execute commands, so it can be open
+// while commands are executed
+func (c *Config) Tunnel() (*Tunnel, error) {
+	client, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+	return &Tunnel{client: client}, nil
+}
+
+// Dial opens a connection to the given address from the remote host. Use
+// 'localhost' to connect to a port of the remote host
+func (t *Tunnel) Dial(network, address string) (net.Conn, error) {
+	return t.client.Dial(network, address)
+}
+
+// Close closes the tunnel and all the forwarded connections
+func (t *Tunnel) Close() error {
+	return t.client.Close()
+}
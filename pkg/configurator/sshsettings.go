@@ -0,0 +1,244 @@
+package configurator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/liferaft/kubekit/pkg/configurator/ssh"
+	"github.com/liferaft/kubekit/pkg/provisioner/config"
+	"github.com/mitchellh/go-homedir"
+)
+
+// SSHSettings are the parameters to connect with SSH to the cluster hosts,
+// taken from the platform configuration
+type SSHSettings struct {
+	Username string
+	// Auth are the credentials and authentication methods to connect to the hosts
+	Auth    *ssh.Auth
+	Port    int
+	Timeout time.Duration
+	// Bastion is the jump host to connect to the hosts of the pools without bastion
+	Bastion *config.SSHBastion
+	// PoolBastions are the jump hosts to connect to the hosts of a pool
+	PoolBastions map[string]*config.SSHBastion
+	KnownHosts   *ssh.KnownHosts
+	// Pool keeps the connections to the hosts open to reuse them, if nil every
+	// operation opens a new connection
+	Pool *ssh.Pool
+}
+
+// NewSSHSettings returns the SSH settings from the platform configuration. The
+// host keys are verified with the given known hosts
+func NewSSHSettings(platformConfig map[string]interface{}, knownHosts *ssh.KnownHosts) (*SSHSettings, error) {
+	username, ok := platformConfig["username"].(string)
+	if !ok {
+		return nil, fmt.Errorf("not found username in platform configuration")
+	}
+	auth, err := getSSHAuth(platformConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &SSHSettings{
+		Username:     username,
+		Auth:         auth,
+		PoolBastions: map[string]*config.SSHBastion{},
+		KnownHosts:   knownHosts,
+	}
+
+	if port, ok := platformConfig["ssh_port"].(float64); ok {
+		s.Port = int(port)
+	}
+	if timeout, ok := platformConfig["ssh_timeout"].(string); ok && len(timeout) != 0 {
+		if s.Timeout, err = time.ParseDuration(timeout); err != nil {
+			return nil, fmt.Errorf("failed to parse the SSH timeout %q. %s", timeout, err)
+		}
+	}
+
+	if s.Bastion, err = getSSHBastion(platformConfig); err != nil {
+		return nil, err
+	}
+	if defaultPool, ok := platformConfig["default_node_pool"].(map[string]interface{}); ok {
+		bastion, err := getSSHBastion(defaultPool)
+		if err != nil {
+			return nil, err
+		}
+		if bastion != nil {
+			s.Bastion = bastion
+		}
+	}
+	if pools, ok := platformConfig["node_pools"].(map[string]interface{}); ok {
+		for name, pool := range pools {
+			poolConfig, ok := pool.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			bastion, err := getSSHBastion(poolConfig)
+			if err != nil {
+				return nil, err
+			}
+			if bastion != nil {
+				s.PoolBastions[name] = bastion
+			}
+		}
+	}
+
+	return s, nil
+}
+
+// getSSHAuth returns the SSH credentials and authentication methods from the
+// platform configuration. The encrypted private keys are decrypted only if
+// the private key authentication is used
+func getSSHAuth(platformConfig map[string]interface{}) (*ssh.Auth, error) {
+	auth := &ssh.Auth{
+		Methods: getList(platformConfig["ssh_auth_methods"]),
+	}
+	if err := ssh.ValidateAuthMethods(auth.Methods); err != nil {
+		return nil, err
+	}
+	if p, ok := platformConfig["password"].(string); ok {
+		auth.Password = p
+	}
+	if !auth.HasMethod(ssh.PrivateKeyAuth) {
+		return auth, nil
+	}
+
+	privKey, err := GetPrivateKey(platformConfig)
+	if err != nil {
+		return nil, err
+	}
+	if len(privKey) != 0 {
+		name, _ := platformConfig["private_key_file"].(string)
+		if len(name) == 0 {
+			name = "private_key"
+		}
+		key, err := ssh.DecryptPrivateKey(name, []byte(privKey))
+		if err != nil {
+			return nil, err
+		}
+		auth.PrivateKeys = append(auth.PrivateKeys, string(key))
+	}
+
+	for _, filename := range getList(platformConfig["ssh_private_key_files"]) {
+		key, err := readPrivateKeyFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		auth.PrivateKeys = append(auth.PrivateKeys, string(key))
+	}
+
+	return auth, nil
+}
+
+// readPrivateKeyFile returns the decrypted private key in the given file
+func readPrivateKeyFile(filename string) ([]byte, error) {
+	filename, err := homedir.Expand(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to expand the path to the private key %s. %s", filename, err)
+	}
+	key, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read private key from %s. %s", filename, err)
+	}
+	return ssh.DecryptPrivateKey(filename, key)
+}
+
+// getList returns the list of strings from a JSON list
+func getList(v interface{}) []string {
+	items, ok := v.([]interface{})
+	if !ok {
+		return nil
+	}
+	list := []string{}
+	for _, item := range items {
+		if str, ok := item.(string); ok && len(str) != 0 {
+			list = append(list, str)
+		}
+	}
+	return list
+}
+
+// getSSHBastion returns the bastion in the given configuration, or nil if
+// there is no bastion
+func getSSHBastion(m map[string]interface{}) (*config.SSHBastion, error) {
+	b, ok := m["ssh_bastion"]
+	if !ok || b == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(b)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the SSH bastion configuration. %s", err)
+	}
+	bastion := &config.SSHBastion{}
+	if err := json.Unmarshal(data, bastion); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the SSH bastion configuration. %s", err)
+	}
+	if len(bastion.Host) == 0 {
+		return nil, nil
+	}
+	return bastion, nil
+}
+
+// bastion returns the bastion to connect to the hosts in the given pool, or
+// nil if they are reached directly
+func (s *SSHSettings) bastion(pool string) *config.SSHBastion {
+	if bastion, ok := s.PoolBastions[pool]; ok {
+		return bastion
+	}
+	return s.Bastion
+}
+
+// sshConfig returns the SSH configuration to connect to the given host in the
+// given pool. If the host is reached through a bastion, the private IP address
+// of the host is used
+func (s *SSHSettings) sshConfig(h *Host, pool string) (*ssh.Config, error) {
+	bastion := s.bastion(pool)
+
+	address := h.PublicIP
+	if bastion != nil && len(h.PrivateIP) != 0 {
+		address = h.PrivateIP
+	}
+
+	sshConf, err := ssh.NewWithAuth(s.Username, address, s.Auth, s.KnownHosts)
+	if err != nil {
+		return nil, err
+	}
+	sshConf.Port = s.Port
+	sshConf.SetTimeout(s.Timeout)
+	sshConf.SetPool(s.Pool)
+
+	if bastion == nil {
+		return sshConf, nil
+	}
+
+	username := bastion.User
+	if len(username) == 0 {
+		username = s.Username
+	}
+	auth := s.Auth
+	if len(bastion.PrivateKeyFile) != 0 {
+		key, err := readPrivateKeyFile(bastion.PrivateKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the SSH bastion private key. %s", err)
+		}
+		// the bastion key replaces the cluster keys and password, not the agent
+		auth = &ssh.Auth{
+			Methods:     []string{ssh.PrivateKeyAuth},
+			PrivateKeys: []string{string(key)},
+		}
+		if s.Auth.HasMethod(ssh.AgentAuth) {
+			auth.Methods = append(auth.Methods, ssh.AgentAuth)
+		}
+	}
+	bastionConf, err := ssh.NewWithAuth(username, bastion.Host, auth, s.KnownHosts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure the SSH bastion %s. %s", bastion.Host, err)
+	}
+	bastionConf.Port = bastion.Port
+	bastionConf.SetTimeout(s.Timeout)
+	sshConf.Bastion = bastionConf
+
+	return sshConf, nil
+}
@@ -0,0 +1,122 @@
+package configurator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/liferaft/kubekit/pkg/configurator/ssh"
+)
+
+func TestNewSSHSettings(t *testing.T) {
+	tests := []struct {
+		name           string
+		platformConfig map[string]interface{}
+		wantPort       int
+		wantTimeout    time.Duration
+		wantBastions   map[string]string
+		wantErr        bool
+	}{
+		{"no username", map[string]interface{}{"password": "S3cr3t"}, 0, 0, nil, true},
+		{"defaults", map[string]interface{}{"username": "kubekit", "password": "S3cr3t"}, 0, 0, map[string]string{"master": "", "worker": ""}, false},
+		{"port and timeout", map[string]interface{}{"username": "kubekit", "password": "S3cr3t", "ssh_port": float64(2222), "ssh_timeout": "30s"}, 2222, 30 * time.Second, nil, false},
+		{"agent authentication", map[string]interface{}{"username": "kubekit", "ssh_auth_methods": []interface{}{"agent"}}, 0, 0, nil, false},
+		{"unknown authentication method", map[string]interface{}{"username": "kubekit", "ssh_auth_methods": []interface{}{"agent", "kerberos"}}, 0, 0, nil, true},
+		{"missing private key file", map[string]interface{}{"username": "kubekit", "ssh_private_key_files": []interface{}{"/not/found/id_rsa"}}, 0, 0, nil, true},
+		{"invalid timeout", map[string]interface{}{"username": "kubekit", "password": "S3cr3t", "ssh_timeout": "30"}, 0, 0, nil, true},
+		{"cluster bastion", map[string]interface{}{
+			"username":    "kubekit",
+			"password":    "S3cr3t",
+			"ssh_bastion": map[string]interface{}{"host": "bastion.example.com", "port": float64(2200)},
+		}, 0, 0, map[string]string{"master": "bastion.example.com", "worker": "bastion.example.com"}, false},
+		{"pool bastion", map[string]interface{}{
+			"username":    "kubekit",
+			"password":    "S3cr3t",
+			"ssh_bastion": map[string]interface{}{"host": "bastion.example.com"},
+			"node_pools": map[string]interface{}{
+				"worker": map[string]interface{}{"count": float64(3), "ssh_bastion": map[string]interface{}{"host": "10.0.1.10"}},
+				"master": map[string]interface{}{"count": float64(1)},
+			},
+		}, 0, 0, map[string]string{"master": "bastion.example.com", "worker": "10.0.1.10"}, false},
+		{"default pool bastion", map[string]interface{}{
+			"username":          "kubekit",
+			"password":          "S3cr3t",
+			"default_node_pool": map[string]interface{}{"ssh_bastion": map[string]interface{}{"host": "10.0.1.10"}},
+		}, 0, 0, map[string]string{"master": "10.0.1.10"}, false},
+		{"empty bastion", map[string]interface{}{"username": "kubekit", "password": "S3cr3t", "ssh_bastion": map[string]interface{}{}}, 0, 0, map[string]string{"master": ""}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewSSHSettings(tt.platformConfig, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewSSHSettings() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Port != tt.wantPort {
+				t.Errorf("NewSSHSettings() port = %d, want %d", got.Port, tt.wantPort)
+			}
+			if got.Timeout != tt.wantTimeout {
+				t.Errorf("NewSSHSettings() timeout = %s, want %s", got.Timeout, tt.wantTimeout)
+			}
+			for pool, wantHost := range tt.wantBastions {
+				var host string
+				if bastion := got.bastion(pool); bastion != nil {
+					host = bastion.Host
+				}
+				if host != wantHost {
+					t.Errorf("NewSSHSettings() bastion of pool %s = %q, want %q", pool, host, wantHost)
+				}
+			}
+		})
+	}
+}
+
+func TestSSHSettings_sshConfig(t *testing.T) {
+	platformConfig := map[string]interface{}{
+		"username":    "kubekit",
+		"password":    "S3cr3t",
+		"ssh_port":    float64(2222),
+		"ssh_timeout": "1m",
+		"node_pools": map[string]interface{}{
+			"worker": map[string]interface{}{"ssh_bastion": map[string]interface{}{"host": "bastion.example.com", "port": float64(2200), "user": "jump"}},
+		},
+	}
+	settings, err := NewSSHSettings(platformConfig, ssh.NewKnownHosts(""))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		host        Host
+		wantAddress string
+		wantBastion string
+	}{
+		{"direct", Host{PublicIP: "54.0.0.1", PrivateIP: "10.0.0.1", RoleName: "master"}, "54.0.0.1", ""},
+		{"through the bastion", Host{PublicIP: "54.0.0.2", PrivateIP: "10.0.0.2", RoleName: "worker"}, "10.0.0.2", "bastion.example.com"},
+		{"pool name", Host{PublicIP: "54.0.0.3", PrivateIP: "10.0.0.3", RoleName: "node", Pool: "worker"}, "10.0.0.3", "bastion.example.com"},
+		{"no private IP", Host{PublicIP: "54.0.0.4", RoleName: "worker"}, "54.0.0.4", "bastion.example.com"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.host.Config(tt.host.RoleName+"000", settings); err != nil {
+				t.Fatalf("Config() error = %v", err)
+			}
+			got := tt.host.GetSSHConfig()
+			if got.Address != tt.wantAddress || got.Port != 2222 || got.Timeout() != time.Minute {
+				t.Errorf("Config() ssh = %s:%d (%s), want %s:2222 (1m0s)", got.Address, got.Port, got.Timeout(), tt.wantAddress)
+			}
+			var bastion string
+			if got.Bastion != nil {
+				bastion = got.Bastion.Address
+				if got.Bastion.Port != 2200 {
+					t.Errorf("Config() bastion port = %d, want 2200", got.Bastion.Port)
+				}
+			}
+			if bastion != tt.wantBastion {
+				t.Errorf("Config() bastion = %q, want %q", bastion, tt.wantBastion)
+			}
+		})
+	}
+}
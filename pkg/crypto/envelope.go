@@ -0,0 +1,437 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// The envelope encryption encrypts every file with a new random data key, the
+// data key is encrypted with the key encryption key (KEK) of a key provider and
+// stored with the encrypted data. Changing the KEK only requires to re-encrypt
+// the data keys.
+
+// Key providers of the envelope encryption
+const (
+	FileKeyProvider       = "file"
+	PassphraseKeyProvider = "passphrase"
+	CommandKeyProvider    = "command"
+)
+
+// DefaultKeyFilename is the filename of the per-installation key file, it's in
+// the KubeKit home directory
+const DefaultKeyFilename = "kubekit.key"
+
+const (
+	envelopeVersion = 1
+	dataKeySize     = 32
+)
+
+// envelopePrefix identifies the encrypted files, it's the beginning of the JSON
+// encoded envelope
+var envelopePrefix = []byte(`{"kubekit_envelope":`)
+
+// EncryptionConfig is the configuration of the encryption at rest. The key
+// provider 'file' uses the key in the key file, 'passphrase' uses the key in the
+// environment variable KUBEKIT_KEY and 'command' uses external commands to
+// encrypt and decrypt the data keys, i.e. with a KMS or Vault client
+type EncryptionConfig struct {
+	Enabled        bool   `json:"enabled" yaml:"enabled" toml:"enabled" mapstructure:"enabled"`
+	KeyProvider    string `json:"key_provider,omitempty" yaml:"key_provider,omitempty" toml:"key_provider,omitempty" mapstructure:"key_provider"`
+	KeyFile        string `json:"key_file,omitempty" yaml:"key_file,omitempty" toml:"key_file,omitempty" mapstructure:"key_file"`
+	KeyID          string `json:"key_id,omitempty" yaml:"key_id,omitempty" toml:"key_id,omitempty" mapstructure:"key_id"`
+	EncryptCommand string `json:"encrypt_command,omitempty" yaml:"encrypt_command,omitempty" toml:"encrypt_command,omitempty" mapstructure:"encrypt_command"`
+	DecryptCommand string `json:"decrypt_command,omitempty" yaml:"decrypt_command,omitempty" toml:"decrypt_command,omitempty" mapstructure:"decrypt_command"`
+}
+
+// KeyProvider encrypts and decrypts the data keys with a key encryption key
+type KeyProvider interface {
+	// Name is the key provider type
+	Name() string
+	// ID identifies the key encryption key, a data key can only be decrypted by
+	// the provider with the same ID used to encrypt it
+	ID() (string, error)
+	WrapKey(dataKey []byte) ([]byte, error)
+	UnwrapKey(wrappedKey []byte) ([]byte, error)
+}
+
+// NewKeyProvider creates the key provider of the given configuration
+func NewKeyProvider(config *EncryptionConfig) (KeyProvider, error) {
+	switch strings.ToLower(config.KeyProvider) {
+	case "", FileKeyProvider:
+		if len(config.KeyFile) == 0 {
+			return nil, fmt.Errorf("the key file is required for the %s key provider", FileKeyProvider)
+		}
+		return NewFileKeyProvider(config.KeyFile), nil
+	case PassphraseKeyProvider:
+		return NewPassphraseKeyProvider()
+	case CommandKeyProvider:
+		return NewCommandKeyProvider(config.EncryptCommand, config.DecryptCommand, config.KeyID)
+	default:
+		return nil, fmt.Errorf("unknown key provider %q. The supported key providers are: %s, %s and %s", config.KeyProvider, FileKeyProvider, PassphraseKeyProvider, CommandKeyProvider)
+	}
+}
+
+// Envelope encrypts and decrypts data with the envelope encryption. The data is
+// encrypted with the key provider, it's decrypted with the key provider or with
+// any of the previous key providers
+type Envelope struct {
+	provider KeyProvider
+	previous []KeyProvider
+}
+
+// envelope is the encrypted data with the encrypted data key
+type envelope struct {
+	Version     int    `json:"kubekit_envelope"`
+	KeyProvider string `json:"key_provider"`
+	KeyID       string `json:"key_id"`
+	Key         string `json:"encrypted_key"`
+	Data        string `json:"data"`
+}
+
+// NewEnvelope creates an envelope to encrypt with the given key provider, the
+// previous key providers are only used to decrypt
+func NewEnvelope(provider KeyProvider, previous ...KeyProvider) *Envelope {
+	return &Envelope{
+		provider: provider,
+		previous: previous,
+	}
+}
+
+// IsEnvelope returns true if the given data was encrypted with the envelope
+// encryption
+func IsEnvelope(data []byte) bool {
+	return bytes.HasPrefix(bytes.TrimSpace(data), envelopePrefix)
+}
+
+// EnvelopeKeyID returns the ID of the key used to encrypt the given data or an
+// empty string if the data is not encrypted with the envelope encryption
+func EnvelopeKeyID(data []byte) string {
+	if !IsEnvelope(data) {
+		return ""
+	}
+	var env envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return ""
+	}
+	return env.KeyID
+}
+
+// Encrypt encrypts the given data with a new data key
+func (e *Envelope) Encrypt(plaintext []byte) ([]byte, error) {
+	keyID, err := e.provider.ID()
+	if err != nil {
+		return nil, err
+	}
+
+	dataKey := make([]byte, dataKeySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, fmt.Errorf("failed to generate the data key. %s", err)
+	}
+	ciphertext, err := seal(dataKey, plaintext)
+	if err != nil {
+		return nil, err
+	}
+	wrappedKey, err := e.provider.WrapKey(dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt the data key with the %s key provider. %s", e.provider.Name(), err)
+	}
+
+	return json.Marshal(envelope{
+		Version:     envelopeVersion,
+		KeyProvider: e.provider.Name(),
+		KeyID:       keyID,
+		Key:         base64.StdEncoding.EncodeToString(wrappedKey),
+		Data:        base64.StdEncoding.EncodeToString(ciphertext),
+	})
+}
+
+// Decrypt decrypts the given data with the key provider used to encrypt it. If
+// the data is not encrypted, it's returned as is
+func (e *Envelope) Decrypt(data []byte) ([]byte, error) {
+	if !IsEnvelope(data) {
+		return data, nil
+	}
+
+	var env envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return nil, fmt.Errorf("the encrypted data cannot be decoded. %s", err)
+	}
+	if env.Version != envelopeVersion {
+		return nil, fmt.Errorf("the encrypted data has the unsupported version %d", env.Version)
+	}
+
+	var provider KeyProvider
+	for _, p := range append([]KeyProvider{e.provider}, e.previous...) {
+		if id, err := p.ID(); err == nil && id == env.KeyID {
+			provider = p
+			break
+		}
+	}
+	if provider == nil {
+		return nil, fmt.Errorf("the data was encrypted with the %s key %s, it's not the current key", env.KeyProvider, env.KeyID)
+	}
+
+	wrappedKey, err := base64.StdEncoding.DecodeString(env.Key)
+	if err != nil {
+		return nil, fmt.Errorf("the encrypted data key cannot be decoded. %s", err)
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(env.Data)
+	if err != nil {
+		return nil, fmt.Errorf("the encrypted data cannot be decoded. %s", err)
+	}
+
+	dataKey, err := provider.UnwrapKey(wrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt the data key with the %s key provider. %s", provider.Name(), err)
+	}
+
+	return open(dataKey, ciphertext)
+}
+
+// seal encrypts and authenticates the plaintext with AES-GCM, the nonce is
+// prepended to the ciphertext
+func seal(key, plaintext []byte) ([]byte, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// open decrypts and authenticates the ciphertext created by seal
+func open(key, ciphertext []byte) ([]byte, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, fmt.Errorf("ciphertext length (%d) it's too short, should be larger than or equal to %d", len(ciphertext), aead.NonceSize())
+	}
+	nonce, ciphertext := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("the data cannot be decrypted, the key is wrong or the data was modified")
+	}
+	return plaintext, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// keyID returns the ID of a key encryption key, it's part of the key hash
+func keyID(key []byte) string {
+	sum := sha256.Sum256(key)
+	return "sha256:" + hex.EncodeToString(sum[:8])
+}
+
+// fileKeyProvider uses the key in a key file, the key is read the first time
+// it's used
+type fileKeyProvider struct {
+	filename string
+	key      []byte
+	mu       sync.Mutex
+}
+
+// NewFileKeyProvider creates a key provider with the key in the given file
+func NewFileKeyProvider(filename string) KeyProvider {
+	return &fileKeyProvider{
+		filename: filename,
+	}
+}
+
+// GenerateKeyFile creates the given key file with a new random key. It fails if
+// the file already exists
+func GenerateKeyFile(filename string) error {
+	key := make([]byte, dataKeySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return fmt.Errorf("failed to generate the key. %s", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(filename), 0700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(filename, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to create the key file %s. %s", filename, err)
+	}
+	defer f.Close()
+
+	_, err = f.WriteString(base64.StdEncoding.EncodeToString(key) + "\n")
+	return err
+}
+
+func (p *fileKeyProvider) Name() string {
+	return FileKeyProvider
+}
+
+func (p *fileKeyProvider) load() ([]byte, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.key != nil {
+		return p.key, nil
+	}
+	data, err := ioutil.ReadFile(p.filename)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("not found the key file %s, generate it with 'kubekit rekey --generate-key'", p.filename)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the key file %s. %s", p.filename, err)
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil || len(key) != dataKeySize {
+		return nil, fmt.Errorf("the key file %s does not have a valid key, it should have a base64 encoded key of %d bytes", p.filename, dataKeySize)
+	}
+	p.key = key
+	return key, nil
+}
+
+func (p *fileKeyProvider) ID() (string, error) {
+	key, err := p.load()
+	if err != nil {
+		return "", err
+	}
+	return keyID(key), nil
+}
+
+func (p *fileKeyProvider) WrapKey(dataKey []byte) ([]byte, error) {
+	key, err := p.load()
+	if err != nil {
+		return nil, err
+	}
+	return seal(key, dataKey)
+}
+
+func (p *fileKeyProvider) UnwrapKey(wrappedKey []byte) ([]byte, error) {
+	key, err := p.load()
+	if err != nil {
+		return nil, err
+	}
+	return open(key, wrappedKey)
+}
+
+// passphraseKeyProvider uses the key in the environment variable KUBEKIT_KEY
+type passphraseKeyProvider struct {
+	key []byte
+}
+
+// NewPassphraseKeyProvider creates a key provider with the key in the
+// environment variable KUBEKIT_KEY. The default passphrase is not secure, so it
+// fails if the key is not set or it's the default passphrase
+func NewPassphraseKeyProvider() (KeyProvider, error) {
+	key := os.Getenv(EnvKeyName)
+	if len(key) == 0 || key == DefaultKeyPassphrase {
+		return nil, fmt.Errorf("the encryption at rest cannot use the default passphrase, set a key in the environment variable %s", EnvKeyName)
+	}
+	if !ValidKey([]byte(key)) {
+		return nil, KeyError(key)
+	}
+	return &passphraseKeyProvider{
+		key: []byte(key),
+	}, nil
+}
+
+func (p *passphraseKeyProvider) Name() string {
+	return PassphraseKeyProvider
+}
+
+func (p *passphraseKeyProvider) ID() (string, error) {
+	return keyID(p.key), nil
+}
+
+func (p *passphraseKeyProvider) WrapKey(dataKey []byte) ([]byte, error) {
+	return seal(p.key, dataKey)
+}
+
+func (p *passphraseKeyProvider) UnwrapKey(wrappedKey []byte) ([]byte, error) {
+	return open(p.key, wrappedKey)
+}
+
+// commandKeyProvider uses external commands to encrypt and decrypt the data
+// keys. The encrypt command receives the base64 encoded data key in the
+// standard input and prints the encrypted data key, the decrypt command receives
+// the encrypted data key and prints the base64 encoded data key
+type commandKeyProvider struct {
+	encryptCommand string
+	decryptCommand string
+	id             string
+}
+
+// NewCommandKeyProvider creates a key provider that runs the given commands to
+// encrypt and decrypt the data keys. The key ID identifies the key used by the
+// commands, it should change when the key changes
+func NewCommandKeyProvider(encryptCommand, decryptCommand, id string) (KeyProvider, error) {
+	if len(encryptCommand) == 0 || len(decryptCommand) == 0 {
+		return nil, fmt.Errorf("the encrypt and decrypt commands are required for the %s key provider", CommandKeyProvider)
+	}
+	if len(id) == 0 {
+		id = CommandKeyProvider
+	}
+	return &commandKeyProvider{
+		encryptCommand: encryptCommand,
+		decryptCommand: decryptCommand,
+		id:             id,
+	}, nil
+}
+
+func (p *commandKeyProvider) Name() string {
+	return CommandKeyProvider
+}
+
+func (p *commandKeyProvider) ID() (string, error) {
+	return p.id, nil
+}
+
+func (p *commandKeyProvider) WrapKey(dataKey []byte) ([]byte, error) {
+	return runKeyCommand(p.encryptCommand, []byte(base64.StdEncoding.EncodeToString(dataKey)))
+}
+
+func (p *commandKeyProvider) UnwrapKey(wrappedKey []byte) ([]byte, error) {
+	out, err := runKeyCommand(p.decryptCommand, wrappedKey)
+	if err != nil {
+		return nil, err
+	}
+	dataKey, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(out)))
+	if err != nil || len(dataKey) != dataKeySize {
+		return nil, fmt.Errorf("the decrypt command did not print a base64 encoded data key")
+	}
+	return dataKey, nil
+}
+
+func runKeyCommand(command string, input []byte) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("/bin/sh", "-c", command)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("the command %q failed. %s: %s", command, err, strings.TrimSpace(stderr.String()))
+	}
+	if stdout.Len() == 0 {
+		return nil, fmt.Errorf("the command %q did not print anything", command)
+	}
+	return stdout.Bytes(), nil
+}
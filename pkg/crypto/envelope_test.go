@@ -0,0 +1,122 @@
+package crypto_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/liferaft/kubekit/pkg/crypto"
+)
+
+func TestEnvelope(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kubekit-envelope")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	keyFile := filepath.Join(dir, crypto.DefaultKeyFilename)
+	oldKeyFile := filepath.Join(dir, "old.key")
+	for _, f := range []string{keyFile, oldKeyFile} {
+		if err := crypto.GenerateKeyFile(f); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := crypto.GenerateKeyFile(keyFile); err == nil {
+		t.Errorf("GenerateKeyFile() expected an error, the key file exists")
+	}
+
+	os.Setenv(crypto.EnvKeyName, "4bCd3fGhiO!23As6")
+	defer os.Unsetenv(crypto.EnvKeyName)
+	passphrase, err := crypto.NewPassphraseKeyProvider()
+	if err != nil {
+		t.Fatal(err)
+	}
+	command, err := crypto.NewCommandKeyProvider("cat", "cat", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plaintext := []byte(`{"version": 4, "serial": 1}`)
+
+	tests := []struct {
+		name    string
+		encrypt *crypto.Envelope
+		decrypt *crypto.Envelope
+		wantErr bool
+	}{
+		{"file", crypto.NewEnvelope(crypto.NewFileKeyProvider(keyFile)), crypto.NewEnvelope(crypto.NewFileKeyProvider(keyFile)), false},
+		{"passphrase", crypto.NewEnvelope(passphrase), crypto.NewEnvelope(passphrase), false},
+		{"command", crypto.NewEnvelope(command), crypto.NewEnvelope(command), false},
+		{"previous key", crypto.NewEnvelope(crypto.NewFileKeyProvider(oldKeyFile)), crypto.NewEnvelope(crypto.NewFileKeyProvider(keyFile), crypto.NewFileKeyProvider(oldKeyFile)), false},
+		{"wrong key", crypto.NewEnvelope(crypto.NewFileKeyProvider(oldKeyFile)), crypto.NewEnvelope(crypto.NewFileKeyProvider(keyFile)), true},
+		{"missing key file", crypto.NewEnvelope(crypto.NewFileKeyProvider(filepath.Join(dir, "missing.key"))), nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.encrypt.Encrypt(plaintext)
+			if err != nil {
+				if !tt.wantErr {
+					t.Fatalf("Encrypt() error = %v", err)
+				}
+				return
+			}
+			if !crypto.IsEnvelope(data) {
+				t.Fatalf("Encrypt() = %s, it's not an envelope", data)
+			}
+
+			got, err := tt.decrypt.Decrypt(data)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Decrypt() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && string(got) != string(plaintext) {
+				t.Errorf("Decrypt() = %s, want %s", got, plaintext)
+			}
+		})
+	}
+}
+
+func TestEnvelope_DecryptPlaintext(t *testing.T) {
+	e := crypto.NewEnvelope(crypto.NewFileKeyProvider("/not/found/kubekit.key"))
+	plaintext := []byte("platform: ec2\n")
+
+	got, err := e.Decrypt(plaintext)
+	if err != nil || string(got) != string(plaintext) {
+		t.Errorf("Decrypt() = %q, %v; want the plaintext", got, err)
+	}
+}
+
+func TestNewKeyProvider(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		config  *crypto.EncryptionConfig
+		want    string
+		wantErr bool
+	}{
+		{"default", "", &crypto.EncryptionConfig{KeyFile: "/tmp/kubekit.key"}, crypto.FileKeyProvider, false},
+		{"file without key file", "", &crypto.EncryptionConfig{KeyProvider: "file"}, "", true},
+		{"passphrase", "4bCd3fGhiO!23As6", &crypto.EncryptionConfig{KeyProvider: "passphrase"}, crypto.PassphraseKeyProvider, false},
+		{"passphrase not set", "", &crypto.EncryptionConfig{KeyProvider: "passphrase"}, "", true},
+		{"default passphrase", crypto.DefaultKeyPassphrase, &crypto.EncryptionConfig{KeyProvider: "passphrase"}, "", true},
+		{"invalid passphrase", "secret", &crypto.EncryptionConfig{KeyProvider: "passphrase"}, "", true},
+		{"command", "", &crypto.EncryptionConfig{KeyProvider: "command", EncryptCommand: "vault-encrypt", DecryptCommand: "vault-decrypt"}, crypto.CommandKeyProvider, false},
+		{"command without decrypt", "", &crypto.EncryptionConfig{KeyProvider: "command", EncryptCommand: "vault-encrypt"}, "", true},
+		{"unknown", "", &crypto.EncryptionConfig{KeyProvider: "kms"}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			os.Setenv(crypto.EnvKeyName, tt.key)
+			defer os.Unsetenv(crypto.EnvKeyName)
+
+			got, err := crypto.NewKeyProvider(tt.config)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewKeyProvider() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got.Name() != tt.want {
+				t.Errorf("NewKeyProvider() = %s, want %s", got.Name(), tt.want)
+			}
+		})
+	}
+}
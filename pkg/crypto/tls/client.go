@@ -0,0 +1,49 @@
+package tls
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"time"
+)
+
+// NewClientKeyPair creates a key pair, not saved to files, with a client
+// certificate for the given user and groups signed by the CA key pair. The
+// groups are the Organizations of the certificate, as Kubernetes reads them.
+// The certificate is valid for the given TTL but not after the CA certificate
+func NewClientKeyPair(caKeyPair *KeyPair, user string, groups []string, ttl time.Duration, opts *KeyOptions) (*KeyPair, error) {
+	if len(user) == 0 {
+		return nil, fmt.Errorf("the user name is required to create a client certificate")
+	}
+	if ttl <= 0 {
+		return nil, fmt.Errorf("invalid TTL %s for the client certificate of %s", ttl, user)
+	}
+	if caKeyPair == nil || caKeyPair.PrivateKey == nil || caKeyPair.Certificate == nil {
+		return nil, fmt.Errorf("the CA private key and certificate are required to sign the client certificate of %s", user)
+	}
+
+	privKey, err := GenPrivateKey(opts.keyAlgorithm())
+	if err != nil {
+		return nil, err
+	}
+
+	subject := pkix.Name{
+		CommonName:   user,
+		Organization: groups,
+	}
+	cert, err := signCert(privKey, caKeyPair, subject, nil, nil, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}, ttl, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign the client certificate of %s. %s", user, err)
+	}
+
+	return &KeyPair{
+		Name:           user,
+		CN:             user,
+		PrivateKey:     privKey,
+		PrivateKeyPEM:  pemEncodePrivateKey(privKey),
+		Certificate:    cert,
+		CertificatePEM: pemEncodeCert(cert),
+		ExtKeyUsage:    []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		Opts:           opts,
+	}, nil
+}
@@ -0,0 +1,78 @@
+package tls
+
+import (
+	"crypto/x509"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestNewClientKeyPair(t *testing.T) {
+	certsDir, err := ioutil.TempDir("", "client")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(certsDir)
+
+	ca, err := NewCAKeyPair(&KeyPair{}, certsDir, "ca", "test-ca", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caCertOnly := &KeyPair{Name: "ca", CN: "test-ca", Certificate: ca.Certificate}
+
+	tests := []struct {
+		name       string
+		ca         *KeyPair
+		user       string
+		groups     []string
+		ttl        time.Duration
+		opts       *KeyOptions
+		wantExpiry time.Time
+		wantErr    bool
+	}{
+		{"user and groups", ca, "alice", []string{"devs", "ops"}, 24 * time.Hour, nil, time.Now().Add(24 * time.Hour), false},
+		{"no groups", ca, "bob", nil, time.Hour, &KeyOptions{KeyAlgorithm: ECDSAP256}, time.Now().Add(time.Hour), false},
+		{"ttl after the CA", ca, "alice", []string{"devs"}, 100 * 365 * 24 * time.Hour, nil, ca.Certificate.NotAfter, false},
+		{"no user", ca, "", []string{"devs"}, time.Hour, nil, time.Time{}, true},
+		{"zero ttl", ca, "alice", nil, 0, nil, time.Time{}, true},
+		{"CA without key", caCertOnly, "alice", nil, time.Hour, nil, time.Time{}, true},
+		{"no CA", nil, "alice", nil, time.Hour, nil, time.Time{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewClientKeyPair(tt.ca, tt.user, tt.groups, tt.ttl, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewClientKeyPair() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			cert := got.Certificate
+			if cert.Subject.CommonName != tt.user {
+				t.Errorf("NewClientKeyPair() CN = %q, want %q", cert.Subject.CommonName, tt.user)
+			}
+			// The groups are encoded as a set, the order is not kept
+			gotGroups := append([]string{}, cert.Subject.Organization...)
+			sort.Strings(gotGroups)
+			if len(tt.groups) != 0 && !reflect.DeepEqual(gotGroups, tt.groups) {
+				t.Errorf("NewClientKeyPair() O = %v, want %v", cert.Subject.Organization, tt.groups)
+			}
+			if !reflect.DeepEqual(cert.ExtKeyUsage, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}) {
+				t.Errorf("NewClientKeyPair() ExtKeyUsage = %v, want only client auth", cert.ExtKeyUsage)
+			}
+			if diff := cert.NotAfter.Sub(tt.wantExpiry); diff > time.Minute || diff < -time.Minute {
+				t.Errorf("NewClientKeyPair() NotAfter = %v, want %v", cert.NotAfter, tt.wantExpiry)
+			}
+			if err := cert.CheckSignatureFrom(ca.Certificate); err != nil {
+				t.Errorf("NewClientKeyPair() certificate is not signed by the CA. %s", err)
+			}
+			if len(got.CertFile) != 0 || len(got.KeyFile) != 0 {
+				t.Errorf("NewClientKeyPair() key pair has files, want no files")
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package tls
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"time"
+)
+
+// NewExternalCAKeyPair creates a CA Key Pair without private key, for a CA
+// that signs the certificate requests outside of KubeKit. The CA certificate
+// is read from the given file or, if empty, from the certificates directory
+func NewExternalCAKeyPair(certFile, baseCertsDir, name, cn string) (*KeyPair, error) {
+	kp := NewEmptyKeyPair(baseCertsDir, name, cn, "", []string{}, []string{}, nil, nil)
+	if len(certFile) == 0 {
+		certFile = kp.CertFile
+	}
+
+	certPEM, err := ioutil.ReadFile(certFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading the CA certificate file %s. %s", certFile, err)
+	}
+	cert, err := parseCertificatePEM(certPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the CA certificate file %s. %s", certFile, err)
+	}
+	if !cert.IsCA {
+		return nil, fmt.Errorf("the certificate in %s is not a CA certificate", certFile)
+	}
+
+	kp.IsCA = true
+	kp.Certificate = cert
+	kp.CertificatePEM = certPEM
+	kp.CN = cert.Subject.CommonName
+
+	return kp, nil
+}
+
+// IsExternal returns true if the key pair is a CA certificate without private
+// key, so the certificates have to be signed by an external CA
+func (kp *KeyPair) IsExternal() bool {
+	return kp.IsCA && kp.PrivateKey == nil && kp.Certificate != nil
+}
+
+// CertificateRequest returns the PEM encoded certificate signing request for
+// the key pair, the private key has to be generated before
+func (kp *KeyPair) CertificateRequest() ([]byte, error) {
+	if kp.PrivateKey == nil {
+		return nil, fmt.Errorf("no private key to create the certificate request for %s", kp.Name)
+	}
+
+	var organizations []string
+	if len(kp.O) != 0 {
+		organizations = []string{kp.O}
+	}
+	template := x509.CertificateRequest{
+		Subject: pkix.Name{
+			CommonName:   kp.CN,
+			Organization: organizations,
+		},
+		DNSNames:    kp.DNSNames,
+		IPAddresses: parseIPs(kp.IPAddresses),
+	}
+
+	der, err := x509.CreateCertificateRequest(rand.Reader, &template, kp.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the certificate request for %s. %s", kp.Name, err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE REQUEST",
+		Bytes: der,
+	}), nil
+}
+
+// ImportCertificate sets the given PEM encoded certificate, signed by the
+// given CA, to the key pair if it's valid for the key pair
+func (kp *KeyPair) ImportCertificate(certPEM []byte, ca *KeyPair) error {
+	cert, err := parseCertificatePEM(certPEM)
+	if err != nil {
+		return fmt.Errorf("failed to parse the certificate for %s. %s", kp.Name, err)
+	}
+	if err := kp.Verify(cert, ca.Certificate); err != nil {
+		return err
+	}
+
+	kp.Certificate = cert
+	kp.CertificatePEM = certPEM
+
+	return nil
+}
+
+// Verify returns an error if the given certificate is not valid for this key
+// pair: it has to be signed by the given CA certificate and to have the key
+// pair public key, Common Name, Organization, SANs and key usages
+func (kp *KeyPair) Verify(cert, caCert *x509.Certificate) error {
+	if kp.PrivateKey == nil {
+		return fmt.Errorf("no private key to verify the certificate for %s", kp.Name)
+	}
+	certPubKey, err := x509.MarshalPKIXPublicKey(cert.PublicKey)
+	if err != nil {
+		return fmt.Errorf("the certificate for %s has an unsupported public key. %s", kp.Name, err)
+	}
+	pubKey, err := x509.MarshalPKIXPublicKey(kp.PrivateKey.Public())
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(certPubKey, pubKey) {
+		return fmt.Errorf("the certificate for %s does not match the private key", kp.Name)
+	}
+
+	if cert.IsCA {
+		return fmt.Errorf("the certificate for %s is a CA certificate", kp.Name)
+	}
+	if cert.Subject.CommonName != kp.CN {
+		return fmt.Errorf("the certificate for %s has the Common Name %q, expected %q", kp.Name, cert.Subject.CommonName, kp.CN)
+	}
+	if len(kp.O) != 0 && !inList(cert.Subject.Organization, kp.O) {
+		return fmt.Errorf("the certificate for %s does not have the Organization %q", kp.Name, kp.O)
+	}
+
+	roots := x509.NewCertPool()
+	roots.AddCert(caCert)
+	if _, err := cert.Verify(x509.VerifyOptions{
+		Roots:       roots,
+		CurrentTime: time.Now(),
+		KeyUsages:   []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}); err != nil {
+		return fmt.Errorf("the certificate for %s is not signed by the CA %s. %s", kp.Name, caCert.Subject.CommonName, err)
+	}
+
+	for _, name := range append(append([]string{}, kp.DNSNames...), kp.IPAddresses...) {
+		if err := cert.VerifyHostname(name); err != nil {
+			return fmt.Errorf("the certificate for %s does not include the SAN %s", kp.Name, name)
+		}
+	}
+
+	if cert.KeyUsage != 0 && cert.KeyUsage&x509.KeyUsageDigitalSignature == 0 {
+		return fmt.Errorf("the certificate for %s cannot be used for digital signature", kp.Name)
+	}
+	for _, usage := range kp.ExtKeyUsage {
+		if !hasExtKeyUsage(cert, usage) {
+			return fmt.Errorf("the certificate for %s does not have the extended key usage %s", kp.Name, extKeyUsageName(usage))
+		}
+	}
+
+	return nil
+}
+
+// hasExtKeyUsage returns true if the certificate can be used for the given
+// extended key usage, a certificate without extended key usages can be used
+// for any
+func hasExtKeyUsage(cert *x509.Certificate, usage x509.ExtKeyUsage) bool {
+	if len(cert.ExtKeyUsage) == 0 {
+		return true
+	}
+	for _, u := range cert.ExtKeyUsage {
+		if u == usage || u == x509.ExtKeyUsageAny {
+			return true
+		}
+	}
+	return false
+}
+
+func extKeyUsageName(usage x509.ExtKeyUsage) string {
+	switch usage {
+	case x509.ExtKeyUsageServerAuth:
+		return "server auth"
+	case x509.ExtKeyUsageClientAuth:
+		return "client auth"
+	}
+	return fmt.Sprintf("%d", usage)
+}
+
+// ImportFrom sets to the key pair the private key and certificate, signed by
+// the given CA, from the files with the key pair name in the given directory.
+// The key pair is not modified if the files does not exists or the
+// certificate is not valid for the key pair
+func (kp *KeyPair) ImportFrom(dir string, ca *KeyPair) error {
+	loaded, err := Load(dir, kp.Name, kp.CN)
+	if err != nil {
+		return err
+	}
+
+	imported := *kp
+	imported.PrivateKey = loaded.PrivateKey
+	imported.PrivateKeyPEM = loaded.PrivateKeyPEM
+	if err := imported.ImportCertificate(loaded.CertificatePEM, ca); err != nil {
+		return err
+	}
+	*kp = imported
+
+	return nil
+}
+
+func parseCertificatePEM(data []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("cannot find a PEM encoded certificate")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+func parseIPs(ips []string) []net.IP {
+	ipAddresses := []net.IP{}
+	for _, ip := range ips {
+		if addr := net.ParseIP(ip); addr != nil {
+			ipAddresses = append(ipAddresses, addr)
+		}
+	}
+	return ipAddresses
+}
+
+func inList(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
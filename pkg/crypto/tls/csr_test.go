@@ -0,0 +1,253 @@
+package tls
+
+import (
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// signRequest signs the certificate request as an external CA would do, the
+// modify function changes the certificate to sign
+func signRequest(t *testing.T, csrPEM []byte, ca *KeyPair, modify func(*x509.Certificate)) []byte {
+	block, _ := pem.Decode(csrPEM)
+	if block == nil {
+		t.Fatal("cannot decode the certificate request")
+	}
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := csr.CheckSignature(); err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      csr.Subject,
+		DNSNames:     csr.DNSNames,
+		IPAddresses:  csr.IPAddresses,
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	pubKey := csr.PublicKey
+	if modify != nil {
+		modify(template)
+		if template.PublicKey != nil {
+			pubKey = template.PublicKey
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.Certificate, pubKey, ca.PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestKeyPair_ImportCertificate(t *testing.T) {
+	certsDir, err := ioutil.TempDir("", "csr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(certsDir)
+
+	ca, err := NewCAKeyPair(&KeyPair{}, certsDir, "ca", "test-ca", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ca.SaveCertificate(true); err != nil {
+		t.Fatal(err)
+	}
+	otherCA, err := NewCAKeyPair(&KeyPair{}, certsDir, "other_ca", "other-ca", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey, err := GenPrivateKey(ECDSAP256)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The external CA has only the certificate
+	externalCA, err := NewExternalCAKeyPair(ca.CertFile, certsDir, "external_ca", "test-ca")
+	if err != nil {
+		t.Fatalf("NewExternalCAKeyPair() error = %v", err)
+	}
+	if !externalCA.IsExternal() || ca.IsExternal() {
+		t.Errorf("KeyPair.IsExternal() = %v for the external CA and %v for the CA", externalCA.IsExternal(), ca.IsExternal())
+	}
+
+	tests := []struct {
+		name    string
+		signer  *KeyPair
+		modify  func(*x509.Certificate)
+		wantErr bool
+	}{
+		{"valid", ca, nil, false},
+		{"no key usages", ca, func(c *x509.Certificate) { c.KeyUsage = 0; c.ExtKeyUsage = nil }, false},
+		{"any extended key usage", ca, func(c *x509.Certificate) { c.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageAny} }, false},
+		{"signed by other CA", otherCA, nil, true},
+		{"other public key", ca, func(c *x509.Certificate) { c.PublicKey = otherKey.Public() }, true},
+		{"missing DNS SAN", ca, func(c *x509.Certificate) { c.DNSNames = []string{"localhost"} }, true},
+		{"missing IP SAN", ca, func(c *x509.Certificate) { c.IPAddresses = []net.IP{net.ParseIP("127.0.0.1")} }, true},
+		{"other common name", ca, func(c *x509.Certificate) { c.Subject = pkix.Name{CommonName: "other", Organization: []string{"test"}} }, true},
+		{"missing organization", ca, func(c *x509.Certificate) { c.Subject = pkix.Name{CommonName: "test-node"} }, true},
+		{"missing extended key usage", ca, func(c *x509.Certificate) { c.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth} }, true},
+		{"no digital signature", ca, func(c *x509.Certificate) { c.KeyUsage = x509.KeyUsageKeyEncipherment }, true},
+		{"CA certificate", ca, func(c *x509.Certificate) { c.IsCA = true; c.BasicConstraintsValid = true }, true},
+		{"expired", ca, func(c *x509.Certificate) { c.NotAfter = time.Now().Add(-time.Second) }, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kp := NewEmptyKeyPair(certsDir, "node", "test-node", "test", []string{"localhost", "node0"}, []string{"127.0.0.1", "10.0.0.1"}, []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}, nil)
+			if err := kp.GenKeyPair(nil); err != nil {
+				t.Fatal(err)
+			}
+			csrPEM, err := kp.CertificateRequest()
+			if err != nil {
+				t.Fatalf("KeyPair.CertificateRequest() error = %v", err)
+			}
+			certPEM := signRequest(t, csrPEM, tt.signer, tt.modify)
+
+			err = kp.ImportCertificate(certPEM, externalCA)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("KeyPair.ImportCertificate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if gotCert := kp.Certificate != nil; gotCert == tt.wantErr {
+				t.Errorf("KeyPair.ImportCertificate() certificate set = %v, want %v", gotCert, !tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestKeyPair_ImportFrom(t *testing.T) {
+	certsDir, err := ioutil.TempDir("", "csr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(certsDir)
+
+	ca, err := NewCAKeyPair(&KeyPair{}, certsDir, "ca", "test-ca", &KeyOptions{KeyAlgorithm: ECDSAP256})
+	if err != nil {
+		t.Fatal(err)
+	}
+	csrDir := filepath.Join(certsDir, "csr")
+	if err := os.MkdirAll(csrDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	newKeyPair := func() *KeyPair {
+		return NewEmptyKeyPair(certsDir, "admin", "admin", "system:masters", nil, nil, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}, &KeyOptions{KeyAlgorithm: ECDSAP256})
+	}
+
+	kp := newKeyPair()
+	if err := kp.ImportFrom(csrDir, ca); !os.IsNotExist(err) {
+		t.Fatalf("KeyPair.ImportFrom() error = %v, want a not exist error", err)
+	}
+
+	requested := newKeyPair()
+	requested.NewFilenames(csrDir, "")
+	if err := requested.GenKeyPair(nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := requested.SavePrivateKey(true); err != nil {
+		t.Fatal(err)
+	}
+	csrPEM, err := requested.CertificateRequest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(requested.CertFile, signRequest(t, csrPEM, ca, nil), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := kp.ImportFrom(csrDir, ca); err != nil {
+		t.Fatalf("KeyPair.ImportFrom() error = %v", err)
+	}
+	if kp.KeyFile != filepath.Join(certsDir, "admin.key") {
+		t.Errorf("KeyPair.ImportFrom() key file = %s, want it in %s", kp.KeyFile, certsDir)
+	}
+	if kp.Certificate == nil || kp.PrivateKey == nil {
+		t.Fatalf("KeyPair.ImportFrom() did not set the certificate and private key")
+	}
+	if !equalKeys(kp.PrivateKey, requested.PrivateKey) {
+		t.Errorf("KeyPair.ImportFrom() private key is not the requested private key")
+	}
+
+	// A certificate for another private key is not imported
+	other := newKeyPair()
+	other.NewFilenames(csrDir, "")
+	if err := other.GenKeyPair(nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := other.SavePrivateKey(true); err != nil {
+		t.Fatal(err)
+	}
+	kp = newKeyPair()
+	if err := kp.ImportFrom(csrDir, ca); err == nil || os.IsNotExist(err) {
+		t.Errorf("KeyPair.ImportFrom() error = %v, want a verification error", err)
+	}
+	if kp.PrivateKey != nil || kp.Certificate != nil {
+		t.Errorf("KeyPair.ImportFrom() modified the key pair with an invalid certificate")
+	}
+}
+
+func TestNewExternalCAKeyPair(t *testing.T) {
+	certsDir, err := ioutil.TempDir("", "csr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(certsDir)
+
+	ca, err := NewCAKeyPair(&KeyPair{}, certsDir, "ca", "test-ca", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	kp, err := NewKeyPair(certsDir, "node", "test-node", "", nil, nil, ca, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, saved := range []*KeyPair{ca, kp} {
+		if err := saved.SaveCertificate(true); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		certFile string
+		wantErr  bool
+	}{
+		{"from file", ca.CertFile, false},
+		{"from certificates directory", "", false},
+		{"not a CA", kp.CertFile, true},
+		{"not found", filepath.Join(certsDir, "foo.crt"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name := "ca"
+			if len(tt.certFile) != 0 {
+				name = "external_ca"
+			}
+			got, err := NewExternalCAKeyPair(tt.certFile, certsDir, name, "test-ca")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewExternalCAKeyPair() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if !got.IsExternal() || !got.Certificate.Equal(ca.Certificate) {
+				t.Errorf("NewExternalCAKeyPair() = %v, want an external CA with the certificate %s", got, ca.CertFile)
+			}
+		})
+	}
+}
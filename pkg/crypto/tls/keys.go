@@ -0,0 +1,251 @@
+package tls
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Algorithms to generate the private keys
+const (
+	RSA2048   = "rsa-2048"
+	RSA3072   = "rsa-3072"
+	RSA4096   = "rsa-4096"
+	ECDSAP256 = "ecdsa-p256"
+	ECDSAP384 = "ecdsa-p384"
+	Ed25519   = "ed25519"
+)
+
+// DefaultKeyAlgorithm is the algorithm to generate the private keys if it's
+// not set in the options
+const DefaultKeyAlgorithm = RSA2048
+
+// KeyAlgorithms is the list of supported algorithms to generate private keys
+var KeyAlgorithms = []string{RSA2048, RSA3072, RSA4096, ECDSAP256, ECDSAP384, Ed25519}
+
+// signatureAlgorithms are the signature algorithms that can be requested for
+// every type of CA private key
+var signatureAlgorithms = map[string][]x509.SignatureAlgorithm{
+	"rsa": {
+		x509.SHA256WithRSA, x509.SHA384WithRSA, x509.SHA512WithRSA,
+		x509.SHA256WithRSAPSS, x509.SHA384WithRSAPSS, x509.SHA512WithRSAPSS,
+	},
+	"ecdsa":   {x509.ECDSAWithSHA256, x509.ECDSAWithSHA384, x509.ECDSAWithSHA512},
+	"ed25519": {x509.PureEd25519},
+}
+
+// KeyOptions are the parameters to generate the private keys and certificates.
+// The zero value generates RSA 2048 keys and certificates valid for Duration
+// days signed with the default signature algorithm of the CA key
+type KeyOptions struct {
+	KeyAlgorithm       string
+	ValidityDays       int
+	SignatureAlgorithm string
+}
+
+// Validate returns an error if the key algorithm, validity or signature
+// algorithm are unknown or the signature algorithm cannot be used with the key
+// algorithm
+func (o *KeyOptions) Validate() error {
+	if o == nil {
+		return nil
+	}
+	if len(o.KeyAlgorithm) != 0 && keyType(o.KeyAlgorithm) == "" {
+		return fmt.Errorf("unknown key algorithm %q, the supported algorithms are: %s", o.KeyAlgorithm, strings.Join(KeyAlgorithms, ", "))
+	}
+	if o.ValidityDays < 0 {
+		return fmt.Errorf("invalid certificates validity %d, the number of days has to be greater than 0", o.ValidityDays)
+	}
+	if len(o.SignatureAlgorithm) == 0 {
+		return nil
+	}
+	sigAlg, err := ParseSignatureAlgorithm(o.SignatureAlgorithm)
+	if err != nil {
+		return err
+	}
+	if !validSignatureAlgorithm(keyType(o.keyAlgorithm()), sigAlg) {
+		return fmt.Errorf("the signature algorithm %s cannot be used with %s keys", sigAlg, o.keyAlgorithm())
+	}
+	return nil
+}
+
+func (o *KeyOptions) keyAlgorithm() string {
+	if o == nil || len(o.KeyAlgorithm) == 0 {
+		return DefaultKeyAlgorithm
+	}
+	return strings.ToLower(o.KeyAlgorithm)
+}
+
+// validity returns the duration of the certificates
+func (o *KeyOptions) validity() time.Duration {
+	days := Duration
+	if o != nil && o.ValidityDays > 0 {
+		days = o.ValidityDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// signatureAlgorithm returns the signature algorithm to sign certificates with
+// the given CA key, or the default algorithm for such key if it's not set
+func (o *KeyOptions) signatureAlgorithm(caKey crypto.Signer) (x509.SignatureAlgorithm, error) {
+	if o == nil || len(o.SignatureAlgorithm) == 0 {
+		return x509.UnknownSignatureAlgorithm, nil
+	}
+	sigAlg, err := ParseSignatureAlgorithm(o.SignatureAlgorithm)
+	if err != nil {
+		return x509.UnknownSignatureAlgorithm, err
+	}
+	if t := keyTypeOf(caKey); !validSignatureAlgorithm(t, sigAlg) {
+		return x509.UnknownSignatureAlgorithm, fmt.Errorf("the signature algorithm %s cannot be used with the %s key of the CA certificate", sigAlg, t)
+	}
+	return sigAlg, nil
+}
+
+// ParseSignatureAlgorithm returns the x509 signature algorithm with the given
+// name, such as "SHA256-RSA", "SHA384-RSAPSS", "ECDSA-SHA256" or "Ed25519"
+func ParseSignatureAlgorithm(name string) (x509.SignatureAlgorithm, error) {
+	names := []string{}
+	for _, algs := range [][]x509.SignatureAlgorithm{signatureAlgorithms["rsa"], signatureAlgorithms["ecdsa"], signatureAlgorithms["ed25519"]} {
+		for _, alg := range algs {
+			if strings.EqualFold(alg.String(), name) {
+				return alg, nil
+			}
+			names = append(names, alg.String())
+		}
+	}
+	return x509.UnknownSignatureAlgorithm, fmt.Errorf("unknown signature algorithm %q, the supported algorithms are: %s", name, strings.Join(names, ", "))
+}
+
+func validSignatureAlgorithm(keyType string, sigAlg x509.SignatureAlgorithm) bool {
+	for _, alg := range signatureAlgorithms[keyType] {
+		if alg == sigAlg {
+			return true
+		}
+	}
+	return false
+}
+
+// keyType returns the type of key (rsa, ecdsa or ed25519) generated with the
+// given algorithm, empty if the algorithm is unknown
+func keyType(algorithm string) string {
+	switch strings.ToLower(algorithm) {
+	case RSA2048, RSA3072, RSA4096:
+		return "rsa"
+	case ECDSAP256, ECDSAP384:
+		return "ecdsa"
+	case Ed25519:
+		return "ed25519"
+	}
+	return ""
+}
+
+// keyTypeOf returns the type of the given private key
+func keyTypeOf(key crypto.Signer) string {
+	switch key.(type) {
+	case *rsa.PrivateKey:
+		return "rsa"
+	case *ecdsa.PrivateKey:
+		return "ecdsa"
+	case ed25519.PrivateKey:
+		return "ed25519"
+	}
+	return "unknown"
+}
+
+// GenPrivateKey generates a private key with the given algorithm, or the
+// default algorithm if it's empty
+func GenPrivateKey(algorithm string) (crypto.Signer, error) {
+	if len(algorithm) == 0 {
+		algorithm = DefaultKeyAlgorithm
+	}
+
+	var key crypto.Signer
+	var err error
+	switch strings.ToLower(algorithm) {
+	case RSA2048:
+		key, err = rsa.GenerateKey(rand.Reader, 2048)
+	case RSA3072:
+		key, err = rsa.GenerateKey(rand.Reader, 3072)
+	case RSA4096:
+		key, err = rsa.GenerateKey(rand.Reader, 4096)
+	case ECDSAP256:
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case ECDSAP384:
+		key, err = ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	case Ed25519:
+		_, key, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return nil, fmt.Errorf("unknown key algorithm %q, the supported algorithms are: %s", algorithm, strings.Join(KeyAlgorithms, ", "))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed generating the %s Key. %s", algorithm, err)
+	}
+	return key, nil
+}
+
+// keyUsage returns the key usage of a certificate for the given private key.
+// Only the RSA keys are used for key encipherment
+func keyUsage(key crypto.Signer) x509.KeyUsage {
+	if _, ok := key.(*rsa.PrivateKey); ok {
+		return x509.KeyUsageKeyEncipherment
+	}
+	return 0
+}
+
+// MarshalPrivateKey returns the PEM block type and the DER encoded private key.
+// RSA keys are encoded in PKCS #1, ECDSA keys in SEC 1 and Ed25519 in PKCS #8
+func MarshalPrivateKey(key crypto.Signer) (string, []byte, error) {
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(k), nil
+	case *ecdsa.PrivateKey:
+		der, err := x509.MarshalECPrivateKey(k)
+		return "EC PRIVATE KEY", der, err
+	case ed25519.PrivateKey:
+		der, err := x509.MarshalPKCS8PrivateKey(k)
+		return "PRIVATE KEY", der, err
+	}
+	return "", nil, fmt.Errorf("unsupported private key type %T", key)
+}
+
+// ParsePrivateKey parses a DER encoded private key from a PEM block of the
+// given type: PKCS #1 RSA key, SEC 1 EC key or PKCS #8 key
+func ParsePrivateKey(blockType string, der []byte) (crypto.Signer, error) {
+	switch blockType {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(der)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(der)
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(der)
+		if err != nil {
+			return nil, err
+		}
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("unsupported private key type %T", key)
+		}
+		return signer, nil
+	}
+	return nil, fmt.Errorf("unsupported private key PEM block type %q", blockType)
+}
+
+func pemEncodePrivateKey(key crypto.Signer) []byte {
+	blockType, der, err := MarshalPrivateKey(key)
+	if err != nil {
+		return nil
+	}
+	block := pem.Block{
+		Type:  blockType,
+		Bytes: der,
+	}
+	return pem.EncodeToMemory(&block)
+}
@@ -0,0 +1,185 @@
+package tls
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestKeyPair_chainVerification(t *testing.T) {
+	certsDir, err := ioutil.TempDir("", "keys")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(certsDir)
+
+	tests := []struct {
+		name    string
+		opts    *KeyOptions
+		wantSig x509.SignatureAlgorithm
+	}{
+		{"default", nil, x509.SHA256WithRSA},
+		{"empty options", &KeyOptions{}, x509.SHA256WithRSA},
+		{"rsa-2048", &KeyOptions{KeyAlgorithm: RSA2048}, x509.SHA256WithRSA},
+		{"rsa-2048 SHA384-RSA", &KeyOptions{KeyAlgorithm: RSA2048, SignatureAlgorithm: "SHA384-RSA"}, x509.SHA384WithRSA},
+		{"rsa-2048 SHA256-RSAPSS", &KeyOptions{KeyAlgorithm: RSA2048, SignatureAlgorithm: "SHA256-RSAPSS"}, x509.SHA256WithRSAPSS},
+		{"rsa-3072", &KeyOptions{KeyAlgorithm: RSA3072}, x509.SHA256WithRSA},
+		{"rsa-3072 SHA512-RSA", &KeyOptions{KeyAlgorithm: RSA3072, SignatureAlgorithm: "SHA512-RSA"}, x509.SHA512WithRSA},
+		{"rsa-4096", &KeyOptions{KeyAlgorithm: RSA4096}, x509.SHA256WithRSA},
+		{"rsa-4096 SHA512-RSAPSS", &KeyOptions{KeyAlgorithm: RSA4096, SignatureAlgorithm: "sha512-rsapss"}, x509.SHA512WithRSAPSS},
+		{"ecdsa-p256", &KeyOptions{KeyAlgorithm: ECDSAP256}, x509.ECDSAWithSHA256},
+		{"ecdsa-p256 ECDSA-SHA384", &KeyOptions{KeyAlgorithm: ECDSAP256, SignatureAlgorithm: "ECDSA-SHA384"}, x509.ECDSAWithSHA384},
+		{"ecdsa-p384", &KeyOptions{KeyAlgorithm: ECDSAP384}, x509.ECDSAWithSHA384},
+		{"ecdsa-p384 ECDSA-SHA256", &KeyOptions{KeyAlgorithm: ECDSAP384, SignatureAlgorithm: "ECDSA-SHA256"}, x509.ECDSAWithSHA256},
+		{"ecdsa-p384 ECDSA-SHA512", &KeyOptions{KeyAlgorithm: ECDSAP384, SignatureAlgorithm: "ECDSA-SHA512"}, x509.ECDSAWithSHA512},
+		{"ed25519", &KeyOptions{KeyAlgorithm: Ed25519}, x509.PureEd25519},
+		{"ed25519 Ed25519", &KeyOptions{KeyAlgorithm: Ed25519, SignatureAlgorithm: "Ed25519"}, x509.PureEd25519},
+		{"validity 30 days", &KeyOptions{KeyAlgorithm: ECDSAP256, ValidityDays: 30}, x509.ECDSAWithSHA256},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.opts.Validate(); err != nil {
+				t.Fatalf("KeyOptions.Validate() error = %v", err)
+			}
+
+			ca, err := NewCAKeyPair(&KeyPair{}, certsDir, "ca", "test-ca", tt.opts)
+			if err != nil {
+				t.Fatalf("NewCAKeyPair() error = %v", err)
+			}
+			kp, err := NewKeyPair(certsDir, "node", "test-node", "test", []string{"localhost"}, []string{"127.0.0.1"}, ca, []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}, tt.opts)
+			if err != nil {
+				t.Fatalf("NewKeyPair() error = %v", err)
+			}
+
+			for _, cert := range []*x509.Certificate{ca.Certificate, kp.Certificate} {
+				if cert.SignatureAlgorithm != tt.wantSig {
+					t.Errorf("certificate %s signature algorithm = %v, want %v", cert.Subject.CommonName, cert.SignatureAlgorithm, tt.wantSig)
+				}
+			}
+			wantKeyType := keyType(tt.opts.keyAlgorithm())
+			if got := keyTypeOf(kp.PrivateKey); got != wantKeyType {
+				t.Errorf("private key type = %v, want %v", got, wantKeyType)
+			}
+			if got := kp.Certificate.KeyUsage&x509.KeyUsageKeyEncipherment != 0; got != (wantKeyType == "rsa") {
+				t.Errorf("key encipherment usage = %v for %s keys", got, wantKeyType)
+			}
+			if validity := kp.Certificate.NotAfter.Sub(time.Now()); validity > tt.opts.validity() {
+				t.Errorf("certificate validity = %v, want up to %v", validity, tt.opts.validity())
+			}
+			if kp.Certificate.NotAfter.After(ca.Certificate.NotAfter) {
+				t.Errorf("certificate expires %v after the CA certificate %v", kp.Certificate.NotAfter, ca.Certificate.NotAfter)
+			}
+
+			roots := x509.NewCertPool()
+			roots.AddCert(ca.Certificate)
+			if _, err := kp.Certificate.Verify(x509.VerifyOptions{
+				DNSName:   "localhost",
+				Roots:     roots,
+				KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+			}); err != nil {
+				t.Errorf("failed to verify the certificate chain. %s", err)
+			}
+
+			// The key pairs have to be loaded from the saved PEM files
+			for _, saved := range []*KeyPair{ca, kp} {
+				if err := saved.SavePrivateKey(true); err != nil {
+					t.Fatalf("KeyPair.SavePrivateKey() error = %v", err)
+				}
+				if err := saved.SaveCertificate(true); err != nil {
+					t.Fatalf("KeyPair.SaveCertificate() error = %v", err)
+				}
+				loaded, err := Load(certsDir, saved.Name, saved.CN)
+				if err != nil {
+					t.Fatalf("Load() error = %v", err)
+				}
+				if !loaded.Certificate.Equal(saved.Certificate) {
+					t.Errorf("loaded certificate %s is not equal to the saved certificate", saved.Name)
+				}
+				if !equalKeys(loaded.PrivateKey, saved.PrivateKey) {
+					t.Errorf("loaded private key %s is not equal to the saved private key", saved.Name)
+				}
+			}
+		})
+	}
+}
+
+func equalKeys(a, b interface{}) bool {
+	switch key := a.(type) {
+	case *rsa.PrivateKey:
+		other, ok := b.(*rsa.PrivateKey)
+		return ok && key.N.Cmp(other.N) == 0 && key.D.Cmp(other.D) == 0
+	case *ecdsa.PrivateKey:
+		other, ok := b.(*ecdsa.PrivateKey)
+		return ok && key.D.Cmp(other.D) == 0 && key.Curve == other.Curve
+	case ed25519.PrivateKey:
+		other, ok := b.(ed25519.PrivateKey)
+		return ok && bytes.Equal(key, other)
+	}
+	return false
+}
+
+func TestKeyOptions_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    *KeyOptions
+		wantErr bool
+	}{
+		{"nil", nil, false},
+		{"empty", &KeyOptions{}, false},
+		{"all set", &KeyOptions{KeyAlgorithm: ECDSAP384, ValidityDays: 365, SignatureAlgorithm: "ECDSA-SHA384"}, false},
+		{"upper case algorithm", &KeyOptions{KeyAlgorithm: "RSA-4096"}, false},
+		{"signature with default key", &KeyOptions{SignatureAlgorithm: "SHA256-RSAPSS"}, false},
+		{"unknown key algorithm", &KeyOptions{KeyAlgorithm: "dsa-1024"}, true},
+		{"negative validity", &KeyOptions{ValidityDays: -1}, true},
+		{"unknown signature algorithm", &KeyOptions{SignatureAlgorithm: "MD5-RSA"}, true},
+		{"ecdsa signature with rsa key", &KeyOptions{KeyAlgorithm: RSA2048, SignatureAlgorithm: "ECDSA-SHA256"}, true},
+		{"ecdsa signature with default key", &KeyOptions{SignatureAlgorithm: "ECDSA-SHA256"}, true},
+		{"rsa signature with ecdsa key", &KeyOptions{KeyAlgorithm: ECDSAP256, SignatureAlgorithm: "SHA256-RSA"}, true},
+		{"ed25519 signature with ecdsa key", &KeyOptions{KeyAlgorithm: ECDSAP256, SignatureAlgorithm: "Ed25519"}, true},
+		{"rsa signature with ed25519 key", &KeyOptions{KeyAlgorithm: Ed25519, SignatureAlgorithm: "SHA512-RSA"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.opts.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("KeyOptions.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewSignedCert_caSignatureAlgorithm(t *testing.T) {
+	certsDir, err := ioutil.TempDir("", "keys")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(certsDir)
+
+	// A RSA CA, such as one provided by the user, cannot sign with an ECDSA
+	// signature algorithm even if the signed certificate keys are ECDSA
+	ca, err := NewCAKeyPair(&KeyPair{}, certsDir, "ca", "test-ca", &KeyOptions{KeyAlgorithm: RSA2048})
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := &KeyOptions{KeyAlgorithm: ECDSAP256, SignatureAlgorithm: "ECDSA-SHA256"}
+	if _, err := NewKeyPair(certsDir, "node", "test-node", "", nil, nil, ca, nil, opts); err == nil {
+		t.Errorf("NewKeyPair() expected an error signing with ECDSA-SHA256 and a RSA CA key")
+	}
+
+	opts.SignatureAlgorithm = ""
+	kp, err := NewKeyPair(certsDir, "node", "test-node", "", nil, nil, ca, nil, opts)
+	if err != nil {
+		t.Fatalf("NewKeyPair() error = %v", err)
+	}
+	if kp.Certificate.SignatureAlgorithm != x509.SHA256WithRSA {
+		t.Errorf("certificate signature algorithm = %v, want %v", kp.Certificate.SignatureAlgorithm, x509.SHA256WithRSA)
+	}
+	if err := kp.Certificate.CheckSignatureFrom(ca.Certificate); err != nil {
+		t.Errorf("failed to verify the certificate signature. %s", err)
+	}
+}
@@ -1,6 +1,7 @@
 package tls
 
 import (
+	"crypto"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
@@ -19,7 +20,7 @@ import (
 type KeyPair struct {
 	Name           string
 	KeyFile        string
-	PrivateKey     *rsa.PrivateKey
+	PrivateKey     crypto.Signer
 	PrivateKeyPEM  []byte
 	CN             string
 	O              string
@@ -30,6 +31,7 @@ type KeyPair struct {
 	CertificatePEM []byte
 	IsCA           bool
 	ExtKeyUsage    []x509.ExtKeyUsage
+	Opts           *KeyOptions
 }
 
 // KeyPairs is a list of KeyPair
@@ -60,7 +62,7 @@ var (
 const EnvCAKeyPassword = "KUBEKIT_CA_KEY_PASSWORD"
 
 // NewEmptyKeyPair creates a KeyPair with everything but the key and cert
-func NewEmptyKeyPair(baseCertsDir, name, cn, o string, dns, ips []string, extKeyUsage []x509.ExtKeyUsage) *KeyPair {
+func NewEmptyKeyPair(baseCertsDir, name, cn, o string, dns, ips []string, extKeyUsage []x509.ExtKeyUsage, opts *KeyOptions) *KeyPair {
 	keyfile := filepath.Join(baseCertsDir, name+".key")
 	certfile := filepath.Join(baseCertsDir, name+".crt")
 	return &KeyPair{
@@ -72,20 +74,22 @@ func NewEmptyKeyPair(baseCertsDir, name, cn, o string, dns, ips []string, extKey
 		IPAddresses: ips,
 		CertFile:    certfile,
 		ExtKeyUsage: extKeyUsage,
+		Opts:        opts,
 	}
 }
 
-// NewKeyPair creates a new KeyPair with the key and cert
-func NewKeyPair(baseCertsDir, name, cn, o string, dns, ips []string, caKeyPair *KeyPair, extKeyUsage []x509.ExtKeyUsage) (*KeyPair, error) {
-	kp := NewEmptyKeyPair(baseCertsDir, name, cn, o, dns, ips, extKeyUsage)
+// NewKeyPair creates a new KeyPair with the key and cert. The options set the
+// key algorithm, validity and signature algorithm, if nil the defaults are used
+func NewKeyPair(baseCertsDir, name, cn, o string, dns, ips []string, caKeyPair *KeyPair, extKeyUsage []x509.ExtKeyUsage, opts *KeyOptions) (*KeyPair, error) {
+	kp := NewEmptyKeyPair(baseCertsDir, name, cn, o, dns, ips, extKeyUsage, opts)
 	err := kp.GenKeyPair(caKeyPair)
 	return kp, err
 }
 
 // NewCAKeyPair creates a new CA Key Pair from the given filenames or generates
 // them if the files does not exists
-func NewCAKeyPair(fromCAKeyPair *KeyPair, baseCertsDir, name, cn string) (*KeyPair, error) {
-	kp := NewEmptyKeyPair(baseCertsDir, name, cn, "", []string{}, []string{}, nil)
+func NewCAKeyPair(fromCAKeyPair *KeyPair, baseCertsDir, name, cn string, opts *KeyOptions) (*KeyPair, error) {
+	kp := NewEmptyKeyPair(baseCertsDir, name, cn, "", []string{}, []string{}, nil, opts)
 	err := kp.GenCAKeyPair(fromCAKeyPair)
 	return kp, err
 }
@@ -109,7 +113,7 @@ func (kp *KeyPair) NewFilenames(baseCertsDir, name string) (prevKeyFile string,
 // Load creates and loads the key pair from the key and cert files located in the
 // given directory
 func Load(baseCertsDir, name, cn string) (*KeyPair, error) {
-	kp := NewEmptyKeyPair(baseCertsDir, name, cn, "", []string{}, []string{}, nil)
+	kp := NewEmptyKeyPair(baseCertsDir, name, cn, "", []string{}, []string{}, nil, nil)
 	if err := kp.Load(); err != nil {
 		return nil, err
 	}
@@ -154,7 +158,7 @@ func (kp *KeyPair) Load() error {
 
 	der = pemPrivBlock.Bytes
 
-	privKey, err := x509.ParsePKCS1PrivateKey(der)
+	privKey, err := ParsePrivateKey(pemPrivBlock.Type, der)
 	if err != nil {
 		return fmt.Errorf("failed to parse DER encoded private key. %s", err)
 	}
@@ -199,7 +203,7 @@ func (kp *KeyPair) Load() error {
 func (kp *KeyPair) GenKeyPair(caKeyPair *KeyPair) error {
 	kp.IsCA = false
 
-	privKey, err := GenRSAPrivateKey()
+	privKey, err := GenPrivateKey(kp.Opts.keyAlgorithm())
 	if err != nil {
 		return err
 	}
@@ -213,7 +217,7 @@ func (kp *KeyPair) GenKeyPair(caKeyPair *KeyPair) error {
 		return nil
 	}
 
-	cert, certPEM, err := SignedCert(privKey, caKeyPair, kp.CN, kp.O, kp.DNSNames, kp.IPAddresses, kp.ExtKeyUsage)
+	cert, certPEM, err := SignedCert(privKey, caKeyPair, kp.CN, kp.O, kp.DNSNames, kp.IPAddresses, kp.ExtKeyUsage, kp.Opts)
 	if err != nil {
 		return err
 	}
@@ -229,7 +233,7 @@ func (kp *KeyPair) GenKeyPair(caKeyPair *KeyPair) error {
 func (kp *KeyPair) GenCAKeyPair(fromCAKeyPair *KeyPair) error {
 	kp.IsCA = true
 
-	caKey, caKeyPEM, err := GenCAPrivateKey(fromCAKeyPair.KeyFile)
+	caKey, caKeyPEM, err := GenCAPrivateKey(fromCAKeyPair.KeyFile, kp.Opts.keyAlgorithm())
 	if err != nil {
 		return err
 	}
@@ -237,7 +241,7 @@ func (kp *KeyPair) GenCAKeyPair(fromCAKeyPair *KeyPair) error {
 	kp.PrivateKey = caKey
 	kp.PrivateKeyPEM = caKeyPEM
 
-	caCert, caCertPEM, err := SelfSignedCACert(fromCAKeyPair.CertFile, caKey, kp.CN)
+	caCert, caCertPEM, err := SelfSignedCACert(fromCAKeyPair.CertFile, caKey, kp.CN, kp.Opts)
 	if err != nil {
 		return err
 	}
@@ -292,13 +296,16 @@ func (kp *KeyPair) SaveCertificate(overwrite bool) error {
 	return nil
 }
 
-// GenCAPrivateKey generates a CA RSA Key or returns the CA Key from the given filename.
-// The file (if provided) should contain a PEM encoded CA RSA Key
-func GenCAPrivateKey(filename string) (caKey *rsa.PrivateKey, caKeyBytes []byte, err error) {
+// GenCAPrivateKey generates a CA Key with the given algorithm or returns the CA
+// Key from the given filename. The file (if provided) should contain a PEM
+// encoded CA RSA, EC or PKCS #8 Key
+func GenCAPrivateKey(filename, algorithm string) (caKey crypto.Signer, caKeyBytes []byte, err error) {
 	if filename == "" {
-		caKey, err = GenRSAPrivateKey()
-		caKeyBytes := pemEncodePrivateKey(caKey)
-		return caKey, caKeyBytes, err
+		caKey, err = GenPrivateKey(algorithm)
+		if err != nil {
+			return nil, []byte{}, err
+		}
+		return caKey, pemEncodePrivateKey(caKey), nil
 	}
 
 	caKeyBytes, err = ioutil.ReadFile(filename)
@@ -321,7 +328,7 @@ func GenCAPrivateKey(filename string) (caKey *rsa.PrivateKey, caKeyBytes []byte,
 		der = pemBlock.Bytes
 	}
 
-	caKey, err = x509.ParsePKCS1PrivateKey(der)
+	caKey, err = ParsePrivateKey(pemBlock.Type, der)
 	return caKey, caKeyBytes, err
 }
 
@@ -334,14 +341,16 @@ func GenRSAPrivateKey() (key *rsa.PrivateKey, err error) {
 	return key, err
 }
 
-// SelfSignedCACert generates a CA x509 Certificate from a CA RSA Key or returns the CA
+// SelfSignedCACert generates a CA x509 Certificate from a CA Key or returns the CA
 // x509 Certificate from the given filename. The file (if provided) should
 // contain a PEM encoded CA x509 Certificate
-func SelfSignedCACert(filename string, caKey *rsa.PrivateKey, cn string) (*x509.Certificate, []byte, error) {
+func SelfSignedCACert(filename string, caKey crypto.Signer, cn string, opts *KeyOptions) (*x509.Certificate, []byte, error) {
 	if filename == "" {
-		caCert, err := NewSelfSignedCACert(caKey, cn)
-		caCertBytes := pemEncodeCert(caCert)
-		return caCert, caCertBytes, err
+		caCert, err := NewSelfSignedCACert(caKey, cn, opts)
+		if err != nil {
+			return nil, []byte{}, err
+		}
+		return caCert, pemEncodeCert(caCert), nil
 	}
 
 	caCertBytes, err := ioutil.ReadFile(filename)
@@ -358,7 +367,12 @@ func SelfSignedCACert(filename string, caKey *rsa.PrivateKey, cn string) (*x509.
 
 // NewSelfSignedCACert creates a Self Signed CA Certificate with a given
 // CA Private Key and a Common Name
-func NewSelfSignedCACert(caKey *rsa.PrivateKey, cn string) (*x509.Certificate, error) {
+func NewSelfSignedCACert(caKey crypto.Signer, cn string, opts *KeyOptions) (*x509.Certificate, error) {
+	sigAlg, err := opts.signatureAlgorithm(caKey)
+	if err != nil {
+		return nil, err
+	}
+
 	serialNumberLimit := new(big.Int).Lsh(big.NewInt(1), 128)
 	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
 	if err != nil {
@@ -376,11 +390,12 @@ func NewSelfSignedCACert(caKey *rsa.PrivateKey, cn string) (*x509.Certificate, e
 			// Country:            []string{Country},
 		},
 		NotBefore: time.Now().UTC(),
-		NotAfter:  time.Now().Add(Duration * 24 * time.Hour).UTC(),
+		NotAfter:  time.Now().Add(opts.validity()).UTC(),
 
-		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		KeyUsage:              keyUsage(caKey) | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
 		BasicConstraintsValid: true,
 		IsCA:                  true,
+		SignatureAlgorithm:    sigAlg,
 	}
 
 	derBytes, err := x509.CreateCertificate(rand.Reader, &template, &template, caKey.Public(), caKey)
@@ -392,8 +407,8 @@ func NewSelfSignedCACert(caKey *rsa.PrivateKey, cn string) (*x509.Certificate, e
 
 // SignedCert generates a Self Signed Certificate and returns also the pem
 // decoded bytes
-func SignedCert(privKey *rsa.PrivateKey, caKeyPair *KeyPair, cn, o string, dns, ips []string, extKeyUsage []x509.ExtKeyUsage) (*x509.Certificate, []byte, error) {
-	cert, err := NewSignedCert(privKey, caKeyPair, cn, o, dns, ips, extKeyUsage)
+func SignedCert(privKey crypto.Signer, caKeyPair *KeyPair, cn, o string, dns, ips []string, extKeyUsage []x509.ExtKeyUsage, opts *KeyOptions) (*x509.Certificate, []byte, error) {
+	cert, err := NewSignedCert(privKey, caKeyPair, cn, o, dns, ips, extKeyUsage, opts)
 	if err != nil {
 		return nil, []byte{}, err
 	}
@@ -404,8 +419,34 @@ func SignedCert(privKey *rsa.PrivateKey, caKeyPair *KeyPair, cn, o string, dns,
 }
 
 // NewSignedCert creates a Self Signed Certificate with a given private key,
-// the CA key pair (key and cert) and a Common Name
-func NewSignedCert(privKey *rsa.PrivateKey, caKeyPair *KeyPair, cn, o string, dns, ips []string, extKeyUsage []x509.ExtKeyUsage) (*x509.Certificate, error) {
+// the CA key pair (key and cert) and a Common Name. The certificate does not
+// expire after the CA certificate
+func NewSignedCert(privKey crypto.Signer, caKeyPair *KeyPair, cn, o string, dns, ips []string, extKeyUsage []x509.ExtKeyUsage, opts *KeyOptions) (*x509.Certificate, error) {
+	var organizations []string
+	if len(o) != 0 {
+		organizations = []string{o}
+	}
+	subject := pkix.Name{
+		CommonName:   cn,
+		Organization: organizations, //   []string{Organization},
+		// OrganizationalUnit: []string{OrganizationalUnit},
+		// Locality:           []string{Locality},
+		// Province:           []string{Province},
+		// Country:            []string{Country},
+	}
+
+	return signCert(privKey, caKeyPair, subject, dns, ips, extKeyUsage, opts.validity(), opts)
+}
+
+// signCert creates a certificate for the given private key and subject signed
+// by the CA key pair, valid for the given duration but not after the CA
+// certificate
+func signCert(privKey crypto.Signer, caKeyPair *KeyPair, subject pkix.Name, dns, ips []string, extKeyUsage []x509.ExtKeyUsage, validity time.Duration, opts *KeyOptions) (*x509.Certificate, error) {
+	sigAlg, err := opts.signatureAlgorithm(caKeyPair.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
 	serialNumberLimit := new(big.Int).Lsh(big.NewInt(1), 128)
 	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
 	if err != nil {
@@ -419,29 +460,23 @@ func NewSignedCert(privKey *rsa.PrivateKey, caKeyPair *KeyPair, cn, o string, dn
 		ipAddresses = append(ipAddresses, net.ParseIP(ip))
 	}
 
-	var organizations []string
-	if len(o) != 0 {
-		organizations = []string{o}
+	notAfter := time.Now().Add(validity).UTC()
+	if notAfter.After(caKeyPair.Certificate.NotAfter) {
+		notAfter = caKeyPair.Certificate.NotAfter
 	}
 
 	template := x509.Certificate{
-		SerialNumber: serialNumber,
-		Subject: pkix.Name{
-			CommonName:   cn,
-			Organization: organizations, //   []string{Organization},
-			// OrganizationalUnit: []string{OrganizationalUnit},
-			// Locality:           []string{Locality},
-			// Province:           []string{Province},
-			// Country:            []string{Country},
-		},
+		SerialNumber:          serialNumber,
+		Subject:               subject,
 		NotBefore:             caKeyPair.Certificate.NotBefore,
-		NotAfter:              time.Now().Add(Duration * 24 * time.Hour).UTC(),
+		NotAfter:              notAfter,
 		DNSNames:              dns,
 		IPAddresses:           ipAddresses,
-		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageContentCommitment,
+		KeyUsage:              keyUsage(privKey) | x509.KeyUsageDigitalSignature | x509.KeyUsageContentCommitment,
 		ExtKeyUsage:           extKeyUsage,
 		BasicConstraintsValid: true,
 		IsCA:                  false,
+		SignatureAlgorithm:    sigAlg,
 	}
 
 	derBytes, err := x509.CreateCertificate(rand.Reader, &template, caKeyPair.Certificate, privKey.Public(), caKeyPair.PrivateKey)
@@ -471,14 +506,6 @@ func (kp *KeyPair) PEMEncodePrivateKey() []byte {
 	return kp.PrivateKeyPEM
 }
 
-func pemEncodePrivateKey(key *rsa.PrivateKey) []byte {
-	block := pem.Block{
-		Type:  "RSA PRIVATE KEY",
-		Bytes: x509.MarshalPKCS1PrivateKey(key),
-	}
-	return pem.EncodeToMemory(&block)
-}
-
 // Save saves all the private keys and certificates (if not empty) for all the
 // Key Pairs
 func (kps KeyPairs) Save(overwrite bool) error {
@@ -0,0 +1,319 @@
+package kluster
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/kraken/ui"
+	"github.com/liferaft/kubekit/pkg/crypto"
+	"github.com/liferaft/kubekit/version"
+)
+
+// ArchiveMetadataFilename is the name of the file, in a cluster archive, with
+// the archive metadata. It's always the first file in the archive
+const ArchiveMetadataFilename = "kubekit-archive.json"
+
+// ArchiveMetadata is the metadata of a cluster archive
+type ArchiveMetadata struct {
+	Version        string    `json:"version" yaml:"version" mapstructure:"version"`
+	Name           string    `json:"name" yaml:"name" mapstructure:"name"`
+	Platform       string    `json:"platform" yaml:"platform" mapstructure:"platform"`
+	KubeKitVersion string    `json:"kubekit_version" yaml:"kubekit_version" mapstructure:"kubekit_version"`
+	CreatedAt      time.Time `json:"created_at" yaml:"created_at" mapstructure:"created_at"`
+	EncryptedFiles []string  `json:"encrypted_files,omitempty" yaml:"encrypted_files,omitempty" mapstructure:"encrypted_files"`
+}
+
+// Export creates a gzip compressed tar archive with everything in the cluster
+// directory: the cluster configuration, state, certificates, credentials and
+// registries. The credentials, state, private keys and kubeconfig files are
+// encrypted in the archive with the given envelope, it's required and cannot
+// use the default passphrase. The etcd snapshots contain every Kubernetes
+// secret, so they are only exported, encrypted, if includeBackups is true
+func (k *Kluster) Export(filename string, includeBackups bool, envelope *crypto.Envelope) error {
+	if envelope == nil {
+		return fmt.Errorf("a key is required to encrypt the secrets in the cluster archive")
+	}
+	dir := k.Dir()
+
+	metadata := ArchiveMetadata{
+		Version:        k.Version,
+		Name:           k.Name,
+		Platform:       k.Platform(),
+		KubeKitVersion: version.Version,
+		CreatedAt:      time.Now().UTC(),
+		EncryptedFiles: []string{},
+	}
+
+	f, err := os.OpenFile(filename, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to create the archive %s. %s", filename, err)
+	}
+	defer f.Close()
+
+	// The metadata is the first file of the archive but the encrypted files are
+	// known after walking the cluster directory, so the files are listed first
+	type archiveFile struct {
+		name string
+		path string
+		info os.FileInfo
+	}
+	files := []archiveFile{}
+
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if name == "." || strings.HasSuffix(name, ".lock") {
+			return nil
+		}
+		name = filepath.ToSlash(name)
+
+		if name == BackupsDirname && !includeBackups {
+			k.ui.Log.Warnf("the etcd snapshots in %s are not exported, they contain every Kubernetes secret", BackupsDirname)
+			return filepath.SkipDir
+		}
+		if !info.IsDir() && !info.Mode().IsRegular() {
+			return nil
+		}
+		if !info.IsDir() && (isArchiveSecretFile(name) || isAtRestFile(name)) {
+			metadata.EncryptedFiles = append(metadata.EncryptedFiles, name)
+		}
+		files = append(files, archiveFile{name: name, path: path, info: info})
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to archive the cluster %q. %s", k.Name, err)
+	}
+
+	gw := gzip.NewWriter(f)
+	tw := tar.NewWriter(gw)
+
+	metadataData, err := json.MarshalIndent(metadata, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeTarFile(tw, ArchiveMetadataFilename, metadataData, 0644, metadata.CreatedAt); err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if file.info.IsDir() {
+			header, err := tar.FileInfoHeader(file.info, "")
+			if err != nil {
+				return err
+			}
+			header.Name = file.name + "/"
+			if err := tw.WriteHeader(header); err != nil {
+				return err
+			}
+			continue
+		}
+
+		data, err := ioutil.ReadFile(file.path)
+		if err != nil {
+			return err
+		}
+		// the files encrypted at rest are encrypted again with the archive key,
+		// so the archive can be imported by a KubeKit with a different key
+		if isAtRestFile(file.name) {
+			if data, err = decryptAtRest(data); err != nil {
+				return fmt.Errorf("failed to decrypt %s. %s", file.name, err)
+			}
+		}
+		if isIn(file.name, metadata.EncryptedFiles...) {
+			if data, err = envelope.Encrypt(data); err != nil {
+				return fmt.Errorf("failed to encrypt %s. %s", file.name, err)
+			}
+		}
+
+		if err := writeTarFile(tw, file.name, data, file.info.Mode().Perm(), file.info.ModTime()); err != nil {
+			return fmt.Errorf("failed to archive the cluster %q. %s", k.Name, err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+// Import extracts the given cluster archive into a new cluster directory in the
+// given clusters path and loads the imported cluster. The archive version has
+// to be supported by this KubeKit and the cluster name has to be unique. The
+// encrypted files are decrypted with the given envelope, it has to use the key
+// used to export the cluster
+func Import(filename, clustersPath string, envelope *crypto.Envelope, parentUI *ui.UI) (*Kluster, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the archive %s. %s", filename, err)
+	}
+	defer f.Close()
+
+	gr, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("the file %s is not a cluster archive. %s", filename, err)
+	}
+	defer gr.Close()
+	tr := tar.NewReader(gr)
+
+	header, err := tr.Next()
+	if err != nil || header.Name != ArchiveMetadataFilename {
+		return nil, fmt.Errorf("the file %s is not a cluster archive, not found the archive metadata", filename)
+	}
+	var metadata ArchiveMetadata
+	if err := json.NewDecoder(tr).Decode(&metadata); err != nil {
+		return nil, fmt.Errorf("failed to read the archive metadata. %s", err)
+	}
+
+	if err := metadata.validate(); err != nil {
+		return nil, err
+	}
+	if !Unique(metadata.Name, clustersPath) {
+		return nil, fmt.Errorf("cluster name %q already exists", metadata.Name)
+	}
+
+	path, err := NewPath(clustersPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := extractArchive(tr, path, metadata.EncryptedFiles, envelope); err != nil {
+		os.RemoveAll(path)
+		return nil, err
+	}
+
+	var configFile string
+	for _, ext := range []string{"yaml", "json", "toml"} {
+		if _, err := os.Stat(filepath.Join(path, DefaultConfigFilename+"."+ext)); err == nil {
+			configFile = filepath.Join(path, DefaultConfigFilename+"."+ext)
+			break
+		}
+	}
+	if len(configFile) == 0 {
+		os.RemoveAll(path)
+		return nil, fmt.Errorf("not found the cluster configuration file in the archive %s", filename)
+	}
+
+	cluster, err := Load(configFile, parentUI)
+	if err != nil {
+		os.RemoveAll(path)
+		return nil, fmt.Errorf("failed to load the imported cluster. %s", err)
+	}
+
+	return cluster, nil
+}
+
+// validate verifies the archive was created by a supported KubeKit version
+func (m *ArchiveMetadata) validate() error {
+	if len(m.Name) == 0 {
+		return fmt.Errorf("the cluster archive does not have a cluster name")
+	}
+	v, err := version.NewSemVer(m.Version)
+	if err != nil {
+		return fmt.Errorf("the cluster archive has an invalid version %q. %s", m.Version, err)
+	}
+	if v.LT(MinSemVersion) {
+		return fmt.Errorf("the cluster archive version %s is not supported, the minimum version is %s", m.Version, MinVersion)
+	}
+	if v.GT(SemVersion) {
+		return fmt.Errorf("the cluster archive version %s is not supported, the maximum version is %s", m.Version, Version)
+	}
+	return nil
+}
+
+// extractArchive extracts the files from the tar reader into the given
+// directory, decrypting the given encrypted files with the given envelope
+func extractArchive(tr *tar.Reader, dir string, encryptedFiles []string, envelope *crypto.Envelope) error {
+	if len(encryptedFiles) != 0 && envelope == nil {
+		return fmt.Errorf("a key is required to decrypt the secrets in the cluster archive")
+	}
+
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read the archive. %s", err)
+		}
+
+		name := filepath.Clean(filepath.FromSlash(header.Name))
+		if filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+			return fmt.Errorf("invalid file %q in the archive", header.Name)
+		}
+		target := filepath.Join(dir, name)
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			data, err := ioutil.ReadAll(tr)
+			if err != nil {
+				return err
+			}
+			if isIn(filepath.ToSlash(name), encryptedFiles...) {
+				if data, err = envelope.Decrypt(data); err != nil {
+					return fmt.Errorf("failed to decrypt %s. %s", name, err)
+				}
+			}
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			if isAtRestFile(name) {
+				err = writeAtRest(target, data, os.FileMode(header.Mode).Perm())
+			} else {
+				err = ioutil.WriteFile(target, data, os.FileMode(header.Mode).Perm())
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// isArchiveSecretFile returns true if the given file, relative to the cluster
+// directory, has to be encrypted in a cluster archive: the credentials, the
+// private keys, the kubeconfig files and the etcd snapshots
+func isArchiveSecretFile(name string) bool {
+	name = filepath.ToSlash(name)
+	if name == CredentialsFileName || strings.HasPrefix(name, BackupsDirname+"/") {
+		return true
+	}
+	if !strings.HasPrefix(name, CertificatesDirname+"/") && !strings.HasPrefix(name, CSRDirname+"/") {
+		return false
+	}
+	switch path.Base(name) {
+	case privateKeyFileName, ed25519PrivateKeyFileName, "kubeconfig":
+		return true
+	}
+	return strings.HasSuffix(name, ".key")
+}
+
+func writeTarFile(tw *tar.Writer, name string, data []byte, mode os.FileMode, modTime time.Time) error {
+	header := &tar.Header{
+		Name:    name,
+		Mode:    int64(mode),
+		Size:    int64(len(data)),
+		ModTime: modTime,
+	}
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+	_, err := tw.Write(data)
+	return err
+}
@@ -0,0 +1,291 @@
+package kluster
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/liferaft/kubekit/pkg/crypto"
+)
+
+func TestArchiveMetadata_validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata ArchiveMetadata
+		wantErr  bool
+	}{
+		{"current version", ArchiveMetadata{Name: "kkdemo", Version: Version}, false},
+		{"min version", ArchiveMetadata{Name: "kkdemo", Version: MinVersion}, false},
+		{"old version", ArchiveMetadata{Name: "kkdemo", Version: "0.1"}, true},
+		{"future version", ArchiveMetadata{Name: "kkdemo", Version: "99.0"}, true},
+		{"invalid version", ArchiveMetadata{Name: "kkdemo", Version: "one"}, true},
+		{"no name", ArchiveMetadata{Version: Version}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.metadata.validate(); (err != nil) != tt.wantErr {
+				t.Errorf("ArchiveMetadata.validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_extractArchive(t *testing.T) {
+	keyDir, err := ioutil.TempDir("", "kubekit-archive-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(keyDir)
+	envelope := newTestEnvelope(t, keyDir, "archive.key")
+	encCred, err := envelope.Encrypt([]byte("access_key: AKIA"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		files    map[string]string
+		envelope *crypto.Envelope
+		want     map[string]string
+		wantErr  bool
+	}{
+		{
+			"config and credentials",
+			map[string]string{"cluster.yaml": "kind: cluster", CredentialsFileName: string(encCred)},
+			envelope,
+			map[string]string{"cluster.yaml": "kind: cluster", CredentialsFileName: "access_key: AKIA"},
+			false,
+		},
+		{
+			"nested files",
+			map[string]string{"certificates/ca.crt": "CERT"},
+			envelope,
+			map[string]string{"certificates/ca.crt": "CERT"},
+			false,
+		},
+		{"no key", map[string]string{CredentialsFileName: string(encCred)}, nil, nil, true},
+		{"wrong key", map[string]string{CredentialsFileName: string(encCred)}, newTestEnvelope(t, keyDir, "other.key"), nil, true},
+		{"path traversal", map[string]string{"../cluster.yaml": "kind: cluster"}, envelope, nil, true},
+		{"absolute path", map[string]string{"/etc/cluster.yaml": "kind: cluster"}, envelope, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "kubekit-archive")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+
+			var buf bytes.Buffer
+			tw := tar.NewWriter(&buf)
+			for name, content := range tt.files {
+				if err := writeTarFile(tw, name, []byte(content), 0600, time.Now()); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := tw.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			err = extractArchive(tar.NewReader(&buf), dir, []string{CredentialsFileName}, tt.envelope)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("extractArchive() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			for name, want := range tt.want {
+				got, err := ioutil.ReadFile(filepath.Join(dir, name))
+				if err != nil {
+					t.Fatalf("extractArchive() file %s not extracted. %s", name, err)
+				}
+				if string(got) != want {
+					t.Errorf("extractArchive() file %s = %q, want %q", name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func Test_isArchiveSecretFile(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		want bool
+	}{
+		{"credentials", CredentialsFileName, true},
+		{"config", "cluster.yaml", false},
+		{"state", ".tfstate/ec2.tfstate", false},
+		{"CA key", "certificates/ec2/root_ca.key", true},
+		{"CA certificate", "certificates/ec2/root_ca.crt", false},
+		{"node key", "certificates/ec2/10.0.0.5/kubelet.key", true},
+		{"SSH key", "certificates/id_rsa", true},
+		{"SSH public key", "certificates/id_rsa.pub", false},
+		{"kubeconfig", "certificates/kubeconfig", true},
+		{"external CA request key", "csr/admin.key", true},
+		{"etcd snapshot", "backups/etcd-snapshot-20200101T000000Z.db", true},
+		{"registry", "registries/docker.key.txt", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isArchiveSecretFile(tt.file); got != tt.want {
+				t.Errorf("isArchiveSecretFile(%q) = %v, want %v", tt.file, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKluster_Export(t *testing.T) {
+	clusterDir, err := ioutil.TempDir("", "kubekit-export")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(clusterDir)
+
+	files := map[string]string{
+		"cluster.yaml":                    "kind: cluster",
+		CredentialsFileName:               "access_key: AKIA",
+		".tfstate/ec2.tfstate":            "STATE",
+		"certificates/ec2/root_ca.key":    "PRIVATE KEY",
+		"certificates/ec2/root_ca.crt":    "CERTIFICATE",
+		"backups/etcd-snapshot-1.db":      "SNAPSHOT",
+		"backups/etcd-snapshot-1.json":    "{}",
+		"registries/registry.example.com": "REGISTRY",
+	}
+	for name, content := range files {
+		filename := filepath.Join(clusterDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filename), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filename, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	k := &Kluster{
+		Name:      "kkdemo",
+		Version:   Version,
+		Platforms: map[string]interface{}{"ec2": nil},
+		path:      filepath.Join(clusterDir, "cluster.yaml"),
+		ui:        parentUI,
+	}
+
+	tests := []struct {
+		name           string
+		includeBackups bool
+		wantEncrypted  []string
+		wantPlain      []string
+		wantExcluded   []string
+	}{
+		{"default", false, []string{CredentialsFileName, ".tfstate/ec2.tfstate", "certificates/ec2/root_ca.key"}, []string{"cluster.yaml", "certificates/ec2/root_ca.crt"}, []string{"backups/etcd-snapshot-1.db"}},
+		{"include backups", true, []string{CredentialsFileName, ".tfstate/ec2.tfstate", "certificates/ec2/root_ca.key", "backups/etcd-snapshot-1.db", "backups/etcd-snapshot-1.json"}, []string{"cluster.yaml"}, nil},
+	}
+
+	keyDir, err := ioutil.TempDir("", "kubekit-export-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(keyDir)
+	envelope := newTestEnvelope(t, keyDir, "archive.key")
+
+	archive := filepath.Join(clusterDir, "..", "kkdemo-export.tar.gz")
+	if err := k.Export(archive, false, nil); err == nil {
+		os.Remove(archive)
+		t.Errorf("Kluster.Export() expected an error without a key")
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer os.Remove(archive)
+			if err := k.Export(archive, tt.includeBackups, envelope); err != nil {
+				t.Fatalf("Kluster.Export() error = %v", err)
+			}
+
+			f, err := os.Open(archive)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+			gr, err := gzip.NewReader(f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tr := tar.NewReader(gr)
+			header, err := tr.Next()
+			if err != nil || header.Name != ArchiveMetadataFilename {
+				t.Fatalf("Kluster.Export() the first file is not the metadata")
+			}
+			var metadata ArchiveMetadata
+			if err := json.NewDecoder(tr).Decode(&metadata); err != nil {
+				t.Fatal(err)
+			}
+			got := map[string]string{}
+			for {
+				header, err := tr.Next()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				data, err := ioutil.ReadAll(tr)
+				if err != nil {
+					t.Fatal(err)
+				}
+				got[header.Name] = string(data)
+			}
+
+			for _, name := range tt.wantEncrypted {
+				if !isIn(name, metadata.EncryptedFiles...) {
+					t.Errorf("Kluster.Export() %s is not in the encrypted files %v", name, metadata.EncryptedFiles)
+				}
+				content, ok := got[name]
+				if !ok || !crypto.IsEnvelope([]byte(content)) {
+					t.Errorf("Kluster.Export() %s is not encrypted in the archive", name)
+					continue
+				}
+				if plain, err := envelope.Decrypt([]byte(content)); err != nil || string(plain) != files[name] {
+					t.Errorf("Kluster.Export() %s decrypted = %q, %v; want %q", name, plain, err, files[name])
+				}
+			}
+			for _, name := range tt.wantPlain {
+				if got[name] != files[name] {
+					t.Errorf("Kluster.Export() %s = %q, want %q", name, got[name], files[name])
+				}
+			}
+			for _, name := range tt.wantExcluded {
+				if _, ok := got[name]; ok {
+					t.Errorf("Kluster.Export() %s is in the archive", name)
+				}
+			}
+		})
+	}
+}
+
+func Test_isAtRestFile(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		want bool
+	}{
+		{"credentials", CredentialsFileName, true},
+		{"state", ".tfstate/ec2.tfstate", true},
+		{"state backup", ".tfstate/ec2.tfstate.bkp", true},
+		{"etcd snapshot", "backups/etcd-snapshot-20200101T000000Z.db", true},
+		{"etcd snapshot metadata", "backups/etcd-snapshot-20200101T000000Z.json", false},
+		{"migrated config backup", "backups/cluster-v1.1.yaml", true},
+		{"config", "cluster.yaml", false},
+		{"CA key", "certificates/ec2/root_ca.key", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isAtRestFile(tt.file); got != tt.want {
+				t.Errorf("isAtRestFile(%q) = %v, want %v", tt.file, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package kluster
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"os/user"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/liferaft/kubekit/version"
+)
+
+// State backend types
+const (
+	LocalBackend = "local"
+	S3Backend    = "s3"
+	HTTPBackend  = "http"
+)
+
+// DefaultStateMaxHistory is the default number of state versions to keep in
+// the state history
+const DefaultStateMaxHistory = 10
+
+// stateVersionFormat is the format of the state version ID, it's the time when
+// the state was saved so the versions can be sorted by ID
+const stateVersionFormat = "20060102T150405.000000000Z"
+
+// BackendConfig is the configuration of the backend where the Terraform state
+// of the cluster is stored. If there is no backend or the type is 'local', the
+// state is stored in the cluster directory
+type BackendConfig struct {
+	Type       string `json:"type" yaml:"type" mapstructure:"type"`
+	MaxHistory int    `json:"max_history,omitempty" yaml:"max_history,omitempty" mapstructure:"max_history"`
+
+	// S3 or S3 compatible object storage. The credentials are taken from the
+	// environment, the shared credentials file or the given AWS profile
+	Endpoint string `json:"endpoint,omitempty" yaml:"endpoint,omitempty" mapstructure:"endpoint"`
+	Bucket   string `json:"bucket,omitempty" yaml:"bucket,omitempty" mapstructure:"bucket"`
+	Prefix   string `json:"prefix,omitempty" yaml:"prefix,omitempty" mapstructure:"prefix"`
+	Region   string `json:"region,omitempty" yaml:"region,omitempty" mapstructure:"region"`
+	Profile  string `json:"profile,omitempty" yaml:"profile,omitempty" mapstructure:"profile"`
+
+	// HTTP, compatible with the Terraform HTTP backend. The credentials are
+	// taken from the environment variables KUBEKIT_BACKEND_USERNAME and
+	// KUBEKIT_BACKEND_PASSWORD
+	Address       string `json:"address,omitempty" yaml:"address,omitempty" mapstructure:"address"`
+	LockAddress   string `json:"lock_address,omitempty" yaml:"lock_address,omitempty" mapstructure:"lock_address"`
+	UnlockAddress string `json:"unlock_address,omitempty" yaml:"unlock_address,omitempty" mapstructure:"unlock_address"`
+	SkipTLSVerify bool   `json:"skip_tls_verify,omitempty" yaml:"skip_tls_verify,omitempty" mapstructure:"skip_tls_verify"`
+}
+
+// StateBackend is where the Terraform state of every platform of a cluster is
+// stored. Every saved state is kept in the state history, the oldest versions
+// are removed when there are more than the maximum history size. The state
+// has to be locked before any change to the infrastructure, so two KubeKit
+// processes, in the same or different hosts, cannot modify the same cluster at
+// the same time
+type StateBackend interface {
+	// Read returns the current state of the given platform or nil if there is
+	// no state
+	Read(platform string) ([]byte, error)
+	// Write saves the given state as the current state and adds it to the history
+	Write(platform string, data []byte) error
+	// Lock locks the state of the given platform for the given operation. If the
+	// state is already locked, returns a LockError with the current lock
+	Lock(platform, operation string) (*LockInfo, error)
+	// Unlock releases the given lock of the state of the given platform
+	Unlock(platform string, lock *LockInfo) error
+	// History returns the versions of the state of the given platform, the
+	// newest version first
+	History(platform string) ([]StateVersion, error)
+	// ReadVersion returns the given version of the state of the given platform
+	ReadVersion(platform, id string) ([]byte, error)
+}
+
+// StateVersion is a version of the state in the state history
+type StateVersion struct {
+	ID      string    `json:"id" yaml:"id"`
+	Created time.Time `json:"created" yaml:"created"`
+	Size    int64     `json:"size" yaml:"size"`
+}
+
+// LockInfo is the information of a state lock. It has the same format as the
+// Terraform lock info, to be compatible with the Terraform HTTP backend servers
+type LockInfo struct {
+	ID        string    `json:"ID"`
+	Operation string    `json:"Operation"`
+	Info      string    `json:"Info"`
+	Who       string    `json:"Who"`
+	Version   string    `json:"Version"`
+	Created   time.Time `json:"Created"`
+	Path      string    `json:"Path"`
+}
+
+// LockError is returned when the state is already locked
+type LockError struct {
+	Lock *LockInfo
+	Err  error
+}
+
+func (e *LockError) Error() string {
+	if e.Lock == nil {
+		return fmt.Sprintf("the state is locked. %s", e.Err)
+	}
+	msg := fmt.Sprintf("the state is locked by %s since %s to %s (lock ID: %s)", e.Lock.Who, e.Lock.Created.Format(time.RFC3339), e.Lock.Operation, e.Lock.ID)
+	if e.Err != nil {
+		msg = fmt.Sprintf("%s. %s", msg, e.Err)
+	}
+	return msg
+}
+
+// NewStateBackend creates the state backend of the given configuration for the
+// given cluster. The local backend stores the state in the given state directory
+func NewStateBackend(config *BackendConfig, cluster, stateDir string) (StateBackend, error) {
+	if config == nil {
+		config = &BackendConfig{}
+	}
+	maxHistory := config.MaxHistory
+	if maxHistory <= 0 {
+		maxHistory = DefaultStateMaxHistory
+	}
+
+	switch strings.ToLower(config.Type) {
+	case "", LocalBackend:
+		return newLocalBackend(stateDir, maxHistory), nil
+	case S3Backend:
+		return newS3Backend(config, cluster, maxHistory, nil)
+	case HTTPBackend:
+		return newHTTPBackend(config, cluster)
+	default:
+		return nil, fmt.Errorf("unknown state backend type %q. The supported types are: %s, %s and %s", config.Type, LocalBackend, S3Backend, HTTPBackend)
+	}
+}
+
+// newLockInfo returns a lock info with a random ID for the given operation
+func newLockInfo(operation, path string) (*LockInfo, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, fmt.Errorf("failed to generate the lock ID. %s", err)
+	}
+
+	who := "unknown"
+	if u, err := user.Current(); err == nil {
+		who = u.Username
+	}
+	if host, err := os.Hostname(); err == nil {
+		who = who + "@" + host
+	}
+
+	return &LockInfo{
+		ID:        hex.EncodeToString(id),
+		Operation: operation,
+		Who:       who,
+		Version:   version.Version,
+		Created:   time.Now().UTC(),
+		Path:      path,
+	}, nil
+}
+
+// newStateVersionID returns the ID of a new version of the state
+func newStateVersionID() string {
+	return time.Now().UTC().Format(stateVersionFormat)
+}
+
+// sortStateVersions sorts the given versions, the newest version first
+func sortStateVersions(versions []StateVersion) {
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].ID > versions[j].ID
+	})
+}
+
+// parseStateVersion returns the state version of the given history entry name
+// with the format `<platform>.<version ID>.tfstate`
+func parseStateVersion(platform, name string, size int64) (StateVersion, bool) {
+	prefix, suffix := platform+".", ".tfstate"
+	if !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, suffix) {
+		return StateVersion{}, false
+	}
+	id := strings.TrimSuffix(strings.TrimPrefix(name, prefix), suffix)
+	created, err := time.Parse(stateVersionFormat, id)
+	if err != nil {
+		return StateVersion{}, false
+	}
+	return StateVersion{ID: id, Created: created, Size: size}, true
+}
+
+func stateVersionName(platform, id string) string {
+	return platform + "." + id + ".tfstate"
+}
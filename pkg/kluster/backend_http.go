@@ -0,0 +1,201 @@
+package kluster
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// httpBackend stores the state in a HTTP server compatible with the Terraform
+// HTTP backend. The state of every platform is at `<address>/<platform>`, it's
+// read with GET and saved with POST. The state is locked with the methods LOCK
+// and UNLOCK sending the lock info. The history is kept by the HTTP server, if
+// supported, so it's not available through this backend
+type httpBackend struct {
+	client        *http.Client
+	address       string
+	lockAddress   string
+	unlockAddress string
+	username      string
+	password      string
+	locks         map[string]string
+	mu            sync.Mutex
+}
+
+func newHTTPBackend(config *BackendConfig, cluster string) (*httpBackend, error) {
+	if len(config.Address) == 0 {
+		return nil, fmt.Errorf("the address is required for the %s state backend", HTTPBackend)
+	}
+	if _, err := url.Parse(config.Address); err != nil {
+		return nil, fmt.Errorf("the address %q of the %s state backend is not valid. %s", config.Address, HTTPBackend, err)
+	}
+
+	lockAddress := config.LockAddress
+	if len(lockAddress) == 0 {
+		lockAddress = config.Address
+	}
+	unlockAddress := config.UnlockAddress
+	if len(unlockAddress) == 0 {
+		unlockAddress = lockAddress
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if config.SkipTLSVerify {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+
+	return &httpBackend{
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   60 * time.Second,
+		},
+		address:       strings.TrimSuffix(config.Address, "/"),
+		lockAddress:   strings.TrimSuffix(lockAddress, "/"),
+		unlockAddress: strings.TrimSuffix(unlockAddress, "/"),
+		username:      os.Getenv("KUBEKIT_BACKEND_USERNAME"),
+		password:      os.Getenv("KUBEKIT_BACKEND_PASSWORD"),
+		locks:         map[string]string{},
+	}, nil
+}
+
+func (b *httpBackend) do(method, address string, body []byte) (*http.Response, []byte, error) {
+	req, err := http.NewRequest(method, address, bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+		sum := md5.Sum(body)
+		req.Header.Set("Content-MD5", base64.StdEncoding.EncodeToString(sum[:]))
+	}
+	if len(b.username) != 0 {
+		req.SetBasicAuth(b.username, b.password)
+	}
+
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+
+	return resp, data, err
+}
+
+// Read returns the state from the server
+func (b *httpBackend) Read(platform string) ([]byte, error) {
+	address := b.address + "/" + platform
+	resp, data, err := b.do(http.MethodGet, address, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the %s state from %s. %s", platform, address, err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		if len(data) == 0 {
+			return nil, nil
+		}
+		return data, nil
+	case http.StatusNoContent, http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("failed to read the %s state from %s. HTTP status: %s", platform, address, resp.Status)
+	}
+}
+
+// Write sends the state to the server, with the lock ID if it's locked
+func (b *httpBackend) Write(platform string, data []byte) error {
+	address := b.address + "/" + platform
+	b.mu.Lock()
+	if id, ok := b.locks[platform]; ok {
+		address = address + "?ID=" + url.QueryEscape(id)
+	}
+	b.mu.Unlock()
+
+	resp, _, err := b.do(http.MethodPost, address, data)
+	if err != nil {
+		return fmt.Errorf("failed to write the %s state to %s. %s", platform, address, err)
+	}
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated, http.StatusNoContent:
+		return nil
+	default:
+		return fmt.Errorf("failed to write the %s state to %s. HTTP status: %s", platform, address, resp.Status)
+	}
+}
+
+// Lock sends the lock info with the LOCK method
+func (b *httpBackend) Lock(platform, operation string) (*LockInfo, error) {
+	address := b.lockAddress + "/" + platform
+	info, err := newLockInfo(operation, b.address+"/"+platform)
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(info)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, data, err := b.do("LOCK", address, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock the %s state at %s. %s", platform, address, err)
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+		b.mu.Lock()
+		b.locks[platform] = info.ID
+		b.mu.Unlock()
+		return info, nil
+	case http.StatusLocked, http.StatusConflict:
+		current := &LockInfo{}
+		if err := json.Unmarshal(data, current); err != nil {
+			return nil, &LockError{Err: fmt.Errorf("the lock info returned by %s cannot be decoded. %s", address, err)}
+		}
+		return nil, &LockError{Lock: current}
+	default:
+		return nil, fmt.Errorf("failed to lock the %s state at %s. HTTP status: %s", platform, address, resp.Status)
+	}
+}
+
+// Unlock sends the lock info with the UNLOCK method
+func (b *httpBackend) Unlock(platform string, info *LockInfo) error {
+	address := b.unlockAddress + "/" + platform
+	body, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+
+	resp, _, err := b.do("UNLOCK", address, body)
+	if err != nil {
+		return fmt.Errorf("failed to unlock the %s state at %s. %s", platform, address, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to unlock the %s state at %s. HTTP status: %s", platform, address, resp.Status)
+	}
+
+	b.mu.Lock()
+	delete(b.locks, platform)
+	b.mu.Unlock()
+
+	return nil
+}
+
+// History is not supported, the history is kept by the HTTP server
+func (b *httpBackend) History(platform string) ([]StateVersion, error) {
+	return nil, fmt.Errorf("the %s state backend does not provide the state history, it's kept by the HTTP server if supported", HTTPBackend)
+}
+
+// ReadVersion is not supported, the history is kept by the HTTP server
+func (b *httpBackend) ReadVersion(platform, id string) ([]byte, error) {
+	return nil, fmt.Errorf("the %s state backend does not provide the state history, it's kept by the HTTP server if supported", HTTPBackend)
+}
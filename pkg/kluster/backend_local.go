@@ -0,0 +1,168 @@
+package kluster
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/nightlyone/lockfile"
+)
+
+// StateHistoryDirname is the directory in the state directory with the state
+// history of the local backend
+const StateHistoryDirname = "history"
+
+// localBackend stores the state in the cluster state directory, the history in
+// the history subdirectory. The state is locked with a lock file
+type localBackend struct {
+	dir        string
+	maxHistory int
+	locks      map[string]lockfile.Lockfile
+	mu         sync.Mutex
+}
+
+func newLocalBackend(dir string, maxHistory int) *localBackend {
+	return &localBackend{
+		dir:        dir,
+		maxHistory: maxHistory,
+		locks:      map[string]lockfile.Lockfile{},
+	}
+}
+
+func (b *localBackend) filename(platform string) string {
+	return filepath.Join(b.dir, platform+".tfstate")
+}
+
+func (b *localBackend) historyDir() string {
+	return filepath.Join(b.dir, StateHistoryDirname)
+}
+
+// Read returns the state in the state file
+func (b *localBackend) Read(platform string) ([]byte, error) {
+	data, err := ioutil.ReadFile(b.filename(platform))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return data, err
+}
+
+// Write saves the state to the state file and to the history, unless it's the
+// same state of the latest version in the history
+func (b *localBackend) Write(platform string, data []byte) error {
+	filename := b.filename(platform)
+	if err := os.MkdirAll(b.historyDir(), 0755); err != nil {
+		return err
+	}
+
+	lock, err := lockFile(filename)
+	defer lock.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if err := ioutil.WriteFile(filename, data, 0644); err != nil {
+		return err
+	}
+
+	versions, err := b.History(platform)
+	if err != nil {
+		return err
+	}
+	if len(versions) != 0 {
+		latest, err := b.ReadVersion(platform, versions[0].ID)
+		if err == nil && sameState(latest, data) {
+			return nil
+		}
+	}
+	historyFilename := filepath.Join(b.historyDir(), stateVersionName(platform, newStateVersionID()))
+	if err := ioutil.WriteFile(historyFilename, data, 0644); err != nil {
+		return err
+	}
+
+	return b.prune(platform)
+}
+
+// prune removes the oldest versions of the state history
+func (b *localBackend) prune(platform string) error {
+	versions, err := b.History(platform)
+	if err != nil {
+		return err
+	}
+	for i := b.maxHistory; i < len(versions); i++ {
+		if err := os.Remove(filepath.Join(b.historyDir(), stateVersionName(platform, versions[i].ID))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Lock locks the state with a lock file, it fails if the lock file is owned by
+// another running process
+func (b *localBackend) Lock(platform, operation string) (*LockInfo, error) {
+	if err := os.MkdirAll(b.dir, 0755); err != nil {
+		return nil, err
+	}
+	// the state file lock is used to write the state, do not use the same lock
+	lock, err := lockFile(filepath.Join(b.dir, platform))
+	if err != nil {
+		return nil, &LockError{Err: err}
+	}
+
+	info, err := newLockInfo(operation, b.filename(platform))
+	if err != nil {
+		lock.Unlock()
+		return nil, err
+	}
+
+	b.mu.Lock()
+	b.locks[info.ID] = lock
+	b.mu.Unlock()
+
+	return info, nil
+}
+
+// Unlock removes the lock file
+func (b *localBackend) Unlock(platform string, info *LockInfo) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	lock, ok := b.locks[info.ID]
+	if !ok {
+		return fmt.Errorf("the lock %s of the %s state was not found", info.ID, platform)
+	}
+	delete(b.locks, info.ID)
+
+	return lock.Unlock()
+}
+
+// History returns the versions in the history directory
+func (b *localBackend) History(platform string) ([]StateVersion, error) {
+	files, err := ioutil.ReadDir(b.historyDir())
+	if os.IsNotExist(err) {
+		return []StateVersion{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	versions := []StateVersion{}
+	for _, f := range files {
+		if v, ok := parseStateVersion(platform, f.Name(), f.Size()); ok {
+			versions = append(versions, v)
+		}
+	}
+	sortStateVersions(versions)
+
+	return versions, nil
+}
+
+// ReadVersion returns the state in the history directory with the given version
+func (b *localBackend) ReadVersion(platform, id string) ([]byte, error) {
+	data, err := ioutil.ReadFile(filepath.Join(b.historyDir(), stateVersionName(platform, filepath.Base(id))))
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("version %s of the %s state not found", id, platform)
+	}
+	return data, err
+}
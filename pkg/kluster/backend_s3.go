@@ -0,0 +1,303 @@
+package kluster
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awscredentials "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+)
+
+// DefaultS3BackendPrefix is the default prefix of the state objects in the bucket
+const DefaultS3BackendPrefix = "kubekit"
+
+// s3Backend stores the state in an S3 or S3 compatible (i.e. MinIO) bucket. The
+// state of every platform is the object `<prefix>/<cluster>/<platform>.tfstate`
+// and the history is in `<prefix>/<cluster>/history/`. The lock is the object
+// `<prefix>/<cluster>/<platform>.tfstate.lock`, created with a conditional
+// write that fails if the object exists. The S3 compatible storages without
+// conditional writes ignore the condition, so the lock is verified after it's
+// created
+type s3Backend struct {
+	client     s3iface.S3API
+	bucket     string
+	prefix     string
+	maxHistory int
+}
+
+// newS3Backend creates the S3 backend. If the given credentials are nil, they
+// are taken from the environment, the shared credentials file or the AWS profile
+func newS3Backend(config *BackendConfig, cluster string, maxHistory int, creds *awscredentials.Credentials) (*s3Backend, error) {
+	if len(config.Bucket) == 0 {
+		return nil, fmt.Errorf("the bucket is required for the %s state backend", S3Backend)
+	}
+
+	region := config.Region
+	if len(region) == 0 {
+		region = "us-east-1"
+	}
+	awsConfig := aws.NewConfig().WithRegion(region)
+	if len(config.Endpoint) != 0 {
+		// the S3 compatible object storages do not support virtual hosted buckets
+		awsConfig = awsConfig.WithEndpoint(config.Endpoint).WithS3ForcePathStyle(true)
+	}
+	if creds != nil {
+		awsConfig = awsConfig.WithCredentials(creds)
+	}
+
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config:            *awsConfig,
+		Profile:           config.Profile,
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the session for the %s state backend. %s", S3Backend, err)
+	}
+
+	prefix := config.Prefix
+	if len(prefix) == 0 {
+		prefix = DefaultS3BackendPrefix
+	}
+
+	return &s3Backend{
+		client:     s3.New(sess),
+		bucket:     config.Bucket,
+		prefix:     path.Join(prefix, cluster),
+		maxHistory: maxHistory,
+	}, nil
+}
+
+func (b *s3Backend) key(platform string) string {
+	return path.Join(b.prefix, platform+".tfstate")
+}
+
+func (b *s3Backend) lockKey(platform string) string {
+	return b.key(platform) + ".lock"
+}
+
+func (b *s3Backend) historyKey(platform, id string) string {
+	return path.Join(b.prefix, StateHistoryDirname, stateVersionName(platform, id))
+}
+
+func (b *s3Backend) get(key string) ([]byte, error) {
+	out, err := b.client.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(key),
+	})
+	if isS3NotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer out.Body.Close()
+
+	return ioutil.ReadAll(out.Body)
+}
+
+func (b *s3Backend) put(key string, data []byte) error {
+	_, err := b.client.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(key),
+		Body:   bytes.NewReader(data),
+	})
+	return err
+}
+
+// putIfNotExists creates the object only if it does not exists. Returns false
+// if the object exists
+func (b *s3Backend) putIfNotExists(key string, data []byte) (bool, error) {
+	req, _ := b.client.PutObjectRequest(&s3.PutObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(key),
+		Body:   bytes.NewReader(data),
+	})
+	req.HTTPRequest.Header.Set("If-None-Match", "*")
+	err := req.Send()
+	if isS3PreconditionFailed(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (b *s3Backend) delete(key string) error {
+	_, err := b.client.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(key),
+	})
+	return err
+}
+
+// Read returns the state object
+func (b *s3Backend) Read(platform string) ([]byte, error) {
+	data, err := b.get(b.key(platform))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the %s state from s3://%s/%s. %s", platform, b.bucket, b.key(platform), err)
+	}
+	return data, nil
+}
+
+// Write saves the state object and the history object, unless it's the same
+// state of the current state object
+func (b *s3Backend) Write(platform string, data []byte) error {
+	current, err := b.Read(platform)
+	if err != nil {
+		return err
+	}
+	if current != nil && sameState(current, data) {
+		return nil
+	}
+
+	if err := b.put(b.key(platform), data); err != nil {
+		return fmt.Errorf("failed to write the %s state to s3://%s/%s. %s", platform, b.bucket, b.key(platform), err)
+	}
+	if err := b.put(b.historyKey(platform, newStateVersionID()), data); err != nil {
+		return fmt.Errorf("failed to write the %s state to the history. %s", platform, err)
+	}
+
+	versions, err := b.History(platform)
+	if err != nil {
+		return err
+	}
+	for i := b.maxHistory; i < len(versions); i++ {
+		if err := b.delete(b.historyKey(platform, versions[i].ID)); err != nil {
+			return fmt.Errorf("failed to remove version %s of the %s state from the history. %s", versions[i].ID, platform, err)
+		}
+	}
+
+	return nil
+}
+
+// Lock creates the lock object if it does not exists
+func (b *s3Backend) Lock(platform, operation string) (*LockInfo, error) {
+	key := b.lockKey(platform)
+
+	info, err := newLockInfo(operation, fmt.Sprintf("s3://%s/%s", b.bucket, b.key(platform)))
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(info)
+	if err != nil {
+		return nil, err
+	}
+	created, err := b.putIfNotExists(key, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the lock s3://%s/%s. %s", b.bucket, key, err)
+	}
+	if !created {
+		current, err := b.readLock(key)
+		if err != nil {
+			return nil, err
+		}
+		return nil, &LockError{Lock: current, Err: fmt.Errorf("the lock exists")}
+	}
+
+	// the storages without conditional writes overwrite the lock of another
+	// process, the last one wins
+	current, err := b.readLock(key)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil || current.ID != info.ID {
+		return nil, &LockError{Lock: current, Err: fmt.Errorf("the lock was taken by another process")}
+	}
+
+	return info, nil
+}
+
+// Unlock removes the lock object if it's the given lock
+func (b *s3Backend) Unlock(platform string, info *LockInfo) error {
+	key := b.lockKey(platform)
+
+	current, err := b.readLock(key)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return nil
+	}
+	if current.ID != info.ID {
+		return &LockError{Lock: current, Err: fmt.Errorf("the lock ID %s does not match", info.ID)}
+	}
+
+	return b.delete(key)
+}
+
+func (b *s3Backend) readLock(key string) (*LockInfo, error) {
+	data, err := b.get(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the lock s3://%s/%s. %s", b.bucket, key, err)
+	}
+	if data == nil {
+		return nil, nil
+	}
+	info := &LockInfo{}
+	if err := json.Unmarshal(data, info); err != nil {
+		return nil, fmt.Errorf("failed to decode the lock s3://%s/%s. %s", b.bucket, key, err)
+	}
+	return info, nil
+}
+
+// History returns the versions of the history objects
+func (b *s3Backend) History(platform string) ([]StateVersion, error) {
+	versions := []StateVersion{}
+	dir := path.Join(b.prefix, StateHistoryDirname) + "/"
+
+	err := b.client.ListObjectsV2Pages(&s3.ListObjectsV2Input{
+		Bucket: aws.String(b.bucket),
+		Prefix: aws.String(dir + platform + "."),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, obj := range page.Contents {
+			name := path.Base(aws.StringValue(obj.Key))
+			if v, ok := parseStateVersion(platform, name, aws.Int64Value(obj.Size)); ok {
+				versions = append(versions, v)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the %s state history. %s", platform, err)
+	}
+	sortStateVersions(versions)
+
+	return versions, nil
+}
+
+// ReadVersion returns the history object of the given version
+func (b *s3Backend) ReadVersion(platform, id string) ([]byte, error) {
+	data, err := b.get(b.historyKey(platform, path.Base(id)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read version %s of the %s state. %s", id, platform, err)
+	}
+	if data == nil {
+		return nil, fmt.Errorf("version %s of the %s state not found", id, platform)
+	}
+	return data, nil
+}
+
+// isS3PreconditionFailed returns true if the conditional write failed because
+// the object exists or another conditional write is in progress
+func isS3PreconditionFailed(err error) bool {
+	if reqErr, ok := err.(awserr.RequestFailure); ok {
+		return reqErr.StatusCode() == http.StatusPreconditionFailed || reqErr.StatusCode() == http.StatusConflict
+	}
+	return false
+}
+
+func isS3NotFound(err error) bool {
+	if reqErr, ok := err.(awserr.RequestFailure); ok {
+		return reqErr.StatusCode() == http.StatusNotFound
+	}
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == s3.ErrCodeNoSuchKey
+	}
+	return false
+}
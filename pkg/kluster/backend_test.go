@@ -0,0 +1,304 @@
+package kluster
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	awscredentials "github.com/aws/aws-sdk-go/aws/credentials"
+)
+
+// fakeS3 is a minimal S3 compatible object storage, like MinIO, with path style
+// buckets to get, put, delete and list objects
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)
+	bucket, key := parts[0], ""
+	if len(parts) == 2 {
+		key = parts[1]
+	}
+
+	switch {
+	case r.Method == http.MethodGet && len(key) == 0:
+		prefix := r.URL.Query().Get("prefix")
+		keys := []string{}
+		for k := range f.objects {
+			if strings.HasPrefix(k, bucket+"/"+prefix) {
+				keys = append(keys, strings.TrimPrefix(k, bucket+"/"))
+			}
+		}
+		sort.Strings(keys)
+		var b bytes.Buffer
+		fmt.Fprintf(&b, `<?xml version="1.0" encoding="UTF-8"?><ListBucketResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Name>%s</Name><KeyCount>%d</KeyCount><MaxKeys>1000</MaxKeys><IsTruncated>false</IsTruncated>`, bucket, len(keys))
+		for _, k := range keys {
+			b.WriteString("<Contents><Key>")
+			xml.EscapeText(&b, []byte(k))
+			fmt.Fprintf(&b, "</Key><Size>%d</Size></Contents>", len(f.objects[bucket+"/"+k]))
+		}
+		b.WriteString("</ListBucketResult>")
+		w.Header().Set("Content-Type", "application/xml")
+		w.Write(b.Bytes())
+	case r.Method == http.MethodGet:
+		data, ok := f.objects[bucket+"/"+key]
+		if !ok {
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message><Key>%s</Key></Error>`, key)
+			return
+		}
+		w.Write(data)
+	case r.Method == http.MethodPut:
+		if _, ok := f.objects[bucket+"/"+key]; ok && r.Header.Get("If-None-Match") == "*" {
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusPreconditionFailed)
+			fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>PreconditionFailed</Code><Message>At least one of the pre-conditions you specified did not hold</Message><Key>%s</Key></Error>`, key)
+			return
+		}
+		data, _ := ioutil.ReadAll(r.Body)
+		f.objects[bucket+"/"+key] = data
+	case r.Method == http.MethodDelete:
+		delete(f.objects, bucket+"/"+key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// fakeHTTPBackend is a minimal server compatible with the Terraform HTTP backend
+type fakeHTTPBackend struct {
+	mu     sync.Mutex
+	states map[string][]byte
+	locks  map[string]*LockInfo
+}
+
+func (f *fakeHTTPBackend) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	path := r.URL.Path
+	body, _ := ioutil.ReadAll(r.Body)
+
+	switch r.Method {
+	case http.MethodGet:
+		data, ok := f.states[path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(data)
+	case http.MethodPost:
+		if lock, ok := f.locks[path]; ok && lock.ID != r.URL.Query().Get("ID") {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		f.states[path] = body
+	case "LOCK":
+		if lock, ok := f.locks[path]; ok {
+			w.WriteHeader(http.StatusLocked)
+			json.NewEncoder(w).Encode(lock)
+			return
+		}
+		lock := &LockInfo{}
+		json.Unmarshal(body, lock)
+		f.locks[path] = lock
+	case "UNLOCK":
+		lock := &LockInfo{}
+		json.Unmarshal(body, lock)
+		if current, ok := f.locks[path]; ok && current.ID != lock.ID {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		delete(f.locks, path)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestStateBackends(t *testing.T) {
+	s3Server := httptest.NewServer(&fakeS3{objects: map[string][]byte{}})
+	defer s3Server.Close()
+	httpServer := httptest.NewServer(&fakeHTTPBackend{states: map[string][]byte{}, locks: map[string]*LockInfo{}})
+	defer httpServer.Close()
+
+	dir, err := ioutil.TempDir("", "kubekit-backend")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	const maxHistory = 2
+	s3b, err := newS3Backend(&BackendConfig{Type: S3Backend, Bucket: "states", Endpoint: s3Server.URL}, "kkdemo", maxHistory, awscredentials.NewStaticCredentials("AKID", "SECRET", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	httpb, err := newHTTPBackend(&BackendConfig{Type: HTTPBackend, Address: httpServer.URL + "/kkdemo"}, "kkdemo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		backend StateBackend
+		// the local lock is owned by this process, it can be locked again
+		wantLockErr bool
+		wantHistory bool
+	}{
+		{"local", newLocalBackend(dir, maxHistory), false, true},
+		{"s3", s3b, true, true},
+		{"http", httpb, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := tt.backend
+
+			got, err := b.Read("ec2")
+			if err != nil || got != nil {
+				t.Fatalf("Read() without state = %q, %v; want nil", got, err)
+			}
+
+			lock, err := b.Lock("ec2", "provision")
+			if err != nil {
+				t.Fatalf("Lock() error = %v", err)
+			}
+			_, err = b.Lock("ec2", "terminate")
+			if _, isLockErr := err.(*LockError); isLockErr != tt.wantLockErr {
+				t.Errorf("Lock() on a locked state error = %v, want lock error %v", err, tt.wantLockErr)
+			}
+
+			states := []string{`{"serial":1}`, `{"serial":2}`, `{"serial":2}`, `{"serial":3}`}
+			for _, s := range states {
+				if err := b.Write("ec2", []byte(s)); err != nil {
+					t.Fatalf("Write() error = %v", err)
+				}
+			}
+			if err := b.Unlock("ec2", lock); err != nil {
+				t.Fatalf("Unlock() error = %v", err)
+			}
+			if _, err := b.Lock("ec2", "provision"); err != nil {
+				t.Errorf("Lock() after unlock error = %v", err)
+			}
+
+			got, err = b.Read("ec2")
+			if err != nil || string(got) != `{"serial":3}` {
+				t.Errorf("Read() = %q, %v; want the last state", got, err)
+			}
+
+			versions, err := b.History("ec2")
+			if !tt.wantHistory {
+				if err == nil {
+					t.Errorf("History() expected an error, the history is not supported")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("History() error = %v", err)
+			}
+			if len(versions) != maxHistory {
+				t.Fatalf("History() returned %d versions, want %d", len(versions), maxHistory)
+			}
+			for i, want := range []string{`{"serial":3}`, `{"serial":2}`} {
+				got, err := b.ReadVersion("ec2", versions[i].ID)
+				if err != nil || string(got) != want {
+					t.Errorf("ReadVersion(%s) = %q, %v; want %q", versions[i].ID, got, err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestNewStateBackend(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *BackendConfig
+		want    string
+		wantErr bool
+	}{
+		{"no backend", nil, "*kluster.localBackend", false},
+		{"local", &BackendConfig{Type: "local"}, "*kluster.localBackend", false},
+		{"s3", &BackendConfig{Type: "s3", Bucket: "states", Region: "us-west-2"}, "*kluster.s3Backend", false},
+		{"s3 without bucket", &BackendConfig{Type: "s3"}, "", true},
+		{"http", &BackendConfig{Type: "HTTP", Address: "https://states.example.com/kkdemo"}, "*kluster.httpBackend", false},
+		{"http without address", &BackendConfig{Type: "http"}, "", true},
+		{"unknown", &BackendConfig{Type: "consul"}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewStateBackend(tt.config, "kkdemo", "/tmp/kkdemo/.tfstate")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewStateBackend() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if gotType := fmt.Sprintf("%T", got); gotType != tt.want {
+				t.Errorf("NewStateBackend() = %s, want %s", gotType, tt.want)
+			}
+		})
+	}
+}
+
+func TestKluster_RestoreState(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kubekit-backend")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	k, err := CreateCluster("kkdemo", "ec2", dir, "yaml", nil, parentUI)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend, err := k.StateBackend()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	states := []string{
+		`{"version":4,"terraform_version":"0.12.20","serial":1,"lineage":"kkdemo","outputs":{"address":{"value":"10.0.0.1","type":"string"}},"resources":[]}`,
+		`{"version":4,"terraform_version":"0.12.20","serial":2,"lineage":"kkdemo","outputs":{"address":{"value":"10.0.0.2","type":"string"}},"resources":[]}`,
+	}
+	for _, s := range states {
+		if err := backend.Write("ec2", []byte(s)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	versions, err := k.StateHistory()
+	if err != nil {
+		t.Fatalf("StateHistory() error = %v", err)
+	}
+	if len(versions) != len(states) {
+		t.Fatalf("StateHistory() returned %d versions, want %d", len(versions), len(states))
+	}
+
+	if err := k.RestoreState("not-a-version"); err == nil {
+		t.Errorf("RestoreState() expected an error restoring an unknown version")
+	}
+	if err := k.RestoreState(versions[1].ID); err != nil {
+		t.Fatalf("RestoreState() error = %v", err)
+	}
+	// the local state is the working copy of the state, rewritten when it's loaded
+	got, err := backend.Read("ec2")
+	if err != nil || !strings.Contains(string(got), "10.0.0.1") {
+		t.Errorf("RestoreState() current state = %q, %v; want the state %q", got, err, states[0])
+	}
+	if versions, _ := k.StateHistory(); len(versions) != len(states)+1 {
+		t.Errorf("RestoreState() history has %d versions, want the restored state as a new version", len(versions))
+	}
+}
@@ -0,0 +1,438 @@
+package kluster
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/liferaft/kubekit/pkg/configurator"
+	"github.com/liferaft/kubekit/pkg/configurator/ssh"
+)
+
+// BackupsDirname is the name of the directory, in the cluster directory, where
+// the etcd snapshots are stored
+const BackupsDirname = "backups"
+
+const (
+	etcdctlCMD           = "ETCDCTL_API=3 /etc/kubernetes/bin/etcdctl"
+	etcdctlClientFlags   = "--endpoints=https://127.0.0.1:2379 --cacert=/etc/pki/etcd_root_ca.crt --cert=/etc/pki/etcd_node.crt --key=/etc/pki/etcd_node.key"
+	etcdManifestsDir     = "/etc/kubernetes/manifests"
+	etcdStaticPods       = "etcd.yaml kube-apiserver.yaml"
+	etcdStopTimeout      = 120
+	snapshotTimeFormat   = "20060102T150405Z"
+	snapshotChecksumType = "sha256"
+)
+
+// EtcdSnapshot is the metadata of an etcd snapshot taken from a cluster. It's
+// stored next to the snapshot file with the same name and `.json` extension
+type EtcdSnapshot struct {
+	Cluster      string    `json:"cluster" yaml:"cluster" mapstructure:"cluster"`
+	Platform     string    `json:"platform" yaml:"platform" mapstructure:"platform"`
+	Release      string    `json:"release,omitempty" yaml:"release,omitempty" mapstructure:"release"`
+	Node         string    `json:"node" yaml:"node" mapstructure:"node"`
+	Filename     string    `json:"filename" yaml:"filename" mapstructure:"filename"`
+	Size         int64     `json:"size" yaml:"size" mapstructure:"size"`
+	Checksum     string    `json:"checksum" yaml:"checksum" mapstructure:"checksum"`
+	ChecksumType string    `json:"checksum_type" yaml:"checksum_type" mapstructure:"checksum_type"`
+	CreatedAt    time.Time `json:"created_at" yaml:"created_at" mapstructure:"created_at"`
+}
+
+// etcdMember is an etcd cluster member as reported by `etcdctl member list`
+type etcdMember struct {
+	ID       uint64   `json:"ID"`
+	Name     string   `json:"name"`
+	PeerURLs []string `json:"peerURLs"`
+}
+
+// BackupsDir returns the directory where the etcd snapshots of this cluster are
+func (k *Kluster) BackupsDir() string {
+	return filepath.Join(k.Dir(), BackupsDirname)
+}
+
+// Backup takes a snapshot of etcd on one master and downloads it to the backups
+// directory of the cluster, with a metadata file containing its checksum. It
+// returns the path to the snapshot file
+func (k *Kluster) Backup() (string, error) {
+	platformName := k.Platform()
+	logPrefix := fmt.Sprintf("KubeKit [ %s@%s ]", k.Name, platformName)
+	k.ui.SetLogPrefix(logPrefix)
+
+	masters, err := k.etcdMembers()
+	if err != nil {
+		return "", err
+	}
+	master := masters[0]
+
+	backupsDir := k.BackupsDir()
+	if err := os.MkdirAll(backupsDir, 0700); err != nil {
+		return "", fmt.Errorf("failed to create the backups directory %s. %s", backupsDir, err)
+	}
+
+	createdAt := time.Now().UTC()
+	filename := fmt.Sprintf("etcd-snapshot-%s.db", createdAt.Format(snapshotTimeFormat))
+	snapshotsDir, _ := k.etcdDirs()
+	remoteFile := filepath.Join(snapshotsDir, filename)
+	tmpFile := filepath.Join("/tmp", filename)
+
+	k.ui.Log.Infof("taking the etcd snapshot %s on master %s", remoteFile, master.PublicIP)
+	saveCMD := fmt.Sprintf("%s %s snapshot save %s && cp %s %s && chmod 644 %s", etcdctlCMD, etcdctlClientFlags, remoteFile, remoteFile, tmpFile, tmpFile)
+	if _, err := k.execIn(saveCMD, master); err != nil {
+		return "", fmt.Errorf("failed to take the etcd snapshot. %s", err)
+	}
+	defer k.execIn("rm -f "+tmpFile, master)
+
+	out, err := k.execIn("sha256sum "+tmpFile, master)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the checksum of the etcd snapshot. %s", err)
+	}
+	fields := strings.Fields(out[master.PublicIP])
+	if len(fields) == 0 {
+		return "", fmt.Errorf("failed to get the checksum of the etcd snapshot on master %s", master.PublicIP)
+	}
+	remoteChecksum := fields[0]
+
+	k.ui.Log.Infof("downloading the etcd snapshot to %s", backupsDir)
+	if err := k.CopyFile(":"+tmpFile, backupsDir, []string{master.PublicIP}, nil, true, false, false, "", "", "0600"); err != nil {
+		return "", err
+	}
+	// CopyFile downloads the file into a directory named as the node IP
+	hostDir := filepath.Join(backupsDir, master.PublicIP)
+	snapshotFile := filepath.Join(backupsDir, filename)
+	if err := os.Rename(filepath.Join(hostDir, filename), snapshotFile); err != nil {
+		return "", fmt.Errorf("failed to move the etcd snapshot to %s. %s", snapshotFile, err)
+	}
+	os.Remove(hostDir)
+
+	checksum, size, err := fileChecksum(snapshotFile)
+	if err != nil {
+		return "", err
+	}
+	if checksum != remoteChecksum {
+		return "", fmt.Errorf("the checksum of the downloaded etcd snapshot (%s) does not match the checksum on master %s (%s)", checksum, master.PublicIP, remoteChecksum)
+	}
+
+	metadata := EtcdSnapshot{
+		Cluster:      k.Name,
+		Platform:     platformName,
+		Release:      k.State[platformName].Release,
+		Node:         master.PublicIP,
+		Filename:     filename,
+		Size:         size,
+		Checksum:     checksum,
+		ChecksumType: snapshotChecksumType,
+		CreatedAt:    createdAt,
+	}
+	if err := metadata.write(snapshotMetadataFile(snapshotFile)); err != nil {
+		return "", err
+	}
+	// the snapshot has every Kubernetes secret, the checksum is of the snapshot
+	// before it's encrypted
+	if err := encryptFile(snapshotFile); err != nil {
+		return "", fmt.Errorf("failed to encrypt the etcd snapshot %s. %s", snapshotFile, err)
+	}
+
+	k.ui.Log.Infof("etcd snapshot saved to %s", snapshotFile)
+
+	return snapshotFile, nil
+}
+
+// Restore restores the given etcd snapshot on every etcd member, the masters.
+// The etcd and kube-apiserver static pods are stopped on every master, the data
+// directory of every member is re-initialized from the snapshot with the same
+// name and peer URLs, then the static pods are started again
+func (k *Kluster) Restore(snapshotFile string) error {
+	platformName := k.Platform()
+	logPrefix := fmt.Sprintf("KubeKit [ %s@%s ]", k.Name, platformName)
+	k.ui.SetLogPrefix(logPrefix)
+
+	masters, err := k.etcdMembers()
+	if err != nil {
+		return err
+	}
+
+	plainFile, remove, err := decryptedFile(snapshotFile)
+	if err != nil {
+		return fmt.Errorf("failed to read the etcd snapshot %s. %s", snapshotFile, err)
+	}
+	defer remove()
+
+	if err := k.verifySnapshot(snapshotFile, plainFile); err != nil {
+		return err
+	}
+
+	filename := filepath.Base(snapshotFile)
+	tmpFile := filepath.Join("/tmp", filename)
+	addresses := make([]string, 0, len(masters))
+	for _, m := range masters {
+		addresses = append(addresses, m.PublicIP)
+	}
+
+	k.ui.Log.Infof("uploading the etcd snapshot %s to the masters", snapshotFile)
+	if err := k.CopyFile(plainFile, ":/tmp", addresses, nil, true, false, false, "", "", "0600"); err != nil {
+		return err
+	}
+	defer k.execIn("rm -f "+tmpFile, masters...)
+
+	// The members name and peer URLs are required to re-initialize every member,
+	// get them before etcd is stopped
+	restoreCMDs, err := k.etcdRestoreCommands(masters, tmpFile)
+	if err != nil {
+		return err
+	}
+
+	k.ui.Log.Infof("stopping etcd and the Kubernetes API server on the masters")
+	stopCMD := fmt.Sprintf("cd %s && mv %s /etc/kubernetes/ && timeout %d sh -c 'while docker ps -q --filter name=k8s_etcd | grep -q .; do sleep 2; done'", etcdManifestsDir, etcdStaticPods, etcdStopTimeout)
+	if _, err := k.execIn(stopCMD, masters...); err != nil {
+		return fmt.Errorf("failed to stop etcd on the masters. %s", err)
+	}
+
+	var errRestore error
+	for _, m := range masters {
+		k.ui.Log.Infof("restoring the etcd snapshot on master %s", m.PublicIP)
+		if _, errRestore = k.execIn(restoreCMDs[m.PublicIP], m); errRestore != nil {
+			errRestore = fmt.Errorf("failed to restore the etcd snapshot on master %s. %s", m.PublicIP, errRestore)
+			break
+		}
+	}
+
+	// The static pods are started even if the restore failed, the members not
+	// restored still have their previous data
+	k.ui.Log.Infof("starting etcd and the Kubernetes API server on the masters")
+	startCMD := fmt.Sprintf("cd /etc/kubernetes && mv %s %s/", etcdStaticPods, etcdManifestsDir)
+	if _, err := k.execIn(startCMD, masters...); err != nil {
+		if errRestore != nil {
+			return fmt.Errorf("%s. Also failed to start etcd on the masters. %s", errRestore, err)
+		}
+		return fmt.Errorf("failed to start etcd on the masters. %s", err)
+	}
+	if errRestore != nil {
+		return errRestore
+	}
+
+	client, err := k.KubeClient()
+	if err != nil {
+		return fmt.Errorf("failed to create the Kubernetes client to verify the nodes. %s", err)
+	}
+	if err := k.waitNodesReady(client, nil, StartTimeout); err != nil {
+		return err
+	}
+
+	k.State[platformName].Status = RunningStatus.String()
+
+	return nil
+}
+
+// etcdMembers returns the hosts running etcd, the masters
+func (k *Kluster) etcdMembers() (configurator.Hosts, error) {
+	platformName := k.Platform()
+
+	switch platformName {
+	case "eks", "aks":
+		return nil, fmt.Errorf("etcd is managed by the %s platform, it cannot be backed up or restored with KubeKit", platformName)
+	}
+
+	if err := k.LoadState(); err != nil {
+		return nil, err
+	}
+
+	masters := k.State[platformName].Nodes.FilterByRolePrefix("master")
+	if len(masters) == 0 {
+		return nil, fmt.Errorf("not found masters in the cluster %q", k.Name)
+	}
+
+	return masters, nil
+}
+
+// etcdDirs returns the etcd snapshots and data directories on the masters
+func (k *Kluster) etcdDirs() (snapshotsDir, dataDir string) {
+	snapshotsDir, dataDir = "/data/etcd-snapshots", "/var/lib/etcd"
+	if k.Platform() == "stacki" {
+		dataDir = "/data/etcd"
+	}
+	if k.Config != nil {
+		if len(k.Config.EtcdSnapshotsDirectory) != 0 {
+			snapshotsDir = k.Config.EtcdSnapshotsDirectory
+		}
+		if len(k.Config.EtcdDataDirectory) != 0 {
+			dataDir = k.Config.EtcdDataDirectory
+		}
+	}
+	return snapshotsDir, dataDir
+}
+
+// etcdRestoreCommands returns the command to re-initialize every etcd member
+// from the given snapshot, indexed by the master IP. The current member data
+// is kept in a backup directory next to the data directory
+func (k *Kluster) etcdRestoreCommands(masters configurator.Hosts, snapshotFile string) (map[string]string, error) {
+	out, err := k.execIn(fmt.Sprintf("%s %s member list -w json", etcdctlCMD, etcdctlClientFlags), masters[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the etcd members. %s", err)
+	}
+	var memberList struct {
+		Members []etcdMember `json:"members"`
+	}
+	if err := json.Unmarshal([]byte(out[masters[0].PublicIP]), &memberList); err != nil {
+		return nil, fmt.Errorf("failed to parse the etcd members. %s", err)
+	}
+
+	initialCluster := []string{}
+	for _, m := range memberList.Members {
+		if len(m.PeerURLs) == 0 {
+			return nil, fmt.Errorf("the etcd member %s does not have a peer URL", m.Name)
+		}
+		initialCluster = append(initialCluster, m.Name+"="+m.PeerURLs[0])
+	}
+
+	tokenCMD := fmt.Sprintf("grep -o -- '--initial-cluster-token=[^ ]*' %s/etcd.yaml | cut -d= -f2", etcdManifestsDir)
+	if out, err = k.execIn(tokenCMD, masters[0]); err != nil {
+		return nil, fmt.Errorf("failed to get the etcd initial cluster token. %s", err)
+	}
+	token := strings.TrimSpace(out[masters[0].PublicIP])
+
+	out, err = k.execIn(fmt.Sprintf("%s %s endpoint status -w json", etcdctlCMD, etcdctlClientFlags), masters...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the etcd member of the masters. %s", err)
+	}
+
+	_, dataDir := k.etcdDirs()
+	restoreDir := dataDir + ".restore"
+	bkpDir := fmt.Sprintf("%s.%d.bkp", dataDir, time.Now().Unix())
+
+	cmds := make(map[string]string, len(masters))
+	for _, h := range masters {
+		var status []struct {
+			Status struct {
+				Header struct {
+					MemberID uint64 `json:"member_id"`
+				} `json:"header"`
+			} `json:"Status"`
+		}
+		if err := json.Unmarshal([]byte(out[h.PublicIP]), &status); err != nil || len(status) == 0 {
+			return nil, fmt.Errorf("failed to parse the etcd member status of master %s. %v", h.PublicIP, err)
+		}
+		var member *etcdMember
+		for i, m := range memberList.Members {
+			if m.ID == status[0].Status.Header.MemberID {
+				member = &memberList.Members[i]
+				break
+			}
+		}
+		if member == nil {
+			return nil, fmt.Errorf("not found the etcd member of master %s", h.PublicIP)
+		}
+
+		cmds[h.PublicIP] = fmt.Sprintf("rm -rf %[1]s && %[2]s snapshot restore %[3]s --name %[4]s --initial-cluster %[5]s --initial-cluster-token %[6]s --initial-advertise-peer-urls %[7]s --data-dir %[1]s && mkdir -p %[8]s && mv %[9]s/member %[8]s/ ; mv %[1]s/member %[9]s/ && rm -rf %[1]s",
+			restoreDir, etcdctlCMD, snapshotFile, member.Name, strings.Join(initialCluster, ","), token, member.PeerURLs[0], bkpDir, dataDir)
+	}
+
+	return cmds, nil
+}
+
+// verifySnapshot verifies the checksum of the given snapshot file with the
+// checksum in the metadata file, if there is one. The checksum is of the
+// decrypted snapshot, plainFile, if the snapshot is encrypted at rest
+func (k *Kluster) verifySnapshot(snapshotFile, plainFile string) error {
+
+	metadataFile := snapshotMetadataFile(snapshotFile)
+	metadata, err := readEtcdSnapshot(metadataFile)
+	if os.IsNotExist(err) {
+		k.ui.Log.Warnf("not found the metadata file %s, the etcd snapshot integrity cannot be verified", metadataFile)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if metadata.Cluster != k.Name {
+		k.ui.Log.Warnf("the etcd snapshot was taken from the cluster %q", metadata.Cluster)
+	}
+
+	checksum, _, err := fileChecksum(plainFile)
+	if err != nil {
+		return err
+	}
+	if checksum != metadata.Checksum {
+		return fmt.Errorf("the checksum of the etcd snapshot %s (%s) does not match the checksum in the metadata file (%s)", snapshotFile, checksum, metadata.Checksum)
+	}
+
+	return nil
+}
+
+// execIn executes the given command with sudo on the given hosts. It returns
+// the command output indexed by the host IP, or an error if the command fails
+// in any host
+func (k *Kluster) execIn(command string, hosts ...configurator.Host) (map[string]string, error) {
+	addresses := make([]string, 0, len(hosts))
+	for _, h := range hosts {
+		addresses = append(addresses, h.PublicIP)
+	}
+
+	result, err := k.Exec("sh -c "+ssh.ShellQuote(command), "", addresses, nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]string, len(addresses))
+	errMsg := []string{}
+	for ip, r := range result.Hosts.GetSnapshot() {
+		if r.ExitStatus != 0 {
+			errMsg = append(errMsg, fmt.Sprintf("%s (%s)", ip, strings.TrimSpace(r.Stderr)))
+			continue
+		}
+		out[ip] = r.Stdout
+	}
+	if len(errMsg) != 0 {
+		return out, fmt.Errorf("the command failed on the following hosts: %s", strings.Join(errMsg, ", "))
+	}
+
+	return out, nil
+}
+
+// snapshotMetadataFile returns the metadata filename of the given snapshot
+func snapshotMetadataFile(snapshotFile string) string {
+	return strings.TrimSuffix(snapshotFile, filepath.Ext(snapshotFile)) + ".json"
+}
+
+// fileChecksum returns the sha256 checksum and size of the given file
+func fileChecksum(filename string) (string, int64, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return "", 0, err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	size, err := io.Copy(h, f)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to get the checksum of %s. %s", filename, err)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), size, nil
+}
+
+func (s *EtcdSnapshot) write(filename string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filename, data, 0600)
+}
+
+func readEtcdSnapshot(filename string) (*EtcdSnapshot, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var s EtcdSnapshot
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("failed to read the etcd snapshot metadata %s. %s", filename, err)
+	}
+	return &s, nil
+}
@@ -0,0 +1,54 @@
+package kluster
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func Test_snapshotMetadataFile(t *testing.T) {
+	tests := []struct {
+		name         string
+		snapshotFile string
+		want         string
+	}{
+		{"db extension", "/tmp/backups/etcd-snapshot-20200101T000000Z.db", "/tmp/backups/etcd-snapshot-20200101T000000Z.json"},
+		{"no extension", "/tmp/backups/snapshot", "/tmp/backups/snapshot.json"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := snapshotMetadataFile(tt.snapshotFile); got != tt.want {
+				t.Errorf("snapshotMetadataFile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_fileChecksum(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kubekit-backup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "snapshot.db")
+	if err := ioutil.WriteFile(filename, []byte("kubekit"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	checksum, size, err := fileChecksum(filename)
+	if err != nil {
+		t.Fatalf("fileChecksum() error = %v", err)
+	}
+	if want := "ea6d9dd8161482c3b207d9564be07c5f3ef44effce5fcb3f4637aef22e2f5236"; checksum != want {
+		t.Errorf("fileChecksum() checksum = %v, want %v", checksum, want)
+	}
+	if size != 7 {
+		t.Errorf("fileChecksum() size = %v, want 7", size)
+	}
+
+	if _, _, err := fileChecksum(filepath.Join(dir, "not-found.db")); err == nil {
+		t.Errorf("fileChecksum() expected an error for a file that does not exists")
+	}
+}
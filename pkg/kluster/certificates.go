@@ -10,6 +10,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 	"text/template"
 
@@ -78,7 +79,7 @@ var CertNames = map[string]Cert{
 			"{{ ALB }}",
 		},
 		IPAddresses: []string{
-			"172.21.0.1",
+			"{{ service_ip }}",
 			"{{ VIP }}",
 			"{{ masters }}",
 		},
@@ -98,7 +99,7 @@ var CertNames = map[string]Cert{
 			"{{ ALB }}",
 		},
 		IPAddresses: []string{
-			"172.21.0.1",
+			"{{ service_ip }}",
 			"{{ VIP }}",
 			"{{ masters }}",
 			"{{ workers }}",
@@ -118,7 +119,7 @@ var CertNames = map[string]Cert{
 			"{{ ALB }}",
 		},
 		IPAddresses: []string{
-			"172.21.0.1",
+			"{{ service_ip }}",
 			"{{ VIP }}",
 			"{{ masters }}",
 			"{{ workers }}",
@@ -137,7 +138,7 @@ var CertNames = map[string]Cert{
 			"{{ ALB }}",
 		},
 		IPAddresses: []string{
-			"172.21.0.1",
+			"{{ service_ip }}",
 			"{{ VIP }}",
 			"{{ masters }}",
 		},
@@ -155,7 +156,7 @@ var CertNames = map[string]Cert{
 			"{{ ALB }}",
 		},
 		IPAddresses: []string{
-			"172.21.0.1",
+			"{{ service_ip }}",
 			"{{ VIP }}",
 			"{{ masters }}",
 		},
@@ -175,7 +176,7 @@ var CertNames = map[string]Cert{
 			"{{ ALB }}",
 		},
 		IPAddresses: []string{
-			"172.21.0.1",
+			"{{ service_ip }}",
 			"{{ VIP }}",
 			"{{ masters }}",
 			"{{ workers }}",
@@ -194,7 +195,7 @@ var CertNames = map[string]Cert{
 			"{{ ALB }}",
 		},
 		IPAddresses: []string{
-			"172.21.0.1",
+			"{{ service_ip }}",
 			"{{ VIP }}",
 			"{{ masters }}",
 		},
@@ -209,12 +210,10 @@ var CertNames = map[string]Cert{
 		DNSNames: []string{
 			"{{ masters }}",
 			"{{ workers }}",
-			"{{ ingress_additional_dns_alt_names }}", // TODO: Not defined
 		},
 		IPAddresses: []string{
 			"{{ masters }}",
 			"{{ workers }}",
-			"{{ ingress_additional_dns_alt_ips }}", // TODO: Not defined
 		},
 	},
 	"opa": Cert{
@@ -229,7 +228,7 @@ var CertNames = map[string]Cert{
 			"{{ workers }}",
 		},
 		IPAddresses: []string{
-			"172.21.0.1",
+			"{{ service_ip }}",
 			"{{ masters }}",
 			"{{ workers }}",
 		},
@@ -240,6 +239,26 @@ var CertNames = map[string]Cert{
 	// },
 }
 
+// KeyOptions returns the key algorithm, validity and signature algorithm to
+// generate the certificates from the cluster configuration. Ed25519 keys are
+// not valid because Kubernetes does not support them to sign the service
+// account tokens
+func (k *Kluster) KeyOptions() (*tls.KeyOptions, error) {
+	opts := &tls.KeyOptions{}
+	if k.Config != nil {
+		opts.KeyAlgorithm = k.Config.CertificatesKeyAlgorithm
+		opts.ValidityDays = k.Config.CertificatesValidityDays
+		opts.SignatureAlgorithm = k.Config.CertificatesSignatureAlgorithm
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if strings.ToLower(opts.KeyAlgorithm) == tls.Ed25519 {
+		return nil, fmt.Errorf("the key algorithm %s is not supported by Kubernetes to sign the service account tokens, use RSA or ECDSA keys", tls.Ed25519)
+	}
+	return opts, nil
+}
+
 // GenerateCerts generates all the certificates self-signed if the CA Key and
 // Cert are not provided.
 func (k *Kluster) GenerateCerts(userCACertsFiles tls.KeyPairs, forceGenerateCA bool) error {
@@ -267,8 +286,12 @@ func (k *Kluster) GenerateCerts(userCACertsFiles tls.KeyPairs, forceGenerateCA b
 		return err
 	}
 	// ... the Certificates
-	_, err = k.genCertificates(baseCertsDir, platformName)
-	return err
+	if _, err = k.genCertificates(baseCertsDir, platformName, false); err != nil {
+		return err
+	}
+
+	// ... and the CA certificate of the OIDC issuer
+	return k.saveOIDCCA(baseCertsDir)
 
 	// return k.certificates.Save(overwrite)
 }
@@ -277,6 +300,15 @@ func (k *Kluster) genCACertificates(baseCertsDir string, userCACertsFiles tls.Ke
 	// TODO: If files are empty (not given by user in flags) check if the files
 	// are in the cluster certificates directory
 
+	opts, err := k.KeyOptions()
+	if err != nil {
+		return nil, err
+	}
+	external, err := k.externalCAs(baseCertsDir, userCACertsFiles)
+	if err != nil {
+		return nil, err
+	}
+
 	certificates := make(tls.KeyPairs, len(CACertNames))
 	genericKeyPair := userCACertsFiles[GenericKeyPairName]
 
@@ -339,7 +371,8 @@ func (k *Kluster) genCACertificates(baseCertsDir string, userCACertsFiles tls.Ke
 		// If this is the generic key and you get here, then create it returning empty files
 
 		// If this is the generic key, create it
-		if kp.Name == GenericKeyPairName {
+		// If the generic key is external, there is no key to use, so create it
+		if kp.Name == GenericKeyPairName || external[GenericKeyPairName] {
 			return &tls.KeyPair{
 				CertFile: "",
 				KeyFile:  "",
@@ -357,6 +390,22 @@ func (k *Kluster) genCACertificates(baseCertsDir string, userCACertsFiles tls.Ke
 			return fmt.Errorf("CA certificate information not found for %s", name)
 		}
 
+		// The external CAs sign the certificates outside KubeKit, only the CA
+		// certificate is required
+		if external[name] {
+			var genericCertFile string
+			if genericKeyPair != nil {
+				genericCertFile = genericKeyPair.CertFile
+			}
+			kp, err := k.loadExternalCA(baseCertsDir, name, certFile.CertFile, genericCertFile)
+			if err != nil {
+				return err
+			}
+			k.certificates[name] = kp
+			certificates[name] = kp
+			return nil
+		}
+
 		var fromCAKeyPair *tls.KeyPair
 		var err error
 		if forceGenerate {
@@ -383,7 +432,7 @@ func (k *Kluster) genCACertificates(baseCertsDir string, userCACertsFiles tls.Ke
 			k.ui.Log.Infof("generating CA certificate for %s", name)
 		}
 
-		kp, err := tls.NewCAKeyPair(fromCAKeyPair, baseCertsDir, name, CACertNames[name].CN)
+		kp, err := tls.NewCAKeyPair(fromCAKeyPair, baseCertsDir, name, CACertNames[name].CN, opts)
 		if err != nil {
 			return err
 		}
@@ -404,7 +453,7 @@ func (k *Kluster) genCACertificates(baseCertsDir string, userCACertsFiles tls.Ke
 	defer k.ui.Notify("kubernetes", "certificates", "</certificates>", "")
 
 	// First, generate the generic key
-	err := generateCAKeyPair(GenericKeyPairName)
+	err = generateCAKeyPair(GenericKeyPairName)
 	if err != nil {
 		return nil, err
 	}
@@ -440,7 +489,29 @@ func (k *Kluster) genCACertificates(baseCertsDir string, userCACertsFiles tls.Ke
 	return certificates, nil
 }
 
-func (k *Kluster) genCertificates(baseCertsDir string, platform string) (tls.KeyPairs, error) {
+// genCertificates generates the certificates signed by the CA certificates,
+// all of them or only the given certificate names. The certificates signed by
+// an external CA are requested to the signer, unless there is a valid
+// certificate and renew is false
+func (k *Kluster) genCertificates(baseCertsDir string, platform string, renew bool, names ...string) (tls.KeyPairs, error) {
+	opts, err := k.KeyOptions()
+	if err != nil {
+		return nil, err
+	}
+	signer, err := k.signer()
+	if err != nil {
+		return nil, err
+	}
+	serviceIP, err := k.kubeServiceIP()
+	if err != nil {
+		return nil, err
+	}
+	extraDNSNames, extraIPs, err := k.extraSANs()
+	if err != nil {
+		return nil, err
+	}
+	pending := []string{}
+
 	certificates := make(tls.KeyPairs, len(CertNames))
 
 	clusterIPS := make(map[string][]string, 0)
@@ -452,7 +523,12 @@ func (k *Kluster) genCertificates(baseCertsDir string, platform string) (tls.Key
 	clusterIPS["workers"] = []string{}
 	clusterDNSs["workers"] = []string{}
 
+	clusterIPS["service_ip"] = []string{serviceIP}
+
 	clusterHostnames := []string{}
+	// publicAddresses are the addresses to access the API server, they have to
+	// be in the API server certificate
+	publicAddresses := []string{}
 
 	nodes := k.State[platform].Nodes
 	for i, node := range nodes {
@@ -462,6 +538,9 @@ func (k *Kluster) genCertificates(baseCertsDir string, platform string) (tls.Key
 		if node.PublicIP != "" {
 			clusterIPS[node.RoleName+"s"] = append(clusterIPS[node.RoleName+"s"], node.PublicIP)
 		}
+		if node.RoleName == "master" {
+			publicAddresses = append(publicAddresses, node.PublicIP, node.PublicDNS)
+		}
 
 		privHostname := strings.Split(node.PrivateDNS, ".")[0]
 		pubHostname := strings.Split(node.PublicDNS, ".")[0]
@@ -535,14 +614,14 @@ func (k *Kluster) genCertificates(baseCertsDir string, platform string) (tls.Key
 	}
 
 	if len(address) > 0 {
-		if net.ParseIP(address).To4() != nil {
+		if net.ParseIP(address) != nil {
 			clusterIPS["VIP"] = []string{address}
 		} else {
 			clusterDNSs["ALB"] = []string{address}
 		}
 	}
 
-	if len(publicVIPAddress) > 0 && net.ParseIP(publicVIPAddress).To4() != nil {
+	if len(publicVIPAddress) > 0 && net.ParseIP(publicVIPAddress) != nil {
 		if _, ok := clusterIPS["VIP"]; ok {
 			clusterIPS["VIP"] = append(clusterIPS["VIP"], publicVIPAddress)
 		} else {
@@ -636,6 +715,21 @@ func (k *Kluster) genCertificates(baseCertsDir string, platform string) (tls.Key
 			genMsg = fmt.Sprintf("generating the node %s certificate for %s%s", hostname, name, fromCACertText)
 		}
 
+		// The certificates of an external CA are signed outside of KubeKit
+		if ca := k.certificates[certInfo.FromCA]; ca != nil && ca.IsExternal() {
+			csrDir := filepath.Join(k.CSRDir(), hostname)
+			kp := tls.NewEmptyKeyPair(baseCertsDir, name, cn, certInfo.O, dns, ips, certInfo.ExtKeyUsage, opts)
+			isPending, err := k.signByExternalCA(kp, ca, certName, csrDir, renew, signer)
+			if err != nil {
+				return err
+			}
+			if isPending {
+				pending = append(pending, certName)
+				return nil
+			}
+			return saveKeyPair(certName, kp)
+		}
+
 		// JUST GENERATE NEW
 		//if kp, err := tls.Load(baseCertsDir, name, cn); err == nil {
 		//	k.ui.Log.Infof(loadedMsg)
@@ -648,7 +742,7 @@ func (k *Kluster) genCertificates(baseCertsDir string, platform string) (tls.Key
 
 		k.ui.Log.Infof(genMsg)
 		// k.ui.Log.Debugf("using the IPs %s and DNSs %s", ips, dns)
-		kp, err := tls.NewKeyPair(baseCertsDir, name, cn, certInfo.O, dns, ips, k.certificates[certInfo.FromCA], certInfo.ExtKeyUsage)
+		kp, err := tls.NewKeyPair(baseCertsDir, name, cn, certInfo.O, dns, ips, k.certificates[certInfo.FromCA], certInfo.ExtKeyUsage, opts)
 		if err != nil {
 			return err
 		}
@@ -682,6 +776,9 @@ func (k *Kluster) genCertificates(baseCertsDir string, platform string) (tls.Key
 		if !neededForPlatform(name) {
 			continue
 		}
+		if len(names) != 0 && !inList(names, name) {
+			continue
+		}
 
 		ips := tls.GenericIPAddresses
 		for _, ip := range certInfo.IPAddresses {
@@ -707,6 +804,9 @@ func (k *Kluster) genCertificates(baseCertsDir string, platform string) (tls.Key
 			dns = append(dns, dn)
 		}
 
+		ips = append(ips, extraIPs[name]...)
+		dns = append(dns, extraDNSNames[name]...)
+
 		if strings.Contains(certInfo.CN, "{{ hostname }}") {
 			for _, hostname := range clusterHostnames {
 				baseCertsHostDir := filepath.Join(baseCertsDir, hostname)
@@ -727,6 +827,18 @@ func (k *Kluster) genCertificates(baseCertsDir string, platform string) (tls.Key
 		}
 	}
 
+	if kp, ok := certificates[APIServerCertName]; ok && kp.Certificate != nil {
+		publicAddresses = append(publicAddresses, address, publicVIPAddress)
+		if err := verifySANs(kp.Certificate, publicAddresses); err != nil {
+			return certificates, fmt.Errorf("the API server certificate is not valid for every public address of the cluster, add the missing addresses to the parameters certificates_extra_ips or certificates_extra_dns_names for the certificate %q. %s", APIServerCertName, err)
+		}
+	}
+
+	if len(pending) != 0 {
+		sort.Strings(pending)
+		return certificates, fmt.Errorf("%d certificate requests are waiting to be signed by the external CA (%s). Sign the requests in %s, save each certificate next to its request with the same name and extension '.crt', then execute the command again", len(pending), strings.Join(pending, ", "), k.CSRDir())
+	}
+
 	return certificates, nil
 }
 
@@ -819,6 +931,10 @@ type KubeconfigData struct {
 	Port                     int
 	ClientCertData           string
 	ClientKeyData            string
+	User                     string
+	OIDCIssuerURL            string
+	OIDCClientID             string
+	OIDCCAData               string
 }
 
 // KubeconfigTmpl is the kubeconfig template
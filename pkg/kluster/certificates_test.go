@@ -0,0 +1,39 @@
+package kluster
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/liferaft/kubekit/pkg/configurator"
+	"github.com/liferaft/kubekit/pkg/crypto/tls"
+)
+
+func TestKluster_KeyOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *configurator.Config
+		want    *tls.KeyOptions
+		wantErr bool
+	}{
+		{"no config", nil, &tls.KeyOptions{}, false},
+		{"defaults", &configurator.Config{}, &tls.KeyOptions{}, false},
+		{"ecdsa", &configurator.Config{CertificatesKeyAlgorithm: "ecdsa-p384", CertificatesValidityDays: 730, CertificatesSignatureAlgorithm: "ECDSA-SHA384"}, &tls.KeyOptions{KeyAlgorithm: "ecdsa-p384", ValidityDays: 730, SignatureAlgorithm: "ECDSA-SHA384"}, false},
+		{"rsa", &configurator.Config{CertificatesKeyAlgorithm: "rsa-4096", CertificatesSignatureAlgorithm: "SHA512-RSAPSS"}, &tls.KeyOptions{KeyAlgorithm: "rsa-4096", SignatureAlgorithm: "SHA512-RSAPSS"}, false},
+		{"ed25519", &configurator.Config{CertificatesKeyAlgorithm: "ed25519"}, nil, true},
+		{"unknown key algorithm", &configurator.Config{CertificatesKeyAlgorithm: "rsa-1024"}, nil, true},
+		{"unmatched signature algorithm", &configurator.Config{CertificatesKeyAlgorithm: "ecdsa-p256", CertificatesSignatureAlgorithm: "SHA256-RSA"}, nil, true},
+		{"negative validity", &configurator.Config{CertificatesValidityDays: -30}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := &Kluster{Config: tt.config}
+			got, err := k.KeyOptions()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Kluster.KeyOptions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Kluster.KeyOptions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
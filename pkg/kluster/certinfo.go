@@ -0,0 +1,201 @@
+package kluster
+
+import (
+	"bufio"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/liferaft/kubekit/pkg/configurator"
+)
+
+// deployedCertsMark is printed before the content of every certificate file
+// read from the nodes
+const deployedCertsMark = "==> "
+
+// CertificateInfo is the information of a CA or signed certificate of the
+// cluster, from the local certificates directory or deployed on a node
+type CertificateInfo struct {
+	Name        string    `json:"name" yaml:"name"`
+	Node        string    `json:"node,omitempty" yaml:"node,omitempty"`
+	File        string    `json:"file" yaml:"file"`
+	IsCA        bool      `json:"ca" yaml:"ca"`
+	Subject     string    `json:"subject" yaml:"subject"`
+	Issuer      string    `json:"issuer" yaml:"issuer"`
+	DNSNames    []string  `json:"dns_names,omitempty" yaml:"dns_names,omitempty"`
+	IPAddresses []string  `json:"ip_addresses,omitempty" yaml:"ip_addresses,omitempty"`
+	NotBefore   time.Time `json:"not_before" yaml:"not_before"`
+	NotAfter    time.Time `json:"not_after" yaml:"not_after"`
+	Deployed    bool      `json:"deployed" yaml:"deployed"`
+}
+
+// ExpiresIn returns the time left until the certificate expires from the given
+// time, it's negative if the certificate is expired
+func (ci CertificateInfo) ExpiresIn(now time.Time) time.Duration {
+	return ci.NotAfter.Sub(now)
+}
+
+// SANs returns the DNS names and IP addresses of the certificate
+func (ci CertificateInfo) SANs() []string {
+	return append(append([]string{}, ci.DNSNames...), ci.IPAddresses...)
+}
+
+func newCertificateInfo(name, node, file string, data []byte) (CertificateInfo, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return CertificateInfo{}, fmt.Errorf("the file %s does not contain a PEM encoded certificate", file)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return CertificateInfo{}, fmt.Errorf("failed to parse the certificate in %s. %s", file, err)
+	}
+
+	ips := make([]string, 0, len(cert.IPAddresses))
+	for _, ip := range cert.IPAddresses {
+		ips = append(ips, ip.String())
+	}
+
+	return CertificateInfo{
+		Name:        name,
+		Node:        node,
+		File:        file,
+		IsCA:        cert.IsCA,
+		Subject:     cert.Subject.String(),
+		Issuer:      cert.Issuer.String(),
+		DNSNames:    cert.DNSNames,
+		IPAddresses: ips,
+		NotBefore:   cert.NotBefore,
+		NotAfter:    cert.NotAfter,
+	}, nil
+}
+
+// Certificates returns the information of the CA and signed certificates in
+// the certificates directory of the cluster. The certificates per node, such
+// as the kubelet certificate, are in a directory named as the node hostname
+func (k *Kluster) Certificates() ([]CertificateInfo, error) {
+	baseCertsDir := filepath.Join(k.CertsDir(), k.Platform())
+	if _, err := os.Stat(baseCertsDir); err != nil {
+		return nil, fmt.Errorf("the certificates of the cluster %q were not found, they are generated with 'kubekit init certificates' or 'kubekit apply'. %s", k.Name, err)
+	}
+
+	certs := []CertificateInfo{}
+	err := filepath.Walk(baseCertsDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || filepath.Ext(path) != ".crt" {
+			return nil
+		}
+		var node string
+		if dir := filepath.Dir(path); dir != baseCertsDir {
+			node = filepath.Base(dir)
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		ci, err := newCertificateInfo(strings.TrimSuffix(info.Name(), ".crt"), node, path, data)
+		if err != nil {
+			k.ui.Log.Warn(err.Error())
+			return nil
+		}
+		certs = append(certs, ci)
+		return nil
+	})
+	sortCertificates(certs)
+
+	return certs, err
+}
+
+// DeployedCertificates returns the information of the certificates deployed
+// on all the cluster nodes or only on the given nodes or the nodes in the
+// given pools
+func (k *Kluster) DeployedCertificates(nodes, pools []string) ([]CertificateInfo, error) {
+	platform := k.Platform()
+	if platform == "eks" || platform == "aks" {
+		return nil, fmt.Errorf("the %s nodes are not accessible through SSH", platform)
+	}
+
+	c, err := k.newCommandFor(nodes, pools)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	command := fmt.Sprintf(`sh -c 'for f in %s/*.crt; do echo "%s$f"; cat "$f"; done'`, configurator.TLSDirectory, deployedCertsMark)
+	result, err := c.Exec(command, "", true)
+	if err != nil {
+		return nil, err
+	}
+
+	certs := []CertificateInfo{}
+	for _, host := range c.Hosts {
+		hostResult, ok := result.Hosts.Load(host.PublicIP)
+		if !ok || len(hostResult.Error) != 0 || hostResult.ExitStatus != 0 {
+			k.ui.Log.Warnf("failed to read the certificates deployed on the node %s", host.PublicIP)
+			continue
+		}
+		certs = append(certs, k.parseDeployedCertificates(host.PublicIP, hostResult.Stdout)...)
+	}
+	sortCertificates(certs)
+
+	return certs, nil
+}
+
+// parseDeployedCertificates returns the information of the certificates
+// printed by the command executed on the node, every certificate file content
+// is preceded by the deployedCertsMark and the filename
+func (k *Kluster) parseDeployedCertificates(node, output string) []CertificateInfo {
+	certs := []CertificateInfo{}
+
+	var file string
+	var content []string
+	add := func() {
+		if len(file) == 0 {
+			return
+		}
+		name := strings.TrimSuffix(filepath.Base(file), ".crt")
+		ci, err := newCertificateInfo(name, node, file, []byte(strings.Join(content, "\n")))
+		if err != nil {
+			k.ui.Log.Warnf("[%s] %s", node, err)
+			return
+		}
+		ci.Deployed = true
+		certs = append(certs, ci)
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, deployedCertsMark) {
+			add()
+			file = strings.TrimPrefix(line, deployedCertsMark)
+			content = []string{}
+			continue
+		}
+		content = append(content, line)
+	}
+	add()
+
+	return certs
+}
+
+// sortCertificates sorts the certificates by node, then the CA certificates
+// first and then by name
+func sortCertificates(certs []CertificateInfo) {
+	sort.SliceStable(certs, func(i, j int) bool {
+		if certs[i].Node != certs[j].Node {
+			return certs[i].Node < certs[j].Node
+		}
+		if certs[i].IsCA != certs[j].IsCA {
+			return certs[i].IsCA
+		}
+		return certs[i].Name < certs[j].Name
+	})
+}
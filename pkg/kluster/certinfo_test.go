@@ -0,0 +1,95 @@
+package kluster
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/liferaft/kubekit/pkg/crypto/tls"
+)
+
+func TestKluster_Certificates(t *testing.T) {
+	clusterDir, err := ioutil.TempDir("", "certinfo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(clusterDir)
+
+	k := &Kluster{
+		Name:      "kkdemo",
+		Platforms: map[string]interface{}{"raw": nil},
+		path:      filepath.Join(clusterDir, DefaultConfigFilename+".yaml"),
+		ui:        parentUI,
+	}
+	baseCertsDir, err := k.makeCertDir("raw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	hostCertsDir := filepath.Join(baseCertsDir, "worker000")
+	if err := os.MkdirAll(hostCertsDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	ca, err := tls.NewCAKeyPair(&tls.KeyPair{}, baseCertsDir, "root_ca", "kube-ca", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	kubelet, err := tls.NewKeyPair(hostCertsDir, "kubelet", "system:node:worker000", "system:nodes", []string{"worker000"}, []string{"10.0.0.1"}, ca, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, kp := range []*tls.KeyPair{ca, kubelet} {
+		if err := kp.SaveCertificate(true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ioutil.WriteFile(filepath.Join(baseCertsDir, "invalid.crt"), []byte("foo"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := k.Certificates()
+	if err != nil {
+		t.Fatalf("Certificates() error = %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("Certificates() returned %d certificates, want 2", len(got))
+	}
+
+	tests := []struct {
+		name     string
+		got      CertificateInfo
+		wantName string
+		wantNode string
+		wantCA   bool
+		wantSANs []string
+	}{
+		{"CA certificate", got[0], "root_ca", "", true, []string{}},
+		{"node certificate", got[1], "kubelet", "worker000", false, []string{"worker000", "10.0.0.1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got.Name != tt.wantName || tt.got.Node != tt.wantNode || tt.got.IsCA != tt.wantCA {
+				t.Errorf("Certificates() = {%s %s %v}, want {%s %s %v}", tt.got.Name, tt.got.Node, tt.got.IsCA, tt.wantName, tt.wantNode, tt.wantCA)
+			}
+			if !reflect.DeepEqual(tt.got.SANs(), tt.wantSANs) {
+				t.Errorf("Certificates() SANs = %v, want %v", tt.got.SANs(), tt.wantSANs)
+			}
+			if tt.got.NotAfter.IsZero() || tt.got.Deployed {
+				t.Errorf("Certificates() NotAfter = %v, Deployed = %v", tt.got.NotAfter, tt.got.Deployed)
+			}
+		})
+	}
+
+	output := "==> /etc/pki/root_ca.crt\n" + string(ca.CertificatePEM) + "==> /etc/pki/kubelet.crt\n" + string(kubelet.CertificatePEM) + "==> /etc/pki/empty.crt\n"
+	deployed := k.parseDeployedCertificates("10.0.0.1", output)
+	if len(deployed) != 2 {
+		t.Fatalf("parseDeployedCertificates() returned %d certificates, want 2", len(deployed))
+	}
+	for i, want := range []string{"/etc/pki/root_ca.crt", "/etc/pki/kubelet.crt"} {
+		if deployed[i].File != want || deployed[i].Node != "10.0.0.1" || !deployed[i].Deployed {
+			t.Errorf("parseDeployedCertificates() = {%s %s %v}, want {%s 10.0.0.1 true}", deployed[i].File, deployed[i].Node, deployed[i].Deployed, want)
+		}
+	}
+}
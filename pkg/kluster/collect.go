@@ -0,0 +1,423 @@
+package kluster
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/liferaft/kubekit/pkg/configurator"
+	"github.com/liferaft/kubekit/pkg/configurator/ssh"
+	"github.com/liferaft/kubekit/version"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// CollectManifestFilename is the name of the file, in a support bundle, with
+// the list of the collected items. It's always the first file in the bundle
+const CollectManifestFilename = "manifest.json"
+
+// Redacted is the text replacing the secrets in a support bundle
+const Redacted = "REDACTED"
+
+// collectLogsFile is the archive with the KubeKit logs created on every node
+const collectLogsFile = "/tmp/kubekit-collect-logs.tar.gz"
+
+// nodeCollectors are the commands executed on every node to collect the
+// diagnostics, the output of every command is saved in the given file
+var nodeCollectors = []struct {
+	name, file, command string
+}{
+	{"docker containers", "docker-ps.txt", "docker ps -a"},
+	{"docker journal", "journal/docker.log", "journalctl -u docker --no-pager --since '24 hours ago'"},
+	{"kubelet journal", "journal/kubelet.log", "journalctl -u kubelet --no-pager --since '24 hours ago'"},
+	{"journal warnings", "journal/warnings.log", "journalctl -p warning --no-pager --since '24 hours ago'"},
+	{"etcd health", "etcd-health.txt", "sh -c 'if [ -x /etc/kubernetes/bin/etcdctl ]; then ETCDCTL_API=3 /etc/kubernetes/bin/etcdctl --endpoints=https://127.0.0.1:2379 --cert=/etc/pki/etcd_node.crt --key=/etc/pki/etcd_node.key endpoint health 2>&1; else echo etcd is not installed on this node; fi'"},
+}
+
+// CollectedItem is an item collected, or failed to collect, in a support bundle
+type CollectedItem struct {
+	Name  string `json:"name" yaml:"name"`
+	Node  string `json:"node,omitempty" yaml:"node,omitempty"`
+	File  string `json:"file,omitempty" yaml:"file,omitempty"`
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// CollectManifest is the manifest of a support bundle, it lists what was
+// collected and what failed
+type CollectManifest struct {
+	Name           string          `json:"name" yaml:"name"`
+	Platform       string          `json:"platform" yaml:"platform"`
+	KubeKitVersion string          `json:"kubekit_version" yaml:"kubekit_version"`
+	CreatedAt      time.Time       `json:"created_at" yaml:"created_at"`
+	Items          []CollectedItem `json:"items" yaml:"items"`
+}
+
+// Failed returns the items that failed to be collected
+func (m *CollectManifest) Failed() []CollectedItem {
+	failed := []CollectedItem{}
+	for _, item := range m.Items {
+		if len(item.Error) != 0 {
+			failed = append(failed, item)
+		}
+	}
+	return failed
+}
+
+// bundle holds the collected files, already redacted, and items
+type bundle struct {
+	files map[string][]byte
+	items []CollectedItem
+	l     sync.Mutex
+}
+
+func newBundle() *bundle {
+	return &bundle{
+		files: make(map[string][]byte),
+	}
+}
+
+// add saves the redacted data to the file, if there is data, and records the
+// item with the error, if any
+func (b *bundle) add(name, node, file string, data []byte, err error) {
+	b.l.Lock()
+	defer b.l.Unlock()
+
+	item := CollectedItem{Name: name, Node: node}
+	if data != nil {
+		item.File = file
+		b.files[file] = redact(data)
+	}
+	if err != nil {
+		item.Error = err.Error()
+	}
+	b.items = append(b.items, item)
+}
+
+// fail records the item that failed to be collected
+func (b *bundle) fail(name, node string, err error) {
+	b.add(name, node, "", nil, err)
+}
+
+// Collect creates a support bundle in the given file, a gzip compressed tar
+// archive with the diagnostics of the cluster: the KubeKit logs, the journal
+// of docker and kubelet, the containers and the etcd health of every node,
+// and the Kubernetes objects. The secrets are redacted. The returned manifest,
+// also in the bundle, lists what was collected and what failed
+func (k *Kluster) Collect(filename string) (*CollectManifest, error) {
+	b := newBundle()
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		k.collectNodes(b)
+	}()
+	go func() {
+		defer wg.Done()
+		k.collectKubernetes(b)
+	}()
+	wg.Wait()
+
+	sort.SliceStable(b.items, func(i, j int) bool {
+		if b.items[i].Node != b.items[j].Node {
+			return b.items[i].Node < b.items[j].Node
+		}
+		return b.items[i].Name < b.items[j].Name
+	})
+
+	manifest := &CollectManifest{
+		Name:           k.Name,
+		Platform:       k.Platform(),
+		KubeKitVersion: version.Version,
+		CreatedAt:      time.Now().UTC(),
+		Items:          b.items,
+	}
+
+	if err := writeBundle(filename, manifest, b.files); err != nil {
+		return nil, fmt.Errorf("failed to create the support bundle %s. %s", filename, err)
+	}
+
+	return manifest, nil
+}
+
+// collectNodes executes the node collectors and gets the KubeKit logs from
+// every node
+func (k *Kluster) collectNodes(b *bundle) {
+	platform := k.Platform()
+	if platform == "eks" || platform == "aks" {
+		b.fail("nodes", "", fmt.Errorf("the %s nodes are not accessible through SSH", platform))
+		return
+	}
+	if state, ok := k.State[platform]; !ok || state == nil || len(state.Nodes) == 0 {
+		b.fail("nodes", "", fmt.Errorf("the cluster has no nodes, it may not be provisioned"))
+		return
+	}
+
+	c, err := k.newCommandFor(nil, nil)
+	if err != nil {
+		b.fail("nodes", "", err)
+		return
+	}
+	defer c.Close()
+
+	for _, collector := range nodeCollectors {
+		k.ui.Log.Debugf("collecting %s from the nodes of cluster %q", collector.name, k.Name)
+		result, _ := c.Exec(collector.command, "", true)
+		addNodeResults(b, collector.name, collector.file, c.Hosts, result)
+	}
+
+	k.collectNodeLogs(c, b)
+}
+
+// addNodeResults adds to the bundle the output of the command executed on
+// every host, saved to the given file in the host directory
+func addNodeResults(b *bundle, name, file string, hosts configurator.Hosts, result *ssh.CommandResult) {
+	for _, host := range hosts {
+		node, hostFile := host.PublicIP, path.Join(nodeDir(host), file)
+		hostResult, ok := result.Hosts.Load(host.PublicIP)
+		switch {
+		case !ok:
+			b.fail(name, node, fmt.Errorf("the command was not executed"))
+		case len(hostResult.Error) != 0:
+			b.fail(name, node, fmt.Errorf("%s", hostResult.Error))
+		case hostResult.ExitStatus != 0:
+			b.add(name, node, hostFile, []byte(hostResult.Stdout+hostResult.Stderr), fmt.Errorf("exit status %d", hostResult.ExitStatus))
+		default:
+			b.add(name, node, hostFile, []byte(hostResult.Stdout), nil)
+		}
+	}
+}
+
+// nodeDir returns the directory, in the bundle, with the files collected from
+// the host. The role name is the node pool name, shared by all the nodes of
+// the pool, so the node is identified by its IP address
+func nodeDir(host configurator.Host) string {
+	return path.Join("nodes", host.RoleName, host.PublicIP)
+}
+
+// collectNodeLogs archives the KubeKit logs on every node and copies the
+// archives to extract the logs to the bundle
+func (k *Kluster) collectNodeLogs(c *configurator.Command, b *bundle) {
+	const name = "kubekit logs"
+
+	k.ui.Log.Debugf("collecting %s from the nodes of cluster %q", name, k.Name)
+	archiveCmd := fmt.Sprintf("sh -c 'tar czf %[1]s --ignore-failed-read /var/log/kubekit* && chmod 0644 %[1]s'", collectLogsFile)
+	result, _ := c.Exec(archiveCmd, "", true)
+
+	dir, err := ioutil.TempDir("", "kubekit-collect")
+	if err != nil {
+		b.fail(name, "", err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	errCopy := c.CopyFrom(":"+collectLogsFile, dir, true, false, false, "", "", "0600")
+	c.Exec("rm -f "+collectLogsFile, "", true)
+
+	for _, host := range c.Hosts {
+		if hostResult, ok := result.Hosts.Load(host.PublicIP); !ok || hostResult.ExitStatus != 0 {
+			msg := "the logs were not archived"
+			if ok {
+				msg = fmt.Sprintf("%s. %s%s", msg, hostResult.Error, hostResult.Stderr)
+			}
+			b.fail(name, host.PublicIP, fmt.Errorf("%s", strings.TrimSpace(msg)))
+			continue
+		}
+
+		data, err := ioutil.ReadFile(filepath.Join(dir, host.PublicIP, path.Base(collectLogsFile)))
+		if err != nil {
+			if errCopy != nil {
+				err = errCopy
+			}
+			b.fail(name, host.PublicIP, err)
+			continue
+		}
+
+		files, err := readTarGz(data)
+		if err != nil {
+			b.fail(name, host.PublicIP, err)
+			continue
+		}
+		for filename, content := range files {
+			file := path.Join(nodeDir(host), "logs", strings.TrimPrefix(filename, "var/log/"))
+			b.add(name, host.PublicIP, file, content, nil)
+		}
+	}
+}
+
+// collectKubernetes dumps the Kubernetes objects of all the namespaces. The
+// data of the secrets is redacted
+func (k *Kluster) collectKubernetes(b *bundle) {
+	client, err := k.KubeClient()
+	if err != nil {
+		b.fail("kubernetes", "", err)
+		return
+	}
+
+	lists := map[string]func() (interface{}, error){
+		"nodes":                  func() (interface{}, error) { return client.ListNodes() },
+		"namespaces":             func() (interface{}, error) { return client.ListNamespaces() },
+		"pods":                   func() (interface{}, error) { return client.ListPods("") },
+		"deployments":            func() (interface{}, error) { return client.ListDeployments("") },
+		"daemonsets":             func() (interface{}, error) { return client.ListDaemonSets("") },
+		"statefulsets":           func() (interface{}, error) { return client.ListStatefulSets("") },
+		"replicasets":            func() (interface{}, error) { return client.ListReplicaSets("") },
+		"jobs":                   func() (interface{}, error) { return client.ListJobs("") },
+		"services":               func() (interface{}, error) { return client.ListServices("") },
+		"endpoints":              func() (interface{}, error) { return client.ListEndpoints("") },
+		"configmaps":             func() (interface{}, error) { return client.ListConfigMaps("") },
+		"serviceaccounts":        func() (interface{}, error) { return client.ListServiceAccounts("") },
+		"persistentvolumes":      func() (interface{}, error) { return client.ListPersistentVolumes() },
+		"persistentvolumeclaims": func() (interface{}, error) { return client.ListPersistentVolumeClaims("") },
+		"events":                 func() (interface{}, error) { return client.ListEvents("") },
+		"secrets": func() (interface{}, error) {
+			secrets, err := client.ListSecrets("")
+			if err != nil {
+				return nil, err
+			}
+			redactSecrets(secrets)
+			return secrets, nil
+		},
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(len(lists))
+	for kind, list := range lists {
+		go func(kind string, list func() (interface{}, error)) {
+			defer wg.Done()
+			name := "kubernetes " + kind
+			objects, err := list()
+			if err != nil {
+				b.fail(name, "", err)
+				return
+			}
+			data, err := json.MarshalIndent(objects, "", "  ")
+			if err != nil {
+				b.fail(name, "", err)
+				return
+			}
+			b.add(name, "", path.Join("kubernetes", kind+".json"), data, nil)
+		}(kind, list)
+	}
+	wg.Wait()
+}
+
+// redactSecrets replaces the data of the secrets, the last applied
+// configuration is removed as it may contain the data too
+func redactSecrets(secrets *corev1.SecretList) {
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		for key := range secret.Data {
+			secret.Data[key] = []byte(Redacted)
+		}
+		for key := range secret.StringData {
+			secret.StringData[key] = Redacted
+		}
+		delete(secret.Annotations, corev1.LastAppliedConfigAnnotation)
+	}
+}
+
+var (
+	// values to encrypt, ENC(), or encrypted, DEC(), by KubeKit
+	cryptoValueRe = regexp.MustCompile(`\b(ENC|DEC)\([^)]*\)`)
+	privateKeyRe  = regexp.MustCompile(`(?s)-----BEGIN ([A-Z ]*)PRIVATE KEY-----.*?-----END [A-Z ]*PRIVATE KEY-----`)
+	// keys with a secret value in YAML, JSON, INI files or command flags
+	secretValueRe = regexp.MustCompile(`(?i)(["']?[\w.-]*(password|passwd|secret|token|private_key|access_key)[\w.-]*["']?\s*[:=]\s*)("[^"]*"|'[^']*'|[^\s,;{}\[\]]+)`)
+)
+
+// redact replaces the secrets in the data: the KubeKit ENC() and DEC() values,
+// the private keys and the values of the keys named like a password, secret,
+// token or access key
+func redact(data []byte) []byte {
+	data = cryptoValueRe.ReplaceAll(data, []byte("${1}("+Redacted+")"))
+	data = privateKeyRe.ReplaceAll(data, []byte("-----BEGIN ${1}PRIVATE KEY-----\n"+Redacted+"\n-----END ${1}PRIVATE KEY-----"))
+	return secretValueRe.ReplaceAllFunc(data, func(match []byte) []byte {
+		m := secretValueRe.FindSubmatch(match)
+		value := m[3]
+		if bytes.Contains(value, []byte(Redacted)) {
+			return match
+		}
+		if len(value) > 1 && (value[0] == '"' || value[0] == '\'') {
+			return []byte(fmt.Sprintf("%s%c%s%c", m[1], value[0], Redacted, value[0]))
+		}
+		return []byte(fmt.Sprintf("%s%q", m[1], Redacted))
+	})
+}
+
+// writeBundle writes the manifest and the files, sorted by name, to a gzip
+// compressed tar archive
+func writeBundle(filename string, manifest *CollectManifest, files map[string][]byte) error {
+	f, err := os.OpenFile(filename, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	gw := gzip.NewWriter(f)
+	tw := tar.NewWriter(gw)
+
+	manifestData, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeTarFile(tw, CollectManifestFilename, manifestData, 0644, manifest.CreatedAt); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := writeTarFile(tw, name, files[name], 0644, manifest.CreatedAt); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+// readTarGz returns the content of the regular files in a gzip compressed tar
+// archive
+func readTarGz(data []byte) (map[string][]byte, error) {
+	gr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer gr.Close()
+	tr := tar.NewReader(gr)
+
+	files := make(map[string][]byte)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return files, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		content, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+		files[strings.TrimPrefix(header.Name, "/")] = content
+	}
+}
@@ -10,21 +10,21 @@ import (
 	"github.com/liferaft/kubekit/pkg/configurator/ssh"
 )
 
-// HostsFilterBy returns the cluster hosts or nodes in the given pools or
-// matching the given node name patterns
+// HostsFilterBy returns the cluster hosts matching the given node IPs or DNS
+// names plus the hosts in the given pools. If there is no node or pool, all
+// the cluster hosts are returned
 func (k *Kluster) HostsFilterBy(nodes []string, pools []string) configurator.Hosts {
 	platform := k.Platform()
 
 	allHosts := k.State[platform].Nodes
-	var hosts configurator.Hosts
-	if len(nodes) != 0 {
-		hosts = allHosts.FilterByNode(nodes...)
-	} else if len(pools) != 0 {
-		hosts = allHosts.FilterByRole(pools...)
-	} else {
-		hosts = allHosts
+	if len(nodes) == 0 && len(pools) == 0 {
+		return allHosts
 	}
 
+	hosts := allHosts.FilterByNode(nodes...)
+	inPools := allHosts.FilterByPool(pools...)
+	hosts = append(hosts, inPools.Difference(hosts)...)
+
 	return hosts
 }
 
@@ -33,7 +33,20 @@ func (k *Kluster) newCommandFor(nodes []string, pools []string) (*configurator.C
 	hosts := k.HostsFilterBy(nodes, pools)
 	platformConfig := k.provisioner[platform].Config()
 
-	return configurator.NewCommand(hosts, platformConfig, k.ui)
+	c, err := configurator.NewCommand(hosts, platformConfig, k.KnownHosts(), k.ui)
+	if err != nil {
+		return nil, err
+	}
+	c.Parallel = k.parallel
+
+	return c, nil
+}
+
+// SetParallel sets the maximum number of nodes to connect to at the same time
+// to execute commands, copy files or configure them. If zero, all the nodes
+// are used at once
+func (k *Kluster) SetParallel(parallel int) {
+	k.parallel = parallel
 }
 
 // CopyFile is to copy files to/form cluster nodes
@@ -42,6 +55,7 @@ func (k *Kluster) CopyFile(from, to string, nodes []string, pools []string, forc
 	if err != nil {
 		return err
 	}
+	defer c.Close()
 
 	return c.Copy(from, to, forceFiles, backupFiles, sudoFiles, owner, group, mode)
 }
@@ -53,6 +67,7 @@ func (k *Kluster) Exec(command, script string, nodes []string, pools []string, s
 	if err != nil {
 		return nil, err
 	}
+	defer c.Close()
 
 	return c.Exec(command, script, sudoExec)
 }
@@ -74,20 +89,11 @@ func (k *Kluster) StartShellTo(nodeName string, in io.Reader, out, e io.Writer)
 	}
 	json.Unmarshal(pConfigB, &platformConfig)
 
-	username, ok := platformConfig["username"]
-	if !ok {
-		return fmt.Errorf("username not found in %q platform configuration", platform)
-	}
-	privKey, err := configurator.GetPrivateKey(platformConfig)
+	settings, err := configurator.NewSSHSettings(platformConfig, k.KnownHosts())
 	if err != nil {
 		return err
 	}
-	var password string
-	if p, ok := platformConfig["password"]; ok {
-		password = p.(string)
-	}
-
-	if err := node.Config(node.RoleName, username.(string), privKey, password); err != nil {
+	if err := node.Config(node.RoleName, settings); err != nil {
 		return err
 	}
 
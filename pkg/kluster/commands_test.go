@@ -0,0 +1,49 @@
+package kluster
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/liferaft/kubekit/pkg/configurator"
+)
+
+func TestKluster_HostsFilterBy(t *testing.T) {
+	k := &Kluster{
+		Platforms: map[string]interface{}{"raw": nil},
+		State: map[string]*State{
+			"raw": {
+				Nodes: configurator.Hosts{
+					{PublicIP: "10.0.0.1", PublicDNS: "master-1.example.com", RoleName: "master"},
+					{PublicIP: "10.0.0.2", PublicDNS: "worker-1.example.com", RoleName: "worker"},
+					{PublicIP: "10.0.0.3", PublicDNS: "worker-2.example.com", RoleName: "worker"},
+					{PublicIP: "10.0.0.4", PublicDNS: "gpu-1.example.com", RoleName: "worker", Pool: "gpu"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name  string
+		nodes []string
+		pools []string
+		want  []string
+	}{
+		{"all", nil, nil, []string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4"}},
+		{"nodes", []string{"10.0.0.2", "master-1.example.com"}, nil, []string{"10.0.0.1", "10.0.0.2"}},
+		{"role as pool", nil, []string{"worker"}, []string{"10.0.0.2", "10.0.0.3"}},
+		{"pool", nil, []string{"gpu"}, []string{"10.0.0.4"}},
+		{"nodes and pools", []string{"10.0.0.1", "10.0.0.4"}, []string{"gpu"}, []string{"10.0.0.1", "10.0.0.4"}},
+		{"not found", []string{"10.0.0.9"}, []string{"infra"}, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []string{}
+			for _, h := range k.HostsFilterBy(tt.nodes, tt.pools) {
+				got = append(got, h.PublicIP)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Kluster.HostsFilterBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -55,7 +55,7 @@ version.
 var (
 	// Version is the cluster configuration version this KubeKit creates. Greater
 	// versions are not supported.
-	Version = "1.1"
+	Version = "1.2"
 
 	// MinVersion is the cluster configuration file minimum version
 	// accepted by this version of KubeKit. If a cluster config file with a lower
@@ -85,10 +85,14 @@ type Kluster struct {
 	State        map[string]*State                  `json:"state" yaml:"state" mapstructure:"state"`                        // State of the cluster for each platform
 	Config       *configurator.Config               `json:"config,omitempty" yaml:"config,omitempty" mapstructure:"config"` // Kubernetes configuration, no matter what platform
 	Resources    []string                           `json:"resources" yaml:"resources" mapstructure:"resources"`
+	Backend      *BackendConfig                     `json:"backend,omitempty" yaml:"backend,omitempty" mapstructure:"backend"` // Backend to store the Terraform state, by default is the local cluster directory
 	path         string                             // Path is where the cluster configuration file is
 	provisioner  map[string]provisioner.Provisioner // List of provisioners. It's a platform that can be provisioned
 	certificates tls.KeyPairs                       // List of TLS key pairs
+	stateBackend StateBackend                       // Backend to store the Terraform state, created from Backend
 	ui           *ui.UI                             // UI to print out to console
+	parallel     int                                // Maximum number of nodes to connect to at the same time, zero is all the nodes
+	migratedCred map[string]string                  // Credentials moved out of the configuration by a migration, saved with the configuration
 }
 
 // New creates a new Kluster or load it if the file already exists
@@ -148,11 +152,27 @@ func New(name, platformName, path, format string, parentUI *ui.UI, envConfig map
 		return &cluster, nil
 	}
 
-	cluster.Config, err = configurator.DefaultConfig(envConfig)
+	cluster.Config, err = newConfig(envConfig)
 
 	return &cluster, err
 }
 
+// newConfig returns the default configuration with the given parameters. The
+// `kube_service_ip` is the first IP address of the `kube_services_cidr`, if
+// not set
+func newConfig(envConfig map[string]string) (*configurator.Config, error) {
+	config, err := configurator.DefaultConfig(envConfig)
+	if err != nil {
+		return nil, err
+	}
+	if len(config.KubeServiceIP) == 0 && len(config.KubeServicesCidr) != 0 {
+		if config.KubeServiceIP, err = KubernetesServiceIP(config.KubeServicesCidr); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
 // Update updates the cluster with the given configuration
 func (k *Kluster) Update(envConfig map[string]string) error {
 	platformName := k.Platform()
@@ -215,7 +235,7 @@ func NewTemplate(name string, platforms []string, path, format string, parentUI
 	}
 
 	var err error
-	cluster.Config, err = configurator.DefaultConfig(envConfig)
+	cluster.Config, err = newConfig(envConfig)
 	cluster.Resources = resources.DefaultResourcesFor()
 
 	return &cluster, err
@@ -469,17 +489,7 @@ func LoadSummary(path string) (*Kluster, error) {
 func (k *Kluster) LoadSummary() error {
 	var err error
 
-	if _, err = os.Stat(k.path); os.IsNotExist(err) {
-		return fmt.Errorf("not found Kluster config file %s", k.path)
-	}
-
-	lock, err := lockFile(k.path)
-	if err != nil {
-		return err
-	}
-	defer lock.Unlock()
-
-	b, err := ioutil.ReadFile(k.path)
+	b, err := k.readFile()
 	if err != nil {
 		return err
 	}
@@ -521,6 +531,38 @@ func (k *Kluster) Load() error {
 		return fmt.Errorf("the cluster version %s is greater than the cluster version supported by this KubeKit (%s)", k.Version, Version)
 	}
 
+	// Migrate the configuration of previous versions. The configuration file and
+	// the credentials found in it are updated the next time the cluster is saved
+	// or with 'kubekit migrate'
+	credentials := map[string]string{}
+	if ver.LT(SemVersion) {
+		from := k.Version
+		data, err := k.readFile()
+		if err != nil {
+			return err
+		}
+		if credentials, _, err = k.migrate(data); err != nil {
+			return err
+		}
+		if k.ui != nil {
+			k.ui.Log.Debugf("cluster configuration migrated from version %s to %s", from, k.Version)
+		}
+	}
+	// The obsolete vSphere credentials may be in a configuration of the current
+	// version, added after it was migrated
+	if vsphereConfig, ok := k.Platforms["vsphere"].(map[interface{}]interface{}); ok {
+		platforms := map[interface{}]interface{}{"vsphere": vsphereConfig}
+		if err := migrateVsphereCredentials(map[interface{}]interface{}{"platforms": platforms}, credentials); err != nil {
+			return err
+		}
+	}
+	if len(credentials) != 0 {
+		k.migratedCred = credentials
+		if k.ui != nil {
+			k.ui.Log.Warnf("the cluster configuration has credentials, they will be moved to the credentials file the next time the cluster is saved or with 'kubekit migrate'")
+		}
+	}
+
 	k.provisioner = make(map[string]provisioner.Provisioner, 1)
 	name := k.Platform()
 	config := k.Platforms[name]
@@ -549,6 +591,19 @@ func (k *Kluster) loadCredentials() (CredentialHandler, error) {
 		return nil, err
 	}
 
+	// the credentials moved out of the configuration are not saved yet
+	if len(k.migratedCred) != 0 {
+		current := credentials.asMap()
+		for key, value := range k.migratedCred {
+			if len(current[key]) == 0 {
+				current[key] = value
+			}
+		}
+		if err := credentials.AssignFromMap(current); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := credentials.Getenv(true); err != nil {
 		return nil, err
 	}
@@ -621,10 +676,6 @@ func (k *Kluster) Save() error {
 		// os.MkdirAll(dir, 0755)
 	}
 
-	format := k.format()
-	var data []byte
-	var err error
-
 	// Get platform configurations
 
 	// Update configuration
@@ -656,28 +707,58 @@ func (k *Kluster) Save() error {
 
 	// k.Platforms = pConfig
 
-	// Do not use String() because:
-	// (1) returns string and []byte is needed, and
-	// (2) pretty print (pp=true) is needed with JSON format
-	switch format {
+	data, err := k.data()
+	if err != nil {
+		return err
+	}
+
+	// the configuration is saved without the credentials moved out of it, so
+	// they are saved in the credentials file first
+	if err := k.saveMigratedCredentials(k.migratedCred); err != nil {
+		return err
+	}
+	k.migratedCred = nil
+
+	lock, err := lockFile(k.path)
+	if err != nil {
+		return err
+	}
+	defer lock.Unlock()
+
+	k.ui.Log.Debugf("updating cluster configuration file %s", k.path)
+	return ioutil.WriteFile(k.path, data, 0644)
+}
+
+// data returns the content of the cluster configuration file. Do not use
+// String() because:
+// (1) returns string and []byte is needed, and
+// (2) pretty print (pp=true) is needed with JSON format
+func (k *Kluster) data() ([]byte, error) {
+	switch format := k.format(); format {
 	case "yaml":
-		data, err = k.YAML()
+		return k.YAML()
 	case "json":
-		data, err = k.JSON(true)
+		return k.JSON(true)
 	case "toml":
-		data, err = k.TOML()
+		return k.TOML()
 	default:
-		err = fmt.Errorf("can't stringify the Kluster, unknown format %q", format)
+		return nil, fmt.Errorf("can't stringify the Kluster, unknown format %q", format)
+	}
+}
+
+// readFile returns the content of the cluster configuration file
+func (k *Kluster) readFile() ([]byte, error) {
+	if _, err := os.Stat(k.path); os.IsNotExist(err) {
+		return nil, fmt.Errorf("not found Kluster config file %s", k.path)
 	}
 
 	lock, err := lockFile(k.path)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer lock.Unlock()
 
-	k.ui.Log.Debugf("updating cluster configuration file %s", k.path)
-	return ioutil.WriteFile(k.path, data, 0644)
+	return ioutil.ReadFile(k.path)
 }
 
 // UpdateState creates a new State structure from the given provisioner TF state
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/liferaft/kubekit/pkg/configurator"
+	"github.com/liferaft/kubekit/pkg/manifest"
 )
 
 // Configure configures the cluster to have Kubernetes up and running. It uses
@@ -12,6 +13,7 @@ func (k *Kluster) Configure() error {
 	platformName := k.Platform()
 	logPrefix := fmt.Sprintf("KubeKit [ %s@%s ]", k.Name, platformName)
 	k.ui.SetLogPrefix(logPrefix)
+	k.ui.SetCluster(k.Name)
 
 	k.ui.Log.Debugf("starting the configuration of cluster %q on %s", k.Name, platformName)
 
@@ -22,12 +24,61 @@ func (k *Kluster) Configure() error {
 	if err != nil {
 		return err
 	}
+	defer conf.Close()
+	conf.SetParallel(k.parallel)
 
 	if err := conf.Configure(); err != nil {
 		k.State[platformName].Status = FailedConfigurationStatus.String()
 		return err
 	}
 
+	k.State[platformName].Status = RunningStatus.String()
+	k.State[platformName].Release = manifest.Version
+
+	return nil
+}
+
+// ConfigureSelection configures Kubernetes only on the cluster nodes matching
+// the given IPs or DNS names or in the given node pools. The cluster-wide
+// Kubernetes resources are applied only if withResources is true
+func (k *Kluster) ConfigureSelection(nodes, pools []string, withResources bool) error {
+	platformName := k.Platform()
+	logPrefix := fmt.Sprintf("KubeKit [ %s@%s ]", k.Name, platformName)
+	k.ui.SetLogPrefix(logPrefix)
+	k.ui.SetCluster(k.Name)
+
+	hosts := k.HostsFilterBy(nodes, pools)
+	if len(hosts) == 0 {
+		return fmt.Errorf("there are no nodes %v or nodes in the pools %v in the cluster %q", nodes, pools, k.Name)
+	}
+	addresses := make([]string, 0, len(hosts))
+	for _, h := range hosts {
+		addresses = append(addresses, h.PublicIP)
+	}
+
+	k.ui.Log.Debugf("starting the configuration of nodes %v of cluster %q on %s", addresses, k.Name, platformName)
+
+	pConf := k.provisioner[platformName].Config()
+	clusterDir := k.Dir()
+
+	conf, err := configurator.New(k.Name, platformName, k.State[platformName].Address, k.State[platformName].Port, k.State[platformName].Nodes, k.State[platformName].Data, pConf, k.Config, k.Resources, clusterDir, k.ui)
+	if err != nil {
+		return err
+	}
+	defer conf.Close()
+	conf.SetParallel(k.parallel)
+
+	if err := conf.ConfigureNodes(addresses...); err != nil {
+		k.State[platformName].Status = FailedConfigurationStatus.String()
+		return err
+	}
+
+	if withResources {
+		if err := conf.ApplyResources(false); err != nil {
+			return err
+		}
+	}
+
 	k.State[platformName].Status = RunningStatus.String()
 
 	return nil
@@ -40,6 +91,7 @@ func (k *Kluster) ApplyClientCertificates(restartServices bool) error {
 	platformName := k.Platform()
 	logPrefix := fmt.Sprintf("KubeKit [ %s@%s ]", k.Name, platformName)
 	k.ui.SetLogPrefix(logPrefix)
+	k.ui.SetCluster(k.Name)
 
 	k.ui.Log.Debugf("starting the configuration of cluster %q on %s", k.Name, platformName)
 
@@ -50,6 +102,8 @@ func (k *Kluster) ApplyClientCertificates(restartServices bool) error {
 	if err != nil {
 		return err
 	}
+	defer conf.Close()
+	conf.SetParallel(k.parallel)
 
 	conf.UploadCerts()
 
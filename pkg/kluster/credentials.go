@@ -4,7 +4,6 @@ import (
 	"bufio"
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"os"
 	"reflect"
 	"strings"
@@ -58,6 +57,7 @@ type CredentialHandler interface {
 	asMap() map[string]string
 	Empty() bool
 	Complete() bool
+	Validate() error
 }
 
 // NewCredentials creates a new credentials [handler] based on the given platform
@@ -199,27 +199,33 @@ func (c *PlatformCredentials) Ask() error {
 
 // Read reads the platform credentials from the cluster credentials file
 func (c *PlatformCredentials) Read() error {
-	credentialsBytes, err := read(c.path)
+	credentialsBytes, err := readAtRest(c.path)
 	if err != nil || credentialsBytes == nil {
 		return err
 	}
 	return yaml.Unmarshal(credentialsBytes, &c)
 }
 
-func read(path string) ([]byte, error) {
-	if _, err := os.Stat(path); os.IsNotExist(err) {
-		return nil, nil
-	}
-	return ioutil.ReadFile(path)
-}
-
 // Write writes the platform credentials to the cluster credentials file
 func (c *PlatformCredentials) Write() error {
 	credentialsBytes, err := yaml.Marshal(c)
 	if err != nil {
 		return err
 	}
-	return ioutil.WriteFile(c.path, credentialsBytes, 0600)
+	return writeAtRest(c.path, credentialsBytes, 0600)
+}
+
+// Validate returns an error if a credential parameter is missing. The
+// platforms without credentials, such as raw, stacki and vra, are always valid
+func (c *PlatformCredentials) Validate() error {
+	switch c.Platform {
+	case "raw", "stacki", "vra":
+		return nil
+	}
+	if !c.Complete() {
+		return fmt.Errorf("the server, username and password are required")
+	}
+	return nil
 }
 
 func printCredentials(header, row string) {
@@ -182,7 +182,7 @@ func (c *AwsCredentials) Ask() error {
 
 // Read reads the AWS credentials from the cluster credentials file
 func (c *AwsCredentials) Read() error {
-	credentialsBytes, err := read(c.path)
+	credentialsBytes, err := readAtRest(c.path)
 	if err != nil || credentialsBytes == nil {
 		return err
 	}
@@ -195,7 +195,7 @@ func (c *AwsCredentials) Write() error {
 	if err != nil {
 		return err
 	}
-	return ioutil.WriteFile(c.path, credentialsBytes, 0600)
+	return writeAtRest(c.path, credentialsBytes, 0600)
 }
 
 // LoadSharedCredentialsFromProfile loads the AWS credentials from the AWS shared credentials file for the given profile
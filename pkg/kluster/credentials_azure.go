@@ -2,7 +2,6 @@ package kluster
 
 import (
 	"fmt"
-	"io/ioutil"
 	"os"
 	"strings"
 
@@ -159,7 +158,7 @@ func (c *AzureCredentials) Ask() error {
 
 // Read reads the Azure credentials from the cluster credentials file
 func (c *AzureCredentials) Read() error {
-	credentialsBytes, err := read(c.path)
+	credentialsBytes, err := readAtRest(c.path)
 	if err != nil || credentialsBytes == nil {
 		return err
 	}
@@ -172,5 +171,13 @@ func (c *AzureCredentials) Write() error {
 	if err != nil {
 		return err
 	}
-	return ioutil.WriteFile(c.path, credentialsBytes, 0600)
+	return writeAtRest(c.path, credentialsBytes, 0600)
+}
+
+// Validate returns an error if a credential parameter is missing
+func (c *AzureCredentials) Validate() error {
+	if !c.Complete() {
+		return fmt.Errorf("the subscription ID, tenant ID, client ID and client secret are required")
+	}
+	return nil
 }
@@ -0,0 +1,393 @@
+package kluster
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/kraken/terraformer"
+	"github.com/liferaft/kubekit/pkg/configurator"
+	"github.com/liferaft/kubekit/pkg/provisioner"
+	"github.com/liferaft/kubekit/pkg/provisioner/state"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Drift sources, where the differences were found
+const (
+	DriftSourceState      = "state"
+	DriftSourceNodes      = "nodes"
+	DriftSourceKubernetes = "kubernetes"
+)
+
+// DriftItem is a difference found between the expected and the actual cluster
+type DriftItem struct {
+	Source   string `json:"source" yaml:"source"`
+	Resource string `json:"resource" yaml:"resource"`
+	Field    string `json:"field,omitempty" yaml:"field,omitempty"`
+	Expected string `json:"expected,omitempty" yaml:"expected,omitempty"`
+	Actual   string `json:"actual,omitempty" yaml:"actual,omitempty"`
+}
+
+// Drift is the list of differences found between the cluster configuration,
+// the Terraform state and the real cluster
+type Drift struct {
+	Cluster  string      `json:"cluster" yaml:"cluster"`
+	Platform string      `json:"platform" yaml:"platform"`
+	Items    []DriftItem `json:"drift" yaml:"drift"`
+}
+
+// Drifted returns true if there are differences in the cluster
+func (d *Drift) Drifted() bool {
+	return len(d.Items) != 0
+}
+
+func (d *Drift) add(source, resource, field, expected, actual string) {
+	d.Items = append(d.Items, DriftItem{
+		Source:   source,
+		Resource: resource,
+		Field:    field,
+		Expected: expected,
+		Actual:   actual,
+	})
+}
+
+// String returns the differences in a human readable format
+func (d *Drift) String() string {
+	if !d.Drifted() {
+		return fmt.Sprintf("No drift found in cluster %q\n", d.Cluster)
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "Drift found in cluster %q:\n\n", d.Cluster)
+	w := tabwriter.NewWriter(&b, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "SOURCE\tRESOURCE\tFIELD\tEXPECTED\tACTUAL")
+	for _, i := range d.Items {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", i.Source, i.Resource, valueOrNone(i.Field), valueOrNone(i.Expected), valueOrNone(i.Actual))
+	}
+	w.Flush()
+
+	return b.String()
+}
+
+// Diff finds the differences, or drift, between what KubeKit knows about the
+// cluster and the real cluster. The saved Terraform state is compared with the
+// refreshed state, the refreshed nodes with the nodes in the cluster state and
+// the Kubernetes nodes with the expected nodes, pools, labels and taints
+func (k *Kluster) Diff() (*Drift, error) {
+	platformName := k.Platform()
+	logPrefix := fmt.Sprintf("KubeKit [ %s@%s ]", k.Name, platformName)
+	k.ui.SetLogPrefix(logPrefix)
+
+	if err := k.LoadState(); err != nil {
+		return nil, err
+	}
+
+	drift := &Drift{
+		Cluster:  k.Name,
+		Platform: platformName,
+	}
+
+	expectedHosts := k.State[platformName].Nodes
+
+	p := k.provisioner[platformName]
+	if r, ok := p.(provisioner.Refresher); ok {
+		var saved map[string]map[string]interface{}
+		if s := p.State(); s != nil {
+			saved = stateResources(s.DeepCopy())
+		}
+		k.ui.Log.Infof("refreshing the state of cluster %q", k.Name)
+		if err := r.Refresh(); err != nil {
+			return nil, fmt.Errorf("failed to refresh the state of cluster %q. %s", k.Name, err)
+		}
+		var refreshed map[string]map[string]interface{}
+		if s := p.State(); s != nil {
+			refreshed = stateResources(s)
+		}
+
+		sensitive, err := r.SensitiveAttributes()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the sensitive attributes of cluster %q. %s", k.Name, err)
+		}
+
+		diffStateResources(drift, saved, refreshed, sensitive)
+		diffNodes(drift, expectedHosts, p.Nodes())
+	} else {
+		k.ui.Log.Debugf("the %s platform does not have a state to refresh", platformName)
+	}
+
+	if len(expectedHosts) == 0 {
+		return drift, nil
+	}
+
+	client, err := k.KubeClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the Kubernetes client to get the nodes. %s", err)
+	}
+	nodes, err := client.ListNodes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the Kubernetes nodes. %s", err)
+	}
+
+	pConfig := p.Config()
+	expected := map[string]expectedNode{}
+	for _, h := range expectedHosts {
+		labels, taints, err := configurator.NodeLabelsAndTaints(pConfig, h)
+		if err != nil {
+			return nil, err
+		}
+		expected[hostAddress(h)] = expectedNode{host: h, labels: labels, taints: taints}
+	}
+	diffKubeNodes(drift, expected, nodes.Items)
+
+	return drift, nil
+}
+
+// stateResources returns the attributes of every resource instance in the
+// given Terraform state, indexed by the resource instance address
+func stateResources(s *terraformer.State) map[string]map[string]interface{} {
+	resources := map[string]map[string]interface{}{}
+	for _, module := range s.Modules {
+		for _, rs := range module.Resources {
+			for key, is := range rs.Instances {
+				if is.Current == nil {
+					continue
+				}
+				addr := rs.Addr.Instance(key).Absolute(module.Addr).String()
+				attrs := map[string]interface{}{}
+				if len(is.Current.AttrsJSON) != 0 {
+					json.Unmarshal(is.Current.AttrsJSON, &attrs)
+				} else {
+					for k, v := range is.Current.AttrsFlat {
+						attrs[k] = v
+					}
+				}
+				resources[addr] = attrs
+			}
+		}
+	}
+	return resources
+}
+
+// diffStateResources adds to the drift the resources and attributes that are
+// different in the saved and refreshed Terraform state. The values of the
+// sensitive attributes, indexed by resource, are replaced by SensitiveValue
+func diffStateResources(drift *Drift, saved, refreshed map[string]map[string]interface{}, sensitive map[string]map[string]bool) {
+	for _, addr := range sortedKeys(saved, refreshed) {
+		savedAttrs, inSaved := saved[addr]
+		refreshedAttrs, inRefreshed := refreshed[addr]
+		switch {
+		case !inRefreshed:
+			drift.add(DriftSourceState, addr, "", "exists", "not found")
+		case !inSaved:
+			drift.add(DriftSourceState, addr, "", "not found", "exists")
+		default:
+			for _, attr := range sortedKeys(savedAttrs, refreshedAttrs) {
+				before, after := savedAttrs[attr], refreshedAttrs[attr]
+				if reflect.DeepEqual(before, after) {
+					continue
+				}
+				expected, actual := toString(before), toString(after)
+				if sensitive[addr][attr] {
+					if before != nil {
+						expected = SensitiveValue
+					}
+					if after != nil {
+						actual = SensitiveValue
+					}
+				}
+				drift.add(DriftSourceState, addr, attr, expected, actual)
+			}
+		}
+	}
+}
+
+// diffNodes adds to the drift the differences between the nodes in the cluster
+// state and the nodes in the refreshed Terraform state. The nodes are identified
+// by the private IP, it does not change when a node is stopped and started. The
+// role name is the node pool name, so it's shared by every node of the pool
+func diffNodes(drift *Drift, hosts configurator.Hosts, nodes []*state.Node) {
+	actual := map[string]*state.Node{}
+	for _, n := range nodes {
+		actual[n.PrivateIP] = n
+	}
+	found := map[string]bool{}
+
+	for _, h := range hosts {
+		n, ok := actual[h.PrivateIP]
+		if !ok {
+			drift.add(DriftSourceNodes, h.PrivateIP, "", h.RoleName, "not found")
+			continue
+		}
+		found[h.PrivateIP] = true
+		fields := []struct{ name, expected, actual string }{
+			{"public_ip", h.PublicIP, n.PublicIP},
+			{"public_dns", h.PublicDNS, n.PublicDNS},
+			{"private_dns", h.PrivateDNS, n.PrivateDNS},
+			{"role", h.RoleName, n.RoleName},
+			{"pool", h.Pool, n.Pool},
+		}
+		for _, f := range fields {
+			if f.expected != f.actual {
+				drift.add(DriftSourceNodes, h.PrivateIP, f.name, f.expected, f.actual)
+			}
+		}
+	}
+
+	for _, n := range nodes {
+		if !found[n.PrivateIP] {
+			drift.add(DriftSourceNodes, n.PrivateIP, "", "not found", n.RoleName)
+		}
+	}
+}
+
+// expectedNode is a cluster node with the labels and taints of its node pool
+type expectedNode struct {
+	host   configurator.Host
+	labels []string
+	taints []string
+}
+
+// diffKubeNodes adds to the drift the differences between the expected nodes,
+// indexed by IP address, and the Kubernetes nodes, including the labels and
+// taints of the node pools
+func diffKubeNodes(drift *Drift, expected map[string]expectedNode, nodes []corev1.Node) {
+	matched := map[string]bool{}
+
+	for _, address := range sortedKeys(expected) {
+		e := expected[address]
+		h := e.host
+		node := findKubeNode(nodes, h.PrivateIP, h.PublicIP)
+		if node == nil {
+			drift.add(DriftSourceKubernetes, address, "", "node in Kubernetes", "not found")
+			continue
+		}
+		matched[node.Name] = true
+
+		for _, label := range e.labels {
+			key, value := parseLabel(label)
+			actual, ok := node.Labels[key]
+			if !ok {
+				drift.add(DriftSourceKubernetes, node.Name, "label", label, "not found")
+			} else if actual != value {
+				drift.add(DriftSourceKubernetes, node.Name, "label", label, key+"="+actual)
+			}
+		}
+
+		for _, taint := range e.taints {
+			want := parseTaint(taint)
+			found := false
+			for _, t := range node.Spec.Taints {
+				if t.Key == want.Key && t.Value == want.Value && t.Effect == want.Effect {
+					found = true
+					break
+				}
+			}
+			if !found {
+				drift.add(DriftSourceKubernetes, node.Name, "taint", taint, "not found")
+			}
+		}
+	}
+
+	for _, n := range nodes {
+		if !matched[n.Name] {
+			drift.add(DriftSourceKubernetes, n.Name, "", "not found", "node in Kubernetes")
+		}
+	}
+}
+
+// findKubeNode returns the Kubernetes node with an internal or external IP
+// equal to any of the given IP addresses
+func findKubeNode(nodes []corev1.Node, ips ...string) *corev1.Node {
+	for i, n := range nodes {
+		for _, ip := range ips {
+			if len(ip) == 0 {
+				continue
+			}
+			for _, nodeAddress := range n.Status.Addresses {
+				if nodeAddress.Type != corev1.NodeInternalIP && nodeAddress.Type != corev1.NodeExternalIP {
+					continue
+				}
+				if nodeAddress.Address == ip {
+					return &nodes[i]
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// hostAddress returns the IP address that identifies the host, the private IP
+// or the public IP if it doesn't have a private one
+func hostAddress(h configurator.Host) string {
+	if len(h.PrivateIP) != 0 {
+		return h.PrivateIP
+	}
+	return h.PublicIP
+}
+
+// parseLabel returns the key and value of a Kubelet label in the format
+// `key=value`. The value may be quoted
+func parseLabel(label string) (key, value string) {
+	kv := strings.SplitN(label, "=", 2)
+	key = kv[0]
+	if len(kv) == 2 {
+		value = strings.Trim(kv[1], `"`)
+	}
+	return key, value
+}
+
+// parseTaint returns the Kubernetes taint of a Kubelet taint in the format
+// `key=value:Effect` or `key:Effect`. The value may be quoted
+func parseTaint(taint string) corev1.Taint {
+	t := corev1.Taint{}
+	if i := strings.LastIndex(taint, ":"); i != -1 {
+		t.Effect = corev1.TaintEffect(taint[i+1:])
+		taint = taint[:i]
+	}
+	t.Key, t.Value = parseLabel(taint)
+	return t
+}
+
+func sortedKeys(maps ...interface{}) []string {
+	keys := map[string]struct{}{}
+	for _, m := range maps {
+		v := reflect.ValueOf(m)
+		if v.Kind() != reflect.Map {
+			continue
+		}
+		for _, k := range v.MapKeys() {
+			keys[k.String()] = struct{}{}
+		}
+	}
+	list := make([]string, 0, len(keys))
+	for k := range keys {
+		list = append(list, k)
+	}
+	sort.Strings(list)
+	return list
+}
+
+func toString(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	if s, ok := v.(string); ok {
+		return s
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(b)
+}
+
+func valueOrNone(s string) string {
+	if len(s) == 0 {
+		return emptyValue
+	}
+	return s
+}
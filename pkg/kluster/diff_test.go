@@ -0,0 +1,220 @@
+package kluster
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/liferaft/kubekit/pkg/configurator"
+	"github.com/liferaft/kubekit/pkg/provisioner/state"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_parseTaint(t *testing.T) {
+	tests := []struct {
+		name  string
+		taint string
+		want  corev1.Taint
+	}{
+		{"quoted empty value", `node-role.kubernetes.io/master="":NoSchedule`, corev1.Taint{Key: "node-role.kubernetes.io/master", Effect: corev1.TaintEffectNoSchedule}},
+		{"value", `dedicated=gpu:NoExecute`, corev1.Taint{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoExecute}},
+		{"no value", `dedicated:PreferNoSchedule`, corev1.Taint{Key: "dedicated", Effect: corev1.TaintEffectPreferNoSchedule}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseTaint(tt.taint); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseTaint() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_diffStateResources(t *testing.T) {
+	tests := []struct {
+		name      string
+		saved     map[string]map[string]interface{}
+		refreshed map[string]map[string]interface{}
+		sensitive map[string]map[string]bool
+		want      []DriftItem
+	}{
+		{
+			"no drift",
+			map[string]map[string]interface{}{"aws_instance.master": {"instance_type": "m4.large"}},
+			map[string]map[string]interface{}{"aws_instance.master": {"instance_type": "m4.large"}},
+			nil,
+			nil,
+		},
+		{
+			"attribute changed",
+			map[string]map[string]interface{}{"aws_instance.master": {"instance_type": "m4.large"}},
+			map[string]map[string]interface{}{"aws_instance.master": {"instance_type": "m4.xlarge"}},
+			nil,
+			[]DriftItem{{Source: DriftSourceState, Resource: "aws_instance.master", Field: "instance_type", Expected: "m4.large", Actual: "m4.xlarge"}},
+		},
+		{
+			"resource deleted",
+			map[string]map[string]interface{}{"aws_instance.master": {}},
+			map[string]map[string]interface{}{},
+			nil,
+			[]DriftItem{{Source: DriftSourceState, Resource: "aws_instance.master", Expected: "exists", Actual: "not found"}},
+		},
+		{
+			"sensitive attribute changed",
+			map[string]map[string]interface{}{"aws_db_instance.db": {"password": "S3cr3t", "port": "5432"}},
+			map[string]map[string]interface{}{"aws_db_instance.db": {"password": "n3wS3cr3t", "port": "5432"}},
+			map[string]map[string]bool{"aws_db_instance.db": {"password": true}},
+			[]DriftItem{{Source: DriftSourceState, Resource: "aws_db_instance.db", Field: "password", Expected: SensitiveValue, Actual: SensitiveValue}},
+		},
+		{
+			"sensitive attribute removed",
+			map[string]map[string]interface{}{"aws_db_instance.db": {"password": "S3cr3t"}},
+			map[string]map[string]interface{}{"aws_db_instance.db": {}},
+			map[string]map[string]bool{"aws_db_instance.db": {"password": true}},
+			[]DriftItem{{Source: DriftSourceState, Resource: "aws_db_instance.db", Field: "password", Expected: SensitiveValue}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			drift := &Drift{}
+			diffStateResources(drift, tt.saved, tt.refreshed, tt.sensitive)
+			if !reflect.DeepEqual(drift.Items, tt.want) {
+				t.Errorf("diffStateResources() = %v, want %v", drift.Items, tt.want)
+			}
+		})
+	}
+}
+
+func Test_diffNodes(t *testing.T) {
+	hosts := configurator.Hosts{
+		{PublicIP: "54.1.1.1", PrivateIP: "10.0.0.1", RoleName: "master", Pool: "master"},
+		{PublicIP: "54.1.1.2", PrivateIP: "10.0.0.2", RoleName: "master", Pool: "master"},
+		{PublicIP: "54.1.2.1", PrivateIP: "10.0.1.1", RoleName: "worker", Pool: "worker"},
+		{PublicIP: "54.1.2.2", PrivateIP: "10.0.1.2", RoleName: "worker", Pool: "worker"},
+	}
+	tests := []struct {
+		name  string
+		nodes []*state.Node
+		want  []DriftItem
+	}{
+		{
+			"no drift",
+			[]*state.Node{
+				{PublicIP: "54.1.1.1", PrivateIP: "10.0.0.1", RoleName: "master", Pool: "master"},
+				{PublicIP: "54.1.1.2", PrivateIP: "10.0.0.2", RoleName: "master", Pool: "master"},
+				{PublicIP: "54.1.2.1", PrivateIP: "10.0.1.1", RoleName: "worker", Pool: "worker"},
+				{PublicIP: "54.1.2.2", PrivateIP: "10.0.1.2", RoleName: "worker", Pool: "worker"},
+			},
+			nil,
+		},
+		{
+			"new public IP and removed node",
+			[]*state.Node{
+				{PublicIP: "54.1.1.1", PrivateIP: "10.0.0.1", RoleName: "master", Pool: "master"},
+				{PublicIP: "54.1.1.9", PrivateIP: "10.0.0.2", RoleName: "master", Pool: "master"},
+				{PublicIP: "54.1.2.1", PrivateIP: "10.0.1.1", RoleName: "worker", Pool: "worker"},
+			},
+			[]DriftItem{
+				{Source: DriftSourceNodes, Resource: "10.0.0.2", Field: "public_ip", Expected: "54.1.1.2", Actual: "54.1.1.9"},
+				{Source: DriftSourceNodes, Resource: "10.0.1.2", Expected: "worker", Actual: "not found"},
+			},
+		},
+		{
+			"unknown node",
+			[]*state.Node{
+				{PublicIP: "54.1.1.1", PrivateIP: "10.0.0.1", RoleName: "master", Pool: "master"},
+				{PublicIP: "54.1.1.2", PrivateIP: "10.0.0.2", RoleName: "master", Pool: "master"},
+				{PublicIP: "54.1.2.1", PrivateIP: "10.0.1.1", RoleName: "worker", Pool: "worker"},
+				{PublicIP: "54.1.2.2", PrivateIP: "10.0.1.2", RoleName: "worker", Pool: "worker"},
+				{PublicIP: "54.1.2.3", PrivateIP: "10.0.1.3", RoleName: "worker", Pool: "worker"},
+			},
+			[]DriftItem{{Source: DriftSourceNodes, Resource: "10.0.1.3", Expected: "not found", Actual: "worker"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			drift := &Drift{}
+			diffNodes(drift, hosts, tt.nodes)
+			if !reflect.DeepEqual(drift.Items, tt.want) {
+				t.Errorf("diffNodes() = %v, want %v", drift.Items, tt.want)
+			}
+		})
+	}
+}
+
+func Test_diffKubeNodes(t *testing.T) {
+	masterLabels := []string{`node-role.kubernetes.io/master=""`}
+	masterTaints := []string{`node-role.kubernetes.io/master="":NoSchedule`}
+	expected := map[string]expectedNode{
+		"10.0.0.1": {host: configurator.Host{PrivateIP: "10.0.0.1", RoleName: "master"}, labels: masterLabels, taints: masterTaints},
+		"10.0.0.2": {host: configurator.Host{PrivateIP: "10.0.0.2", RoleName: "master"}, labels: masterLabels, taints: masterTaints},
+		"10.0.1.1": {host: configurator.Host{PrivateIP: "10.0.1.1", PublicIP: "54.1.2.1", RoleName: "worker"}},
+		"10.0.1.2": {host: configurator.Host{PrivateIP: "10.0.1.2", PublicIP: "54.1.2.2", RoleName: "worker"}},
+	}
+	kubeNode := func(name string, addresses []corev1.NodeAddress, labels map[string]string, taints ...corev1.Taint) corev1.Node {
+		return corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+			Spec:       corev1.NodeSpec{Taints: taints},
+			Status:     corev1.NodeStatus{Addresses: addresses},
+		}
+	}
+	internalIP := func(ip string) []corev1.NodeAddress {
+		return []corev1.NodeAddress{{Type: corev1.NodeInternalIP, Address: ip}}
+	}
+	externalIP := func(ip string) []corev1.NodeAddress {
+		return []corev1.NodeAddress{{Type: corev1.NodeExternalIP, Address: ip}}
+	}
+	masterLabel := map[string]string{"node-role.kubernetes.io/master": ""}
+	masterTaint := corev1.Taint{Key: "node-role.kubernetes.io/master", Effect: corev1.TaintEffectNoSchedule}
+
+	tests := []struct {
+		name  string
+		nodes []corev1.Node
+		want  []DriftItem
+	}{
+		{
+			"no drift",
+			[]corev1.Node{
+				kubeNode("ip-10-0-0-1", internalIP("10.0.0.1"), masterLabel, masterTaint),
+				kubeNode("ip-10-0-0-2", internalIP("10.0.0.2"), masterLabel, masterTaint),
+				kubeNode("ip-10-0-1-1", internalIP("10.0.1.1"), nil),
+				kubeNode("worker", externalIP("54.1.2.2"), nil),
+			},
+			nil,
+		},
+		{
+			"missing label and taint",
+			[]corev1.Node{
+				kubeNode("ip-10-0-0-1", internalIP("10.0.0.1"), masterLabel, masterTaint),
+				kubeNode("ip-10-0-0-2", internalIP("10.0.0.2"), map[string]string{}),
+				kubeNode("ip-10-0-1-1", internalIP("10.0.1.1"), nil),
+				kubeNode("ip-10-0-1-2", internalIP("10.0.1.2"), nil),
+			},
+			[]DriftItem{
+				{Source: DriftSourceKubernetes, Resource: "ip-10-0-0-2", Field: "label", Expected: `node-role.kubernetes.io/master=""`, Actual: "not found"},
+				{Source: DriftSourceKubernetes, Resource: "ip-10-0-0-2", Field: "taint", Expected: `node-role.kubernetes.io/master="":NoSchedule`, Actual: "not found"},
+			},
+		},
+		{
+			"missing and unknown nodes",
+			[]corev1.Node{
+				kubeNode("ip-10-0-0-1", internalIP("10.0.0.1"), masterLabel, masterTaint),
+				kubeNode("ip-10-0-0-2", internalIP("10.0.0.2"), masterLabel, masterTaint),
+				kubeNode("ip-10-0-1-1", internalIP("10.0.1.1"), nil),
+				kubeNode("10.0.1.2", internalIP("10.0.1.9"), nil),
+			},
+			[]DriftItem{
+				{Source: DriftSourceKubernetes, Resource: "10.0.1.2", Expected: "node in Kubernetes", Actual: "not found"},
+				{Source: DriftSourceKubernetes, Resource: "10.0.1.2", Expected: "not found", Actual: "node in Kubernetes"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			drift := &Drift{}
+			diffKubeNodes(drift, expected, tt.nodes)
+			if !reflect.DeepEqual(drift.Items, tt.want) {
+				t.Errorf("diffKubeNodes() = %v, want %v", drift.Items, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,248 @@
+package kluster
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/liferaft/kubekit/pkg/crypto"
+)
+
+var (
+	atRest   *crypto.Envelope
+	atRestMu sync.RWMutex
+)
+
+// SetEncryption enables the encryption at rest of the Terraform state and the
+// credentials files with the given envelope. If the envelope is nil, the
+// encryption at rest is disabled
+func SetEncryption(envelope *crypto.Envelope) {
+	atRestMu.Lock()
+	defer atRestMu.Unlock()
+	atRest = envelope
+}
+
+// Encryption returns the envelope used to encrypt the files at rest or nil if
+// the encryption at rest is disabled
+func Encryption() *crypto.Envelope {
+	atRestMu.RLock()
+	defer atRestMu.RUnlock()
+	return atRest
+}
+
+// encryptAtRest encrypts the given data if the encryption at rest is enabled
+func encryptAtRest(data []byte) ([]byte, error) {
+	envelope := Encryption()
+	if envelope == nil {
+		return data, nil
+	}
+	return envelope.Encrypt(data)
+}
+
+// decryptAtRest decrypts the given data if it's encrypted. The data that is not
+// encrypted is returned as is, so the files created before the encryption was
+// enabled can be read
+func decryptAtRest(data []byte) ([]byte, error) {
+	if !crypto.IsEnvelope(data) {
+		return data, nil
+	}
+	envelope := Encryption()
+	if envelope == nil {
+		return nil, fmt.Errorf("the data is encrypted but the encryption at rest is not enabled")
+	}
+	return envelope.Decrypt(data)
+}
+
+// readAtRest reads and decrypts the given file, returns nil if the file does
+// not exists
+func readAtRest(filename string) ([]byte, error) {
+	data, err := ioutil.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if data, err = decryptAtRest(data); err != nil {
+		return nil, fmt.Errorf("failed to decrypt %s. %s", filename, err)
+	}
+	return data, nil
+}
+
+// writeAtRest encrypts and writes the given data to the given file
+func writeAtRest(filename string, data []byte, perm os.FileMode) error {
+	data, err := encryptAtRest(data)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt %s. %s", filename, err)
+	}
+	return ioutil.WriteFile(filename, data, perm)
+}
+
+// sameState returns true if both states are the same. The encrypted states are
+// the same if they are encrypted with the same key, even with different data
+// keys, and have the same content
+func sameState(a, b []byte) bool {
+	if bytes.Equal(a, b) {
+		return true
+	}
+	keyID := crypto.EnvelopeKeyID(a)
+	if len(keyID) == 0 || keyID != crypto.EnvelopeKeyID(b) {
+		return false
+	}
+	plainA, errA := decryptAtRest(a)
+	plainB, errB := decryptAtRest(b)
+	return errA == nil && errB == nil && bytes.Equal(plainA, plainB)
+}
+
+// isAtRestFile returns true if the given file, relative to the cluster
+// directory, is encrypted at rest: the credentials, the Terraform state, the etcd
+// snapshots and the backups of the migrated configuration files
+func isAtRestFile(name string) bool {
+	name = filepath.ToSlash(name)
+	if name == CredentialsFileName {
+		return true
+	}
+	if strings.HasPrefix(name, BackupsDirname+"/") {
+		base := path.Base(name)
+		return strings.HasSuffix(base, ".db") || strings.HasPrefix(base, DefaultConfigFilename+"-v")
+	}
+	return strings.HasPrefix(name, StateDirname+"/") && (strings.HasSuffix(name, ".tfstate") || strings.HasSuffix(name, ".tfstate.bkp"))
+}
+
+// decryptedFile returns the name of the given file decrypted. If the file is
+// encrypted it's decrypted to a temporal file with the same name, removed with
+// the returned function
+func decryptedFile(filename string) (string, func(), error) {
+	noop := func() {}
+
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return "", noop, err
+	}
+	if !crypto.IsEnvelope(data) {
+		return filename, noop, nil
+	}
+	if data, err = decryptAtRest(data); err != nil {
+		return "", noop, fmt.Errorf("failed to decrypt %s. %s", filename, err)
+	}
+
+	dir, err := ioutil.TempDir("", "kubekit-decrypted")
+	if err != nil {
+		return "", noop, err
+	}
+	remove := func() { os.RemoveAll(dir) }
+	plainFile := filepath.Join(dir, filepath.Base(filename))
+	if err := ioutil.WriteFile(plainFile, data, 0600); err != nil {
+		remove()
+		return "", noop, fmt.Errorf("failed to decrypt %s. %s", filename, err)
+	}
+
+	return plainFile, remove, nil
+}
+
+// encryptFile encrypts the given file, if the encryption at rest is enabled
+func encryptFile(filename string) error {
+	if Encryption() == nil {
+		return nil
+	}
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	return writeAtRest(filename, data, 0600)
+}
+
+// Rekey re-encrypts the Terraform state and credentials files of the cluster.
+// The encrypted files are decrypted with the envelope 'from' and encrypted with
+// the envelope 'to', if 'to' is nil the files are decrypted. If the state is in
+// a remote backend, only the current state is re-encrypted, the versions in the
+// history keep the previous key. Returns the re-encrypted files
+func (k *Kluster) Rekey(from, to *crypto.Envelope) ([]string, error) {
+	dir := k.Dir()
+	files := []string{}
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() || !isAtRestFile(name) {
+			return nil
+		}
+
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if data, err = recrypt(data, from, to); err != nil {
+			return fmt.Errorf("failed to re-encrypt %s. %s", name, err)
+		}
+		tmpPath := path + ".rekey"
+		if err := ioutil.WriteFile(tmpPath, data, info.Mode().Perm()); err != nil {
+			return err
+		}
+		if err := os.Rename(tmpPath, path); err != nil {
+			return err
+		}
+		files = append(files, path)
+
+		return nil
+	})
+	if err != nil {
+		return files, fmt.Errorf("failed to re-encrypt the files of cluster %q. %s", k.Name, err)
+	}
+
+	if k.backendType() == LocalBackend {
+		return files, nil
+	}
+
+	unlock, err := k.lockState("rekey")
+	if err != nil {
+		return files, err
+	}
+	defer unlock()
+
+	platform := k.Platform()
+	backend, err := k.StateBackend()
+	if err != nil {
+		return files, err
+	}
+	data, err := backend.Read(platform)
+	if err != nil || data == nil {
+		return files, err
+	}
+	if data, err = recrypt(data, from, to); err != nil {
+		return files, fmt.Errorf("failed to re-encrypt the %s state in the %s state backend. %s", platform, k.backendType(), err)
+	}
+	if err := backend.Write(platform, data); err != nil {
+		return files, err
+	}
+
+	return append(files, fmt.Sprintf("%s state backend: %s", k.backendType(), platform)), nil
+}
+
+// recrypt decrypts the given data with the envelope 'from', if it's encrypted,
+// and encrypts it with the envelope 'to', if it's not nil
+func recrypt(data []byte, from, to *crypto.Envelope) ([]byte, error) {
+	if crypto.IsEnvelope(data) {
+		if from == nil {
+			return nil, fmt.Errorf("the data is encrypted but there is no key to decrypt it")
+		}
+		var err error
+		if data, err = from.Decrypt(data); err != nil {
+			return nil, err
+		}
+	}
+	if to == nil {
+		return data, nil
+	}
+	return to.Encrypt(data)
+}
@@ -0,0 +1,180 @@
+package kluster
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/liferaft/kubekit/pkg/crypto"
+)
+
+func newTestEnvelope(t *testing.T, dir, name string) *crypto.Envelope {
+	keyFile := filepath.Join(dir, name)
+	if err := crypto.GenerateKeyFile(keyFile); err != nil {
+		t.Fatal(err)
+	}
+	return crypto.NewEnvelope(crypto.NewFileKeyProvider(keyFile))
+}
+
+func TestCredentials_encryptedAtRest(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kubekit-encryption")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	SetEncryption(newTestEnvelope(t, dir, "kubekit.key"))
+	defer SetEncryption(nil)
+
+	path := filepath.Join(dir, CredentialsFileName)
+	cred := NewPlatformCredentials("kkdemo", "vsphere", path)
+	cred.SetParameters("vcenter.example.com", "admin", "S3cr3t")
+	if err := cred.Write(); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !crypto.IsEnvelope(data) {
+		t.Errorf("the credentials file is not encrypted: %s", data)
+	}
+
+	got := NewPlatformCredentials("kkdemo", "vsphere", path)
+	if err := got.Read(); err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	if got.Password != "S3cr3t" {
+		t.Errorf("Read() password = %q, want %q", got.Password, "S3cr3t")
+	}
+
+	SetEncryption(nil)
+	if err := got.Read(); err == nil {
+		t.Errorf("Read() expected an error, the encryption at rest is disabled")
+	}
+}
+
+func Test_sameState(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kubekit-encryption")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	current := newTestEnvelope(t, dir, "kubekit.key")
+	other := newTestEnvelope(t, dir, "other.key")
+	SetEncryption(current)
+	defer SetEncryption(nil)
+
+	encrypt := func(e *crypto.Envelope, s string) []byte {
+		data, err := e.Encrypt([]byte(s))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data
+	}
+
+	tests := []struct {
+		name string
+		a    []byte
+		b    []byte
+		want bool
+	}{
+		{"same plaintext", []byte(`{"serial":1}`), []byte(`{"serial":1}`), true},
+		{"different plaintext", []byte(`{"serial":1}`), []byte(`{"serial":2}`), false},
+		{"same state with different data keys", encrypt(current, `{"serial":1}`), encrypt(current, `{"serial":1}`), true},
+		{"different state", encrypt(current, `{"serial":1}`), encrypt(current, `{"serial":2}`), false},
+		{"plaintext and encrypted", []byte(`{"serial":1}`), encrypt(current, `{"serial":1}`), false},
+		{"different keys", encrypt(other, `{"serial":1}`), encrypt(current, `{"serial":1}`), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sameState(tt.a, tt.b); got != tt.want {
+				t.Errorf("sameState() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKluster_Rekey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kubekit-rekey")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	keysDir := filepath.Join(dir, "keys")
+	oldKey := newTestEnvelope(t, keysDir, "old.key")
+	newKey := newTestEnvelope(t, keysDir, "kubekit.key")
+
+	clusterDir := filepath.Join(dir, "kkdemo")
+	if err := os.MkdirAll(filepath.Join(clusterDir, StateDirname, StateHistoryDirname), 0755); err != nil {
+		t.Fatal(err)
+	}
+	oldState, err := oldKey.Encrypt([]byte(`{"serial":1}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string][]byte{
+		CredentialsFileName:                            []byte("platform: ec2\n"),
+		filepath.Join(StateDirname, "ec2.tfstate"):     oldState,
+		filepath.Join(StateDirname, "ec2.tfstate.bkp"): []byte(`{"serial":0}`),
+		filepath.Join(StateDirname, StateHistoryDirname, "ec2.20200101T000000.000000000Z.tfstate"): oldState,
+		DefaultConfigFilename + ".yaml": []byte("kind: cluster\n"),
+	}
+	for name, data := range files {
+		if err := ioutil.WriteFile(filepath.Join(clusterDir, name), data, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	k := &Kluster{
+		Name: "kkdemo",
+		path: filepath.Join(clusterDir, DefaultConfigFilename+".yaml"),
+	}
+
+	if _, err := k.Rekey(nil, newKey); err == nil {
+		t.Errorf("Rekey() expected an error, there is no key to decrypt the state")
+	}
+
+	from := crypto.NewEnvelope(crypto.NewFileKeyProvider(filepath.Join(keysDir, "kubekit.key")), crypto.NewFileKeyProvider(filepath.Join(keysDir, "old.key")))
+	got, err := k.Rekey(from, newKey)
+	if err != nil {
+		t.Fatalf("Rekey() error = %v", err)
+	}
+	if len(got) != 4 {
+		t.Errorf("Rekey() re-encrypted %d files, want 4. %v", len(got), got)
+	}
+
+	for name, want := range files {
+		data, err := ioutil.ReadFile(filepath.Join(clusterDir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !isAtRestFile(name) {
+			if string(data) != string(want) {
+				t.Errorf("the file %s was modified: %s", name, data)
+			}
+			continue
+		}
+		plaintext, err := newKey.Decrypt(data)
+		if err != nil {
+			t.Errorf("the file %s is not encrypted with the new key. %s", name, err)
+			continue
+		}
+		wantPlaintext, _ := from.Decrypt(want)
+		if string(plaintext) != string(wantPlaintext) {
+			t.Errorf("the file %s = %s, want %s", name, plaintext, wantPlaintext)
+		}
+	}
+
+	if _, err := k.Rekey(newKey, nil); err != nil {
+		t.Fatalf("Rekey() to decrypt error = %v", err)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(clusterDir, StateDirname, "ec2.tfstate"))
+	if err != nil || string(data) != `{"serial":1}` {
+		t.Errorf("the decrypted state = %s, %v; want the plaintext", data, err)
+	}
+}
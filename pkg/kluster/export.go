@@ -74,6 +74,7 @@ func (k *Kluster) ExportK8s() error {
 	if err != nil {
 		return err
 	}
+	defer conf.Close()
 
 	logPrefix = fmt.Sprintf("Export [ %s@%s ]", k.Name, platformName)
 	k.ui.SetLogPrefix(logPrefix)
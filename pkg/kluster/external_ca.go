@@ -0,0 +1,207 @@
+package kluster
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/liferaft/kubekit/pkg/crypto/tls"
+)
+
+// CSRDirname is the directory, in the cluster directory, with the private keys
+// and certificate requests waiting to be signed by an external CA, and where
+// the user saves the signed certificates
+const CSRDirname = "csr"
+
+// CSRDir returns the path to store the certificate requests to be signed by
+// the external CAs
+func (k *Kluster) CSRDir() string {
+	return filepath.Join(k.Dir(), CSRDirname)
+}
+
+// caNameFrom returns the CA certificate name from the given name or CN, i.e.
+// 'kube-ca' is the CA certificate 'root_ca'
+func caNameFrom(name string) (string, error) {
+	for caName, caCert := range CACertNames {
+		if caName == serviceAccountKeyName {
+			continue
+		}
+		if name == caName || name == caCert.CN {
+			return caName, nil
+		}
+	}
+	return "", fmt.Errorf("unknown external CA %q, the CA certificates are: %s", name, caCertNamesList())
+}
+
+func caCertNamesList() string {
+	names := []string{}
+	for caName := range CACertNames {
+		if caName != serviceAccountKeyName {
+			names = append(names, caName)
+		}
+	}
+	sort.Strings(names)
+	return fmt.Sprintf("%v", names)
+}
+
+// externalCAs returns the names of the CA certificates that sign the
+// certificates outside of KubeKit. These are the CAs in the parameter
+// `certificates_external_ca` and, if the generic CA is external, the CAs
+// without their own private key because they would use the generic CA. The
+// service account key pair is never external
+func (k *Kluster) externalCAs(baseCertsDir string, userCACertsFiles tls.KeyPairs) (map[string]bool, error) {
+	external := map[string]bool{}
+	if k.Config == nil {
+		return external, nil
+	}
+	for _, name := range k.Config.CertificatesExternalCA {
+		caName, err := caNameFrom(name)
+		if err != nil {
+			return nil, err
+		}
+		external[caName] = true
+	}
+
+	if !external[GenericKeyPairName] {
+		return external, nil
+	}
+	for name := range CACertNames {
+		if name == serviceAccountKeyName || external[name] {
+			continue
+		}
+		if kp, ok := userCACertsFiles[name]; ok && kp != nil && len(kp.KeyFile) != 0 {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(baseCertsDir, name+".key")); err == nil {
+			continue
+		}
+		external[name] = true
+	}
+
+	return external, nil
+}
+
+// loadExternalCA loads the certificate of an external CA from the given file,
+// from the certificates directory or, if this CA uses the external generic CA,
+// from the given generic CA certificate file or the generic CA certificate in
+// the certificates directory. The certificate is saved in the certificates
+// directory
+func (k *Kluster) loadExternalCA(baseCertsDir, name, certFile, genericCertFile string) (*tls.KeyPair, error) {
+	candidates := []string{certFile, filepath.Join(baseCertsDir, name+".crt")}
+	if name != GenericKeyPairName {
+		candidates = append(candidates, genericCertFile, filepath.Join(baseCertsDir, GenericKeyPairName+".crt"))
+	}
+	certFile = ""
+	for _, filename := range candidates {
+		if len(filename) == 0 {
+			continue
+		}
+		if _, err := os.Stat(filename); err == nil {
+			certFile = filename
+			break
+		}
+	}
+	if len(certFile) == 0 {
+		return nil, fmt.Errorf("the certificate of the external CA %s is required, provide it with the flag '--%s-cert-file'", name, CACertNames[name].CN)
+	}
+
+	kp, err := tls.NewExternalCAKeyPair(certFile, baseCertsDir, name, CACertNames[name].CN)
+	if err != nil {
+		return nil, err
+	}
+	k.ui.Log.Infof("using the certificate of the external CA %s from %s", name, certFile)
+
+	return kp, kp.SaveCertificate(true)
+}
+
+// signByExternalCA sets to the key pair a private key and a certificate signed
+// by the external CA. The certificate is imported from the CSR directory if
+// it was signed, otherwise the existing certificate is used if it's still
+// valid and renew is false. If there isn't a valid certificate, a new private
+// key and certificate request are created in the CSR directory and sent to the
+// signer. Returns true if the certificate request is waiting to be signed
+func (k *Kluster) signByExternalCA(kp, ca *tls.KeyPair, certName, csrDir string, renew bool, signer Signer) (bool, error) {
+	csrKP := *kp
+	csrKP.NewFilenames(csrDir, "")
+	csrFile := filepath.Join(csrDir, kp.Name+".csr")
+	removeRequest := func() {
+		for _, filename := range []string{csrKP.KeyFile, csrKP.CertFile, csrFile} {
+			os.Remove(filename)
+		}
+	}
+
+	// A certificate signed from a previous request
+	err := kp.ImportFrom(csrDir, ca)
+	if err == nil {
+		k.ui.Log.Infof("imported the certificate for %s signed by the external CA %s", certName, ca.Name)
+		removeRequest()
+		return false, nil
+	}
+	if !os.IsNotExist(err) {
+		return false, fmt.Errorf("the signed certificate %s is not valid, replace it or remove the files %s.{key,csr,crt} to request a new certificate. %s", csrKP.CertFile, filepath.Join(csrDir, kp.Name), err)
+	}
+
+	// A request to be signed by the user, the other signers get a new request
+	if _, ok := signer.(*directorySigner); ok {
+		_, errCSR := os.Stat(csrFile)
+		_, errKey := os.Stat(csrKP.KeyFile)
+		if errCSR == nil && errKey == nil {
+			k.ui.Log.Infof("the certificate request %s is waiting to be signed by the external CA %s", csrFile, ca.Name)
+			return true, nil
+		}
+	}
+
+	// The existing certificate, if it's still valid
+	if !renew {
+		if err := kp.ImportFrom(filepath.Dir(kp.KeyFile), ca); err == nil {
+			k.ui.Log.Infof("using the existing certificate for %s signed by the external CA %s", certName, ca.Name)
+			return false, nil
+		} else if !os.IsNotExist(err) {
+			k.ui.Log.Warnf("a new certificate will be requested for %s. %s", certName, err)
+		}
+	}
+
+	// A new certificate request
+	k.ui.Log.Infof("creating the certificate request for %s to be signed by the external CA %s", certName, ca.Name)
+	if err := os.MkdirAll(csrDir, 0700); err != nil {
+		return false, err
+	}
+	if err := csrKP.GenKeyPair(nil); err != nil {
+		return false, err
+	}
+	csrPEM, err := csrKP.CertificateRequest()
+	if err != nil {
+		return false, err
+	}
+	if err := csrKP.SavePrivateKey(true); err != nil {
+		return false, err
+	}
+	if err := ioutil.WriteFile(csrFile, csrPEM, 0600); err != nil {
+		return false, fmt.Errorf("failed to save the certificate request to %s. %s", csrFile, err)
+	}
+
+	certPEM, err := signer.Sign(ca.Name, certName, csrPEM)
+	if err == errSignPending {
+		k.ui.Log.Infof("the certificate request %s is waiting to be signed by the external CA %s", csrFile, ca.Name)
+		return true, nil
+	}
+	if err != nil {
+		removeRequest()
+		return false, err
+	}
+
+	signed := *kp
+	signed.PrivateKey = csrKP.PrivateKey
+	signed.PrivateKeyPEM = csrKP.PrivateKeyPEM
+	if err := signed.ImportCertificate(certPEM, ca); err != nil {
+		removeRequest()
+		return false, err
+	}
+	*kp = signed
+	k.ui.Log.Infof("imported the certificate for %s signed by the external CA %s", certName, ca.Name)
+	removeRequest()
+
+	return false, nil
+}
@@ -0,0 +1,286 @@
+package kluster
+
+import (
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/liferaft/kubekit/pkg/configurator"
+	"github.com/liferaft/kubekit/pkg/crypto/tls"
+)
+
+func TestKluster_externalCAs(t *testing.T) {
+	baseCertsDir, err := ioutil.TempDir("", "external_ca")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(baseCertsDir)
+	if err := ioutil.WriteFile(filepath.Join(baseCertsDir, "ingress_root_ca.key"), []byte("key"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		config   *configurator.Config
+		userKeys tls.KeyPairs
+		want     map[string]bool
+		wantErr  bool
+	}{
+		{"no config", nil, nil, map[string]bool{}, false},
+		{"none", &configurator.Config{}, nil, map[string]bool{}, false},
+		{"by name", &configurator.Config{CertificatesExternalCA: []string{"etcd_root_ca"}}, nil, map[string]bool{"etcd_root_ca": true}, false},
+		{"by CN", &configurator.Config{CertificatesExternalCA: []string{"etcd-ca"}}, nil, map[string]bool{"etcd_root_ca": true}, false},
+		{"generic without keys", &configurator.Config{CertificatesExternalCA: []string{"kube-ca"}}, nil, map[string]bool{"root_ca": true, "etcd_root_ca": true}, false},
+		{"generic with user key", &configurator.Config{CertificatesExternalCA: []string{"root_ca"}}, tls.KeyPairs{"etcd_root_ca": &tls.KeyPair{KeyFile: "etcd.key"}}, map[string]bool{"root_ca": true}, false},
+		{"service account", &configurator.Config{CertificatesExternalCA: []string{"srv_acc"}}, nil, nil, true},
+		{"unknown", &configurator.Config{CertificatesExternalCA: []string{"foo"}}, nil, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := &Kluster{Config: tt.config}
+			got, err := k.externalCAs(baseCertsDir, tt.userKeys)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Kluster.externalCAs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Kluster.externalCAs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// fakeSigner signs the certificate requests with the CA private key, or leaves
+// them pending if there is no CA
+type fakeSigner struct {
+	ca       *tls.KeyPair
+	requests int
+}
+
+func (s *fakeSigner) Sign(caName, certName string, csrPEM []byte) ([]byte, error) {
+	s.requests++
+	if s.ca == nil {
+		return nil, errSignPending
+	}
+	return signCSR(s.ca, csrPEM)
+}
+
+func signCSR(ca *tls.KeyPair, csrPEM []byte) ([]byte, error) {
+	block, _ := pem.Decode(csrPEM)
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      csr.Subject,
+		DNSNames:     csr.DNSNames,
+		IPAddresses:  csr.IPAddresses,
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.Certificate, csr.PublicKey, ca.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), nil
+}
+
+func TestKluster_signByExternalCA(t *testing.T) {
+	clusterDir, err := ioutil.TempDir("", "external_ca")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(clusterDir)
+
+	k := &Kluster{
+		Name:      "kkdemo",
+		Platforms: map[string]interface{}{"raw": nil},
+		path:      filepath.Join(clusterDir, DefaultConfigFilename+".yaml"),
+		ui:        parentUI,
+	}
+	baseCertsDir, err := k.makeCertDir("raw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	csrDir := k.CSRDir()
+
+	// The CA private key is only known by the external CA
+	signingCA, err := tls.NewCAKeyPair(&tls.KeyPair{}, clusterDir, "signing_ca", "kube-ca", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := signingCA.SaveCertificate(true); err != nil {
+		t.Fatal(err)
+	}
+	ca, err := k.loadExternalCA(baseCertsDir, GenericKeyPairName, signingCA.CertFile, "")
+	if err != nil {
+		t.Fatalf("Kluster.loadExternalCA() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(baseCertsDir, GenericKeyPairName+".crt")); err != nil {
+		t.Errorf("Kluster.loadExternalCA() did not save the CA certificate. %s", err)
+	}
+	if _, err := k.loadExternalCA(baseCertsDir, "etcd_root_ca", "", ""); err != nil {
+		t.Errorf("Kluster.loadExternalCA() error = %v, want the generic CA certificate", err)
+	}
+
+	newKeyPair := func() *tls.KeyPair {
+		return tls.NewEmptyKeyPair(baseCertsDir, "admin", "admin", "system:masters", nil, nil, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}, nil)
+	}
+	sign := func(renew bool, signer Signer) (*tls.KeyPair, bool) {
+		kp := newKeyPair()
+		pending, err := k.signByExternalCA(kp, ca, "admin", csrDir, renew, signer)
+		if err != nil {
+			t.Fatalf("Kluster.signByExternalCA() error = %v", err)
+		}
+		if !pending {
+			if kp.Certificate == nil || kp.PrivateKey == nil {
+				t.Fatalf("Kluster.signByExternalCA() did not set the certificate")
+			}
+			if err := kp.SavePrivateKey(true); err != nil {
+				t.Fatal(err)
+			}
+			if err := kp.SaveCertificate(true); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return kp, pending
+	}
+	exists := func(name string) bool {
+		_, err := os.Stat(filepath.Join(csrDir, name))
+		return err == nil
+	}
+
+	// The request is saved in the CSR directory until the user signs it
+	if _, pending := sign(false, &directorySigner{}); !pending || !exists("admin.csr") || !exists("admin.key") {
+		t.Fatalf("Kluster.signByExternalCA() pending = %v, want a pending request in %s", pending, csrDir)
+	}
+	csrPEM, err := ioutil.ReadFile(filepath.Join(csrDir, "admin.csr"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, pending := sign(false, &directorySigner{}); !pending {
+		t.Fatalf("Kluster.signByExternalCA() pending = %v, want the same pending request", pending)
+	}
+	if sameCSR, _ := ioutil.ReadFile(filepath.Join(csrDir, "admin.csr")); string(sameCSR) != string(csrPEM) {
+		t.Fatalf("Kluster.signByExternalCA() created a new request, want the same pending request")
+	}
+
+	certPEM, err := signCSR(signingCA, csrPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(csrDir, "admin.crt"), certPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+	pendingSigner := &fakeSigner{}
+	imported, pending := sign(false, pendingSigner)
+	if pending || exists("admin.csr") || exists("admin.key") || exists("admin.crt") {
+		t.Fatalf("Kluster.signByExternalCA() pending = %v, want the signed certificate imported and the request removed", pending)
+	}
+
+	// The imported certificate is used until it's renewed
+	existing, _ := sign(false, pendingSigner)
+	if !existing.Certificate.Equal(imported.Certificate) || pendingSigner.requests != 0 {
+		t.Errorf("Kluster.signByExternalCA() requested a new certificate, want the existing certificate")
+	}
+	renewed, pending := sign(true, &fakeSigner{ca: signingCA})
+	if pending || renewed.Certificate.Equal(imported.Certificate) || exists("admin.csr") {
+		t.Errorf("Kluster.signByExternalCA() pending = %v, want a new certificate signed by the signer", pending)
+	}
+
+	// A certificate not signed by the external CA is not imported
+	otherCA, err := tls.NewCAKeyPair(&tls.KeyPair{}, clusterDir, "other_ca", "kube-ca", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := k.signByExternalCA(newKeyPair(), ca, "admin", csrDir, true, &fakeSigner{ca: otherCA}); err == nil || exists("admin.csr") {
+		t.Errorf("Kluster.signByExternalCA() error = %v, want an error and the request removed with a certificate signed by other CA", err)
+	}
+}
+
+func TestKluster_signer(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *configurator.Config
+		want    Signer
+		wantErr bool
+	}{
+		{"no config", nil, &directorySigner{}, false},
+		{"directory", &configurator.Config{}, &directorySigner{}, false},
+		{"command", &configurator.Config{CertificatesSignerCommand: "cfssl sign -"}, &commandSigner{command: "cfssl sign -"}, false},
+		{"both", &configurator.Config{CertificatesSignerURL: "http://localhost:8888/api/v1/cfssl/sign", CertificatesSignerCommand: "cfssl sign -"}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := &Kluster{Config: tt.config}
+			got, err := k.signer()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Kluster.signer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Kluster.signer() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSigners(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req cfsslSignRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Label != "root_ca" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"success":false,"errors":[{"code":400,"message":"invalid request"}]}`))
+			return
+		}
+		w.Write([]byte(`{"success":true,"result":{"certificate":"CERT"}}`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name        string
+		signer      Signer
+		caName      string
+		want        string
+		wantPending bool
+		wantErr     bool
+	}{
+		{"directory", &directorySigner{}, "root_ca", "", true, false},
+		{"http", &httpSigner{url: server.URL, client: server.Client()}, "root_ca", "CERT", false, false},
+		{"http error", &httpSigner{url: server.URL, client: server.Client()}, "etcd_root_ca", "", false, true},
+		{"command PEM", &commandSigner{command: `cat; echo; echo "$KUBEKIT_CA_NAME/$KUBEKIT_CERT_NAME"`}, "root_ca", "CSR\nroot_ca/admin", false, false},
+		{"command JSON", &commandSigner{command: `echo '{"cert":"CERT","csr":"CSR"}'`}, "root_ca", "CERT", false, false},
+		{"command error", &commandSigner{command: "exit 1"}, "root_ca", "", false, true},
+		{"command timeout", &commandSigner{command: "exec sleep 10"}, "root_ca", "", false, true},
+	}
+
+	prevSignerTimeout := signerTimeout
+	defer func() { signerTimeout = prevSignerTimeout }()
+	signerTimeout = 500 * time.Millisecond
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.signer.Sign(tt.caName, "admin", []byte("CSR"))
+			if pending := err == errSignPending; pending != tt.wantPending {
+				t.Fatalf("Signer.Sign() error = %v, wantPending %v", err, tt.wantPending)
+			}
+			if (err != nil && err != errSignPending) != tt.wantErr {
+				t.Fatalf("Signer.Sign() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Signer.Sign() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
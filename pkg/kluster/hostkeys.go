@@ -0,0 +1,136 @@
+package kluster
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/liferaft/kubekit/pkg/configurator/ssh"
+	"github.com/liferaft/kubekit/pkg/provisioner"
+)
+
+// KnownHosts returns the store of the pinned SSH host keys of the cluster nodes
+func (k *Kluster) KnownHosts() *ssh.KnownHosts {
+	return ssh.NewKnownHosts(filepath.Join(k.Dir(), ssh.KnownHostsFilename))
+}
+
+// HostKeys returns the pinned SSH host keys of the given nodes, or all the
+// pinned keys if no node is given
+func (k *Kluster) HostKeys(nodes []string) ([]ssh.HostKey, error) {
+	keys, err := k.KnownHosts().List()
+	if err != nil || len(nodes) == 0 {
+		return keys, err
+	}
+
+	hosts := k.hostKeyAddresses(nodes)
+	filtered := []ssh.HostKey{}
+	for _, key := range keys {
+		for _, host := range hosts {
+			if key.Host == host {
+				filtered = append(filtered, key)
+				break
+			}
+		}
+	}
+
+	return filtered, nil
+}
+
+// DeleteHostKeys removes the pinned SSH host keys of the given nodes, use it
+// when a node is replaced and has a new host key. The new key is pinned the
+// next time KubeKit connects to the node. Returns the number of deleted keys
+func (k *Kluster) DeleteHostKeys(nodes []string) (int, error) {
+	if len(nodes) == 0 {
+		return 0, fmt.Errorf("at least one node is required to delete its host keys")
+	}
+	return k.KnownHosts().Remove(k.hostKeyAddresses(nodes)...)
+}
+
+// DeleteAllHostKeys removes all the pinned SSH host keys of the cluster
+func (k *Kluster) DeleteAllHostKeys() error {
+	err := os.Remove(filepath.Join(k.Dir(), ssh.KnownHostsFilename))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// hostKeyAddresses returns the addresses used to connect to the given nodes,
+// identified by IP or DNS name, with the SSH port as they are in the known
+// hosts store. The nodes not in the cluster, such as removed nodes, are used as
+// they are
+func (k *Kluster) hostKeyAddresses(nodes []string) []string {
+	port := k.sshPort()
+	addresses := []string{}
+	for _, node := range nodes {
+		hosts := k.HostsFilterBy([]string{node}, nil)
+		if len(hosts) == 0 {
+			addresses = append(addresses, ssh.HostKeyAddress(node, port))
+			continue
+		}
+		// the nodes behind a bastion are reached by their private IP address
+		for _, h := range hosts {
+			addresses = append(addresses, ssh.HostKeyAddress(h.PublicIP, port))
+			if len(h.PrivateIP) != 0 {
+				addresses = append(addresses, ssh.HostKeyAddress(h.PrivateIP, port))
+			}
+		}
+	}
+	return addresses
+}
+
+// sshPort returns the port to connect with SSH to the cluster nodes
+func (k *Kluster) sshPort() int {
+	p, ok := k.provisioner[k.Platform()]
+	if !ok {
+		return ssh.DefaultPort
+	}
+	data, err := json.Marshal(p.Config())
+	if err != nil {
+		return ssh.DefaultPort
+	}
+	var config struct {
+		Port int `json:"ssh_port"`
+	}
+	if err := json.Unmarshal(data, &config); err != nil || config.Port == 0 {
+		return ssh.DefaultPort
+	}
+	return config.Port
+}
+
+// seedHostKeys pins the SSH host keys of the cluster nodes reported by the
+// platform, if the platform exposes them, so the first connection to the nodes
+// is verified as well
+func (k *Kluster) seedHostKeys(p provisioner.Provisioner) {
+	hk, ok := p.(provisioner.HostKeyer)
+	if !ok {
+		return
+	}
+
+	hostKeys, err := hk.HostKeys(p.Nodes())
+	if err != nil {
+		k.ui.Log.Warnf("failed to get the SSH host keys of the nodes, they will be pinned the first time KubeKit connects to them. %s", err)
+		return
+	}
+
+	knownHosts := k.KnownHosts()
+	port := k.sshPort()
+	for address, keys := range hostKeys {
+		// the host keys are verified with the address and port of the connection
+		host := ssh.HostKeyAddress(address, port)
+		if err := knownHosts.Add(host, keys...); err != nil {
+			k.ui.Log.Warnf("failed to pin the SSH host keys of %s. %s", host, err)
+			continue
+		}
+		k.ui.Log.Debugf("pinned %d SSH host keys of %s", len(keys), host)
+	}
+}
+
+// setKnownHosts sets the store of the pinned SSH host keys to the provisioners
+// connecting to the cluster hosts
+func (k *Kluster) setKnownHosts(p provisioner.Provisioner) {
+	if v, ok := p.(provisioner.HostKeyVerifier); ok {
+		v.SetKnownHosts(k.KnownHosts())
+	}
+}
@@ -0,0 +1,44 @@
+package kluster
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/liferaft/kubekit/pkg/configurator"
+)
+
+func TestKluster_hostKeyAddresses(t *testing.T) {
+	tests := []struct {
+		name      string
+		variables map[string]string
+		nodes     []string
+		want      []string
+	}{
+		{"default port", nil, []string{"54.0.0.5"}, []string{"54.0.0.5", "10.0.0.5"}},
+		{"custom port", map[string]string{"ssh_port": "2222"}, []string{"54.0.0.5"}, []string{"[54.0.0.5]:2222", "[10.0.0.5]:2222"}},
+		{"node not in the cluster", map[string]string{"ssh_port": "2222"}, []string{"10.0.0.9"}, []string{"[10.0.0.9]:2222"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "kubekit-hostkeys")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+
+			k, err := CreateCluster("kkdemo", "ec2", dir, "yaml", tt.variables, parentUI)
+			if err != nil {
+				t.Fatal(err)
+			}
+			k.State["ec2"].Nodes = configurator.Hosts{
+				{RoleName: "master-01", PublicIP: "54.0.0.5", PrivateIP: "10.0.0.5", Pool: "master"},
+			}
+
+			if got := k.hostKeyAddresses(tt.nodes); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Kluster.hostKeyAddresses() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
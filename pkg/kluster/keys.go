@@ -1,19 +1,21 @@
 package kluster
 
 import (
+	"crypto/ed25519"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
+	"encoding/json"
 	"encoding/pem"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 
+	"github.com/liferaft/kubekit/pkg/configurator/ssh"
 	"github.com/liferaft/kubekit/pkg/crypto"
 	"github.com/liferaft/kubekit/pkg/provisioner"
 	"github.com/mitchellh/go-homedir"
-	"golang.org/x/crypto/ssh"
 )
 
 const (
@@ -21,6 +23,9 @@ const (
 
 	privateKeyFileName = "id_rsa"
 	publicKeyFileName  = "id_rsa.pub"
+
+	ed25519PrivateKeyFileName = "id_ed25519"
+	ed25519PublicKeyFileName  = "id_ed25519.pub"
 )
 
 var (
@@ -53,10 +58,36 @@ func (k *Kluster) HandleKeys() error {
 	return k.Save()
 }
 
+// sshKeyConfig are the platform parameters to generate and use the SSH keys
+type sshKeyConfig struct {
+	KeyType     string   `json:"ssh_key_type"`
+	AuthMethods []string `json:"ssh_auth_methods"`
+}
+
+// sshKeyConfig returns the SSH keys parameters of the cluster platform
+func (k *Kluster) sshKeyConfig() sshKeyConfig {
+	keyConfig := sshKeyConfig{}
+	platform, ok := k.provisioner[k.Platform()]
+	if !ok {
+		return keyConfig
+	}
+	if b, err := json.Marshal(platform.Config()); err == nil {
+		json.Unmarshal(b, &keyConfig)
+	}
+	return keyConfig
+}
+
+// agentOnly returns true if the nodes are accessed only with the keys held by
+// the SSH agent, so no private key should be generated or stored
+func (c sshKeyConfig) agentOnly() bool {
+	auth := &ssh.Auth{Methods: c.AuthMethods}
+	return auth.HasMethod(ssh.AgentAuth) && !auth.HasMethod(ssh.PrivateKeyAuth)
+}
+
 func (k *Kluster) handlePrivateKey(platform provisioner.Provisioner) error {
 	privKeyFile, privKey, requiredPrivKey := platform.GetPrivateKey()
 
-	if len(privKey) == 0 && !requiredPrivKey {
+	if len(privKey) == 0 && (!requiredPrivKey || k.sshKeyConfig().agentOnly()) {
 		return nil
 	}
 
@@ -142,7 +173,15 @@ func (k *Kluster) handlePublicKey(platform provisioner.Provisioner) error {
 	_, err = os.Stat(pubKeyFile)
 	_, isException := platformKeyGenExceptions[k.Platform()]
 
-	if os.IsNotExist(err) && requiredPublicKey && !isException {
+	if os.IsNotExist(err) && requiredPublicKey && k.sshKeyConfig().agentOnly() {
+		// the nodes are accessed with the keys in the SSH agent, use the first one
+		k.ui.Log.Debugf("using the public key from the SSH agent")
+		pubKey, err = ssh.AgentPublicKey()
+		if err != nil {
+			return fmt.Errorf("failed to get the public key from the SSH agent for cluster %s. error: %s", platform.Name(), err)
+		}
+		pubKeyFile = ""
+	} else if os.IsNotExist(err) && requiredPublicKey && !isException {
 		//we need a public key
 		pubKeyFile, pubKey, err = k.GenPubKeyFile(platform)
 		if err != nil {
@@ -184,23 +223,17 @@ func (k *Kluster) GenPubKeyFile(platform provisioner.Provisioner) (string, []byt
 		return "", nil, err
 	}
 
-	block, _ := pem.Decode(privKeyByte)
-	if block == nil {
-		return "", nil, fmt.Errorf("failed to decode private key")
-	}
-	privKey, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	privKeyFile, _, _ := platform.GetPrivateKey()
+	pubKeyByte, err := ssh.AuthorizedKey(privKeyFile, privKeyByte)
 	if err != nil {
 		return "", nil, err
 	}
 
-	pubKey, err := ssh.NewPublicKey(&privKey.PublicKey)
-	if err != nil {
-		return "", nil, err
+	keyFileName := publicKeyFileName
+	if k.sshKeyConfig().KeyType == ssh.ED25519Key {
+		keyFileName = ed25519PublicKeyFileName
 	}
-
-	pubKeyByte := ssh.MarshalAuthorizedKey(pubKey)
-
-	fileName, err := k.certFilePath(publicKeyFileName)
+	fileName, err := k.certFilePath(keyFileName)
 	if err != nil {
 		return fileName, pubKeyByte, err
 	}
@@ -247,23 +280,40 @@ func (k *Kluster) getOrGenPrivateKey(platform provisioner.Provisioner) ([]byte,
 	return privKeyBytes, err
 }
 
-// GenPrivKeyFile generates a private key file in the certificates directory
+// GenPrivKeyFile generates a private key file in the certificates directory.
+// The key type is set in the platform parameter `ssh_key_type`, RSA by default
 func (k *Kluster) GenPrivKeyFile(writeFile bool) (string, []byte, error) {
-	key, err := genPrivKey()
-	if err != nil {
-		return "", nil, err
-	}
+	keyFileName := privateKeyFileName
+	var keyBytes []byte
 
-	keyBytes := pem.EncodeToMemory(&pem.Block{
-		Type:  "RSA PRIVATE KEY",
-		Bytes: x509.MarshalPKCS1PrivateKey(key),
-	})
+	switch keyType := k.sshKeyConfig().KeyType; keyType {
+	case "", ssh.RSAKey:
+		key, err := genPrivKey()
+		if err != nil {
+			return "", nil, err
+		}
+		keyBytes = pem.EncodeToMemory(&pem.Block{
+			Type:  "RSA PRIVATE KEY",
+			Bytes: x509.MarshalPKCS1PrivateKey(key),
+		})
+	case ssh.ED25519Key:
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return "", nil, err
+		}
+		if keyBytes, err = ssh.MarshalED25519PrivateKey(key, k.Name); err != nil {
+			return "", nil, err
+		}
+		keyFileName = ed25519PrivateKeyFileName
+	default:
+		return "", nil, fmt.Errorf("unknown SSH key type %q, the valid types are: %s and %s", keyType, ssh.RSAKey, ssh.ED25519Key)
+	}
 
 	if !writeFile {
 		return "", keyBytes, nil
 	}
 
-	fileName, err := k.certFilePath(privateKeyFileName)
+	fileName, err := k.certFilePath(keyFileName)
 	if err != nil {
 		return fileName, keyBytes, err
 	}
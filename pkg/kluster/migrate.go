@@ -0,0 +1,334 @@
+package kluster
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/kraken/ui"
+	"github.com/liferaft/kubekit/version"
+	toml "github.com/pelletier/go-toml"
+	"github.com/pmezard/go-difflib/difflib"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// MigrateFunc migrates the given cluster configuration, as a generic map, from
+// one version to the next one. The credentials found in the configuration are
+// moved to the given credentials map to be saved in the credentials file
+type MigrateFunc func(config map[interface{}]interface{}, credentials map[string]string) error
+
+// Migration is a migration of the cluster configuration from one version to the
+// next one
+type Migration struct {
+	From        string
+	To          string
+	Description string
+	Migrate     MigrateFunc
+}
+
+// migrations is the ordered list of migrations of the cluster configuration.
+// When Version is modified, append the migration from the previous version
+var migrations = []Migration{
+	{
+		From:        "1.0",
+		To:          "1.1",
+		Description: "no changes, the missing parameters get the default values",
+	},
+	{
+		From:        "1.1",
+		To:          "1.2",
+		Description: "the obsolete vSphere parameters vsphere_server, vsphere_username and vsphere_password are moved to the credentials file",
+		Migrate:     migrateVsphereCredentials,
+	},
+}
+
+// MigrationResult is the result of migrating a cluster configuration file
+type MigrationResult struct {
+	Path       string
+	From       string
+	To         string
+	Migrations []Migration
+	Diff       string
+	Backup     string
+}
+
+// Migrate migrates the given cluster configuration file to the current
+// Version. It returns the applied migrations and the differences between the
+// original and the migrated file. Unless it's a dry run, the original file is
+// copied to the backups directory of the cluster before it's rewritten and the
+// credentials found in the configuration are moved to the credentials file
+func Migrate(path string, dryRun bool, parentUI *ui.UI) (*MigrationResult, error) {
+	k := &Kluster{
+		path: path,
+		ui:   parentUI.Copy(),
+	}
+	if err := k.LoadSummary(); err != nil {
+		return nil, err
+	}
+
+	ver, err := version.NewSemVer(k.Version)
+	if err != nil {
+		return nil, fmt.Errorf("the cluster version (%s) is not well formed or not SemVer compliance. %s", k.Version, err)
+	}
+	if ver.LT(MinSemVersion) {
+		return nil, fmt.Errorf("the cluster version %s cannot be migrated, the minimun version supported is %s", k.Version, MinVersion)
+	}
+	if ver.GT(SemVersion) {
+		return nil, fmt.Errorf("the cluster version %s is greater than the cluster version supported by this KubeKit (%s)", k.Version, Version)
+	}
+
+	result := &MigrationResult{
+		Path: path,
+		From: k.Version,
+		To:   Version,
+	}
+	if !ver.LT(SemVersion) {
+		return result, nil
+	}
+
+	original, err := k.readFile()
+	if err != nil {
+		return nil, err
+	}
+	credentials, applied, err := k.migrate(original)
+	if err != nil {
+		return nil, err
+	}
+	result.Migrations = applied
+
+	data, err := k.data()
+	if err != nil {
+		return nil, err
+	}
+	// do not show the credentials moved to the credentials file
+	originalText := maskFields(string(original), vsphereObsoleteFields)
+	result.Diff, err = difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(originalText),
+		B:        difflib.SplitLines(string(data)),
+		FromFile: fmt.Sprintf("%s (version %s)", path, result.From),
+		ToFile:   fmt.Sprintf("%s (version %s)", path, result.To),
+		Context:  3,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if dryRun {
+		return result, nil
+	}
+
+	if err := os.MkdirAll(k.BackupsDir(), 0700); err != nil {
+		return nil, err
+	}
+	result.Backup = filepath.Join(k.BackupsDir(), fmt.Sprintf("%s-v%s.%s", DefaultConfigFilename, result.From, k.format()))
+	// the original configuration may have credentials
+	if err := writeAtRest(result.Backup, original, 0600); err != nil {
+		return nil, fmt.Errorf("failed to backup the cluster configuration file to %s. %s", result.Backup, err)
+	}
+
+	if err := k.saveMigratedCredentials(credentials); err != nil {
+		return nil, err
+	}
+
+	lock, err := lockFile(k.path)
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Unlock()
+
+	k.ui.Log.Debugf("updating cluster configuration file %s", k.path)
+	if err := ioutil.WriteFile(k.path, data, 0644); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// migrationsFrom returns the ordered list of migrations to apply to a cluster
+// configuration of the given version to get to the current Version
+func migrationsFrom(from string) ([]Migration, error) {
+	list := []Migration{}
+
+	current, err := version.NewSemVer(from)
+	if err != nil {
+		return nil, err
+	}
+	for current.LT(SemVersion) {
+		var next *Migration
+		for i, m := range migrations {
+			// the PATCH number is not considered for the cluster configuration version
+			if v, err := version.NewSemVer(m.From); err == nil && v.Major == current.Major && v.Minor == current.Minor {
+				next = &migrations[i]
+				break
+			}
+		}
+		if next == nil {
+			return nil, fmt.Errorf("not found a migration for the cluster configuration version %s", current)
+		}
+		list = append(list, *next)
+		if current, err = version.NewSemVer(next.To); err != nil {
+			return nil, err
+		}
+	}
+
+	return list, nil
+}
+
+// migrate applies the migrations to the given cluster configuration content
+// and loads the migrated configuration into this Kluster. It returns the
+// credentials found in the configuration and the applied migrations
+func (k *Kluster) migrate(data []byte) (map[string]string, []Migration, error) {
+	list, err := migrationsFrom(k.Version)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	config, err := decodeConfig(data, k.format())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read the cluster configuration to migrate it. %s", err)
+	}
+
+	credentials := map[string]string{}
+	for _, m := range list {
+		if m.Migrate != nil {
+			if err := m.Migrate(config, credentials); err != nil {
+				return nil, nil, fmt.Errorf("failed to migrate the cluster configuration from version %s to %s. %s", m.From, m.To, err)
+			}
+		}
+		config["version"] = m.To
+	}
+
+	b, err := yaml.Marshal(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	*k = Kluster{
+		path: k.path,
+		ui:   k.ui,
+	}
+	if err := k.ReadYAML(b); err != nil {
+		return nil, nil, fmt.Errorf("failed to load the migrated cluster configuration. %s", err)
+	}
+
+	return credentials, list, nil
+}
+
+// saveMigratedCredentials saves in the credentials file the credentials found
+// in the cluster configuration. The credentials already in the file are kept
+func (k *Kluster) saveMigratedCredentials(params map[string]string) error {
+	if len(params) == 0 {
+		return nil
+	}
+
+	path := filepath.Join(k.Dir(), CredentialsFileName)
+	cred := NewCredentials(k.Name, k.Platform(), path)
+	if err := cred.Read(); err != nil {
+		return err
+	}
+
+	current := cred.asMap()
+	for key, value := range params {
+		if len(current[key]) == 0 {
+			current[key] = value
+		}
+	}
+	if err := cred.AssignFromMap(current); err != nil {
+		return err
+	}
+
+	return cred.Write()
+}
+
+// decodeConfig returns the cluster configuration content as a generic map
+func decodeConfig(data []byte, format string) (map[interface{}]interface{}, error) {
+	switch format {
+	case "yaml", "json":
+		// JSON is a subset of YAML
+		config := map[interface{}]interface{}{}
+		err := yaml.Unmarshal(data, &config)
+		return config, err
+	case "toml":
+		tree, err := toml.LoadBytes(data)
+		if err != nil {
+			return nil, err
+		}
+		config, _ := toMapInterface(tree.ToMap()).(map[interface{}]interface{})
+		return config, nil
+	default:
+		return nil, fmt.Errorf("unknown format %s", format)
+	}
+}
+
+// toMapInterface converts every map[string]interface{} in the given value to
+// map[interface{}]interface{}, the maps used by the YAML decoder and expected by
+// the provisioners configuration
+func toMapInterface(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		m := make(map[interface{}]interface{}, len(v))
+		for key, val := range v {
+			m[key] = toMapInterface(val)
+		}
+		return m
+	case []interface{}:
+		l := make([]interface{}, len(v))
+		for i, val := range v {
+			l[i] = toMapInterface(val)
+		}
+		return l
+	default:
+		return value
+	}
+}
+
+// vsphereObsoleteFields are the obsolete vSphere parameters with the
+// credentials parameter they are moved to
+var vsphereObsoleteFields = map[string]string{
+	"vsphere_server":   "server",
+	"vsphere_username": "username",
+	"vsphere_password": "password",
+}
+
+// maskFields hides the value of the given fields in the cluster configuration
+// content, in any format (YAML, JSON or TOML). The same value in other fields
+// is not modified
+func maskFields(text string, fields map[string]string) string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, regexp.QuoteMeta(name))
+	}
+	sort.Strings(names)
+	re := regexp.MustCompile(`(?m)^(\s*"?(?:` + strings.Join(names, "|") + `)"?\s*[:=]\s*)("[^"]*"|'[^']*'|[^,\s#]*)`)
+	return re.ReplaceAllString(text, "${1}********")
+}
+
+// migrateVsphereCredentials moves the obsolete vSphere credentials parameters
+// from the platform configuration to the credentials
+func migrateVsphereCredentials(config map[interface{}]interface{}, credentials map[string]string) error {
+	platforms, ok := config["platforms"].(map[interface{}]interface{})
+	if !ok {
+		return nil
+	}
+	vsphere, ok := platforms["vsphere"].(map[interface{}]interface{})
+	if !ok {
+		return nil
+	}
+
+	for field, param := range vsphereObsoleteFields {
+		value, ok := vsphere[field]
+		if !ok {
+			continue
+		}
+		if s, ok := value.(string); ok && len(s) != 0 {
+			credentials[param] = s
+		}
+		delete(vsphere, field)
+	}
+
+	return nil
+}
@@ -0,0 +1,192 @@
+package kluster
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+func Test_migrationsFrom(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    string
+		want    []string
+		wantErr bool
+	}{
+		{"from min version", MinVersion, []string{"1.0", "1.1"}, false},
+		{"from previous version", "1.1", []string{"1.1"}, false},
+		{"from patch version", "1.1.0", []string{"1.1"}, false},
+		{"current version", Version, []string{}, false},
+		{"unknown version", "0.9", nil, true},
+		{"invalid version", "one", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := migrationsFrom(tt.from)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("migrationsFrom() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			from := []string{}
+			for _, m := range got {
+				from = append(from, m.From)
+			}
+			if strings.Join(from, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("migrationsFrom() = %v, want %v", from, tt.want)
+			}
+		})
+	}
+}
+
+const vsphereClusterConfig = `version: "1.1"
+kind: cluster
+name: kkdemo
+platforms:
+  vsphere:
+    datacenter: Vagrant
+    vsphere_server: 10.25.150.90
+    vsphere_username: kubekit
+    vsphere_password: S3cr3t
+state:
+  vsphere:
+    status: absent
+`
+
+func TestMigrate(t *testing.T) {
+	tests := []struct {
+		name    string
+		dryRun  bool
+		wantCfg string
+	}{
+		{"dry run", true, vsphereClusterConfig},
+		{"migrate", false, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "kubekit-migrate")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+
+			path := filepath.Join(dir, "cluster.yaml")
+			if err := ioutil.WriteFile(path, []byte(vsphereClusterConfig), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			result, err := Migrate(path, tt.dryRun, parentUI)
+			if err != nil {
+				t.Fatalf("Migrate() error = %v", err)
+			}
+			if result.From != "1.1" || result.To != Version {
+				t.Errorf("Migrate() migrated from %s to %s, want from 1.1 to %s", result.From, result.To, Version)
+			}
+			if !strings.Contains(result.Diff, "-    vsphere_password: ********") {
+				t.Errorf("Migrate() diff does not remove the vSphere password or it's not hidden:\n%s", result.Diff)
+			}
+			if strings.Contains(result.Diff, "S3cr3t") {
+				t.Errorf("Migrate() diff shows the vSphere password:\n%s", result.Diff)
+			}
+
+			data, err := ioutil.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.dryRun {
+				if string(data) != tt.wantCfg {
+					t.Errorf("Migrate() modified the configuration file in a dry run")
+				}
+				if _, err := os.Stat(filepath.Join(dir, CredentialsFileName)); !os.IsNotExist(err) {
+					t.Errorf("Migrate() created the credentials file in a dry run")
+				}
+				return
+			}
+
+			if strings.Contains(string(data), "vsphere_") {
+				t.Errorf("Migrate() the configuration file still has the obsolete parameters:\n%s", data)
+			}
+			backup, err := ioutil.ReadFile(result.Backup)
+			if err != nil {
+				t.Fatalf("Migrate() backup file not found. %s", err)
+			}
+			if string(backup) != vsphereClusterConfig {
+				t.Errorf("Migrate() backup file is not the original configuration file")
+			}
+
+			credData, err := ioutil.ReadFile(filepath.Join(dir, CredentialsFileName))
+			if err != nil {
+				t.Fatalf("Migrate() credentials file not found. %s", err)
+			}
+			cred := PlatformCredentials{}
+			if err := yaml.Unmarshal(credData, &cred); err != nil {
+				t.Fatal(err)
+			}
+			if cred.Server != "10.25.150.90" || cred.Username != "kubekit" || cred.Password != "S3cr3t" {
+				t.Errorf("Migrate() credentials = %+v, want the vSphere credentials from the configuration", cred)
+			}
+		})
+	}
+}
+
+func Test_maskFields(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"yaml", "    vsphere_username: kubekit\n    username: kubekit\n", "    vsphere_username: ********\n    username: kubekit\n"},
+		{"yaml quoted", "    vsphere_password: \"S3 cr3t\"\n", "    vsphere_password: ********\n"},
+		{"json", "  \"vsphere_server\": \"10.25.150.90\",\n  \"server\": \"10.25.150.90\"\n", "  \"vsphere_server\": ********,\n  \"server\": \"10.25.150.90\"\n"},
+		{"toml", "vsphere_password = 'S3cr3t' # secret\n", "vsphere_password = ******** # secret\n"},
+		{"other fields", "    datacenter: kubekit\n", "    datacenter: kubekit\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := maskFields(tt.text, vsphereObsoleteFields); got != tt.want {
+				t.Errorf("maskFields() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKluster_Load_migratedCredentials(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kubekit-migrate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "cluster.yaml")
+	if err := ioutil.WriteFile(path, []byte(vsphereClusterConfig), 0644); err != nil {
+		t.Fatal(err)
+	}
+	credPath := filepath.Join(dir, CredentialsFileName)
+
+	k := &Kluster{path: path, ui: parentUI}
+	if err := k.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if _, err := os.Stat(credPath); !os.IsNotExist(err) {
+		t.Errorf("Load() created the credentials file")
+	}
+	cred, err := k.GetCredentialsAsMap()
+	if err != nil {
+		t.Fatalf("GetCredentialsAsMap() error = %v", err)
+	}
+	if cred["server"] != "10.25.150.90" || cred["username"] != "kubekit" || cred["password"] != "S3cr3t" {
+		t.Errorf("Load() credentials = %v, want the vSphere credentials from the configuration", cred)
+	}
+
+	if err := k.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if _, err := os.Stat(credPath); err != nil {
+		t.Errorf("Save() did not create the credentials file. %s", err)
+	}
+}
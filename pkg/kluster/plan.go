@@ -0,0 +1,239 @@
+package kluster
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"text/tabwriter"
+
+	"github.com/kraken/terraformer"
+	"github.com/liferaft/kubekit/pkg/provisioner"
+)
+
+// SensitiveValue replaces the value of the sensitive attributes in a plan
+const SensitiveValue = "(sensitive value)"
+
+// PlanAttribute is a resource attribute modified by a plan
+type PlanAttribute struct {
+	Name   string `json:"name" yaml:"name"`
+	Before string `json:"before,omitempty" yaml:"before,omitempty"`
+	After  string `json:"after,omitempty" yaml:"after,omitempty"`
+}
+
+// PlanChange is a change to a resource in a plan
+type PlanChange struct {
+	Address    string          `json:"address" yaml:"address"`
+	Action     string          `json:"action" yaml:"action"`
+	Attributes []PlanAttribute `json:"attributes,omitempty" yaml:"attributes,omitempty"`
+}
+
+// Plan is the list of changes to apply to the cluster infrastructure
+type Plan struct {
+	Cluster  string       `json:"cluster" yaml:"cluster"`
+	Platform string       `json:"platform" yaml:"platform"`
+	Destroy  bool         `json:"destroy" yaml:"destroy"`
+	Add      int          `json:"to_add" yaml:"to_add"`
+	Change   int          `json:"to_change" yaml:"to_change"`
+	Remove   int          `json:"to_destroy" yaml:"to_destroy"`
+	Changes  []PlanChange `json:"changes" yaml:"changes"`
+	File     string       `json:"plan_file,omitempty" yaml:"plan_file,omitempty"`
+}
+
+// String returns the plan in a human readable format
+func (p *Plan) String() string {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "Plan for cluster %q: %d to add, %d to change, %d to destroy\n", p.Cluster, p.Add, p.Change, p.Remove)
+	if len(p.Changes) != 0 {
+		fmt.Fprintln(&b)
+		w := tabwriter.NewWriter(&b, 0, 0, 3, ' ', 0)
+		fmt.Fprintln(w, "ACTION\tRESOURCE\tATTRIBUTE\tBEFORE\tAFTER")
+		for _, c := range p.Changes {
+			if len(c.Attributes) == 0 {
+				fmt.Fprintf(w, "%s\t%s\t\t\t\n", c.Action, c.Address)
+				continue
+			}
+			for i, a := range c.Attributes {
+				action, address := c.Action, c.Address
+				if i != 0 {
+					action, address = "", ""
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", action, address, a.Name, valueOrNone(a.Before), valueOrNone(a.After))
+			}
+		}
+		w.Flush()
+	}
+	if len(p.File) != 0 {
+		fmt.Fprintf(&b, "\nPlan saved to %s, to apply exactly these changes execute: kubekit apply %s --plan-file %s\n", p.File, p.Cluster, p.File)
+	}
+
+	return b.String()
+}
+
+// Plan gets the changes to apply to the cluster infrastructure, or to destroy it
+// if destroy is true, without applying them. If a plan file is given, the plan
+// is saved to the file to be applied later with ApplyPlan
+func (k *Kluster) Plan(destroy bool, planFile string) (*Plan, error) {
+	platformName := k.Platform()
+
+	k.LoadState()
+	k.ui.Log.Debug("state(s) loaded")
+
+	p := k.provisioner[platformName]
+	pf, isPlanFiler := p.(provisioner.PlanFiler)
+	if len(planFile) != 0 && !isPlanFiler {
+		return nil, fmt.Errorf("the %s platform does not support to save the plan to a file", platformName)
+	}
+
+	logPrefix := fmt.Sprintf("Provisioner [ %s@%s ]", k.Name, platformName)
+	k.ui.SetLogPrefix(logPrefix)
+
+	tfPlan, err := p.Plan(destroy)
+
+	logPrefix = fmt.Sprintf("KubeKit [ %s@%s ]", k.Name, platformName)
+	k.ui.SetLogPrefix(logPrefix)
+
+	if err != nil {
+		return nil, err
+	}
+	k.ui.Log.Debugf("plan to apply: %v", tfPlan)
+
+	stats := terraformer.NewStats(tfPlan)
+	plan := &Plan{
+		Cluster:  k.Name,
+		Platform: platformName,
+		Destroy:  destroy,
+		Add:      stats.Add,
+		Change:   stats.Change,
+		Remove:   stats.Destroy,
+		Changes:  []PlanChange{},
+	}
+
+	if !isPlanFiler {
+		return plan, nil
+	}
+
+	changes, err := pf.Changes(tfPlan)
+	if err != nil {
+		return nil, err
+	}
+	for _, rc := range changes {
+		if rc.Action == terraformer.ActionNoOp {
+			continue
+		}
+		plan.Changes = append(plan.Changes, newPlanChange(rc))
+	}
+
+	if len(planFile) != 0 {
+		if err := pf.SavePlan(planFile); err != nil {
+			return nil, err
+		}
+		if err := k.encryptPlanFile(planFile); err != nil {
+			return nil, err
+		}
+		plan.File = planFile
+	}
+
+	return plan, nil
+}
+
+// ApplyPlan applies exactly the changes of the plan saved in the given file. The
+// plan is not applied if the infrastructure changed after the plan was created
+func (k *Kluster) ApplyPlan(planFile string) error {
+	platformName := k.Platform()
+	logPrefix := fmt.Sprintf("KubeKit [ %s@%s ]", k.Name, platformName)
+	k.ui.SetLogPrefix(logPrefix)
+
+	unlock, err := k.lockState("apply a plan to")
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	k.LoadState()
+	k.ui.Log.Debug("state(s) loaded")
+
+	p := k.provisioner[platformName]
+	pf, ok := p.(provisioner.PlanFiler)
+	if !ok {
+		return fmt.Errorf("the %s platform does not support to apply a plan from a file", platformName)
+	}
+
+	logPrefix = fmt.Sprintf("Provisioner [ %s@%s ]", k.Name, platformName)
+	k.ui.SetLogPrefix(logPrefix)
+
+	filename, remove, err := decryptedFile(planFile)
+	if err != nil {
+		return fmt.Errorf("failed to read the plan file %s. %s", planFile, err)
+	}
+	defer remove()
+
+	k.setKnownHosts(p)
+	err = pf.ApplyPlan(filename)
+	defer k.SaveState()
+	defer k.ui.TerminateAllNotifications("")
+
+	logPrefix = fmt.Sprintf("KubeKit [ %s@%s ]", k.Name, platformName)
+	k.ui.SetLogPrefix(logPrefix)
+
+	switch {
+	case err != nil:
+		k.State[platformName].Status = FailedProvisioningStatus.String()
+	case p.State() == nil || p.State().Empty():
+		// the applied plan was to destroy the cluster
+		k.State[platformName].Status = TerminatedStatus.String()
+		if err := k.DeleteAllHostKeys(); err != nil {
+			k.ui.Log.Warnf("failed to delete the SSH host keys of the terminated nodes. %s", err)
+		}
+	default:
+		k.State[platformName].Status = ProvisionedStatus.String()
+		k.seedHostKeys(p)
+	}
+
+	return err
+}
+
+// encryptPlanFile encrypts the given plan file if the encryption at rest is
+// enabled. The plan has the Terraform variables, with the credentials, and state
+func (k *Kluster) encryptPlanFile(planFile string) error {
+	if Encryption() == nil {
+		k.ui.Log.Warnf("the plan file %s has the cluster credentials and state unencrypted, enable the encryption at rest to encrypt it", planFile)
+		return nil
+	}
+	return encryptFile(planFile)
+}
+
+// newPlanChange returns the change to a resource with the modified attributes.
+// The values of the sensitive attributes are replaced by SensitiveValue
+func newPlanChange(rc *terraformer.ResourceChange) PlanChange {
+	change := PlanChange{
+		Address: rc.Address,
+		Action:  rc.Action,
+	}
+	// every attribute of a destroyed resource is removed, there is no need to list them
+	if rc.Action == terraformer.ActionDelete {
+		return change
+	}
+
+	for _, name := range sortedKeys(rc.Before, rc.After) {
+		before, after := rc.Before[name], rc.After[name]
+		if reflect.DeepEqual(before, after) {
+			continue
+		}
+		attr := PlanAttribute{
+			Name:   name,
+			Before: toString(before),
+			After:  toString(after),
+		}
+		if rc.Sensitive[name] {
+			if before != nil {
+				attr.Before = SensitiveValue
+			}
+			if after != nil && after != terraformer.KnownAfterApply {
+				attr.After = SensitiveValue
+			}
+		}
+		change.Attributes = append(change.Attributes, attr)
+	}
+
+	return change
+}
@@ -0,0 +1,200 @@
+package kluster
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kraken/terraformer"
+	"github.com/liferaft/kubekit/pkg/crypto"
+)
+
+func Test_newPlanChange(t *testing.T) {
+	tests := []struct {
+		name string
+		rc   *terraformer.ResourceChange
+		want PlanChange
+	}{
+		{
+			"create",
+			&terraformer.ResourceChange{
+				Address: "aws_instance.master[0]",
+				Action:  terraformer.ActionCreate,
+				After:   map[string]interface{}{"ami": "ami-123", "id": terraformer.KnownAfterApply, "tags": nil},
+			},
+			PlanChange{
+				Address: "aws_instance.master[0]",
+				Action:  terraformer.ActionCreate,
+				Attributes: []PlanAttribute{
+					{Name: "ami", After: "ami-123"},
+					{Name: "id", After: terraformer.KnownAfterApply},
+				},
+			},
+		},
+		{
+			"update only changed attributes",
+			&terraformer.ResourceChange{
+				Address: "aws_instance.master[0]",
+				Action:  terraformer.ActionUpdate,
+				Before:  map[string]interface{}{"ami": "ami-123", "instance_type": "m4.large", "tags": map[string]interface{}{"Name": "master"}},
+				After:   map[string]interface{}{"ami": "ami-123", "instance_type": "m4.xlarge", "tags": map[string]interface{}{"Name": "master"}},
+			},
+			PlanChange{
+				Address:    "aws_instance.master[0]",
+				Action:     terraformer.ActionUpdate,
+				Attributes: []PlanAttribute{{Name: "instance_type", Before: "m4.large", After: "m4.xlarge"}},
+			},
+		},
+		{
+			"sensitive attributes",
+			&terraformer.ResourceChange{
+				Address:   "vsphere_virtual_machine.master",
+				Action:    terraformer.ActionReplace,
+				Before:    map[string]interface{}{"password": "S3cr3t", "token": "T0k3n"},
+				After:     map[string]interface{}{"password": "N3wS3cr3t", "token": terraformer.KnownAfterApply},
+				Sensitive: map[string]bool{"password": true, "token": true},
+			},
+			PlanChange{
+				Address: "vsphere_virtual_machine.master",
+				Action:  terraformer.ActionReplace,
+				Attributes: []PlanAttribute{
+					{Name: "password", Before: SensitiveValue, After: SensitiveValue},
+					{Name: "token", Before: SensitiveValue, After: terraformer.KnownAfterApply},
+				},
+			},
+		},
+		{
+			"delete",
+			&terraformer.ResourceChange{
+				Address: "aws_instance.worker[1]",
+				Action:  terraformer.ActionDelete,
+				Before:  map[string]interface{}{"ami": "ami-123"},
+			},
+			PlanChange{Address: "aws_instance.worker[1]", Action: terraformer.ActionDelete},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := newPlanChange(tt.rc); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("newPlanChange() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlan_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		plan     *Plan
+		want     []string
+		dontWant []string
+	}{
+		{
+			"no changes",
+			&Plan{Cluster: "kkdemo"},
+			[]string{`Plan for cluster "kkdemo": 0 to add, 0 to change, 0 to destroy`},
+			[]string{"ACTION", "Plan saved"},
+		},
+		{
+			"changes saved to a file",
+			&Plan{
+				Cluster: "kkdemo",
+				Change:  1,
+				Remove:  1,
+				Changes: []PlanChange{
+					{Address: "aws_instance.master[0]", Action: "update", Attributes: []PlanAttribute{{Name: "instance_type", Before: "m4.large", After: "m4.xlarge"}}},
+					{Address: "aws_instance.worker[1]", Action: "delete"},
+				},
+				File: "kkdemo.tfplan",
+			},
+			[]string{
+				`Plan for cluster "kkdemo": 0 to add, 1 to change, 1 to destroy`,
+				"update   aws_instance.master[0]   instance_type   m4.large",
+				"delete   aws_instance.worker[1]",
+				"kubekit apply kkdemo --plan-file kkdemo.tfplan",
+			},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.plan.String()
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("Plan.String() does not contain %q:\n%s", want, got)
+				}
+			}
+			for _, dontWant := range tt.dontWant {
+				if strings.Contains(got, dontWant) {
+					t.Errorf("Plan.String() contains %q:\n%s", dontWant, got)
+				}
+			}
+		})
+	}
+}
+
+func TestKluster_encryptPlanFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		encrypt bool
+	}{
+		{"encryption at rest", true},
+		{"no encryption", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "kubekit-plan")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+
+			if tt.encrypt {
+				SetEncryption(newTestEnvelope(t, dir, "kubekit.key"))
+				defer SetEncryption(nil)
+			}
+
+			content := []byte("plan with the credentials")
+			planFile := filepath.Join(dir, "kkdemo.tfplan")
+			if err := ioutil.WriteFile(planFile, content, 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			k := &Kluster{Name: "kkdemo", ui: parentUI}
+			if err := k.encryptPlanFile(planFile); err != nil {
+				t.Fatalf("encryptPlanFile() error = %v", err)
+			}
+			data, err := ioutil.ReadFile(planFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if crypto.IsEnvelope(data) != tt.encrypt {
+				t.Errorf("encryptPlanFile() encrypted = %v, want %v", crypto.IsEnvelope(data), tt.encrypt)
+			}
+
+			filename, remove, err := decryptedFile(planFile)
+			if err != nil {
+				t.Fatalf("decryptedFile() error = %v", err)
+			}
+			if got, _ := ioutil.ReadFile(filename); string(got) != string(content) {
+				t.Errorf("decryptedFile() content = %q, want %q", got, content)
+			}
+			if filename != planFile {
+				info, err := os.Stat(filename)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if info.Mode().Perm() != 0600 {
+					t.Errorf("decryptedFile() file mode = %v, want 0600", info.Mode().Perm())
+				}
+			}
+			remove()
+			if _, err := os.Stat(filename); tt.encrypt && !os.IsNotExist(err) {
+				t.Errorf("decryptedFile() the decrypted plan file was not removed")
+			}
+		})
+	}
+}
@@ -0,0 +1,336 @@
+package kluster
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/liferaft/kubekit/pkg/configurator"
+	"github.com/liferaft/kubekit/pkg/configurator/kube"
+	"github.com/liferaft/kubekit/pkg/provisioner"
+	"github.com/liferaft/kubekit/pkg/provisioner/state"
+)
+
+// StartTimeout is the maximum time to wait for the Kubernetes nodes to be ready
+// after they are started
+const StartTimeout = 10 * time.Minute
+
+// stopServicesCMD and startServicesCMD stop and start the Kubernetes services
+// on the platforms where KubeKit cannot power on/off the nodes
+const (
+	stopServicesCMD  = "systemctl stop kubelet; systemctl stop docker"
+	startServicesCMD = "systemctl start docker; systemctl start kubelet"
+)
+
+// Start starts the cluster, or only the given nodes or the nodes in the given
+// pools. On the platforms with a provisioner able to power on the nodes, they
+// are powered on, otherwise the Kubernetes services are started on them. The
+// nodes are uncordoned once they are ready
+func (k *Kluster) Start(nodes, pools []string) error {
+	platformName := k.Platform()
+	logPrefix := fmt.Sprintf("KubeKit [ %s@%s ]", k.Name, platformName)
+	k.ui.SetLogPrefix(logPrefix)
+
+	if err := k.LoadState(); err != nil {
+		return err
+	}
+
+	hosts, all, err := k.powerHosts(nodes, pools)
+	if err != nil {
+		return err
+	}
+
+	k.ui.Log.Infof("starting %d nodes of cluster %q", len(hosts), k.Name)
+
+	if pm, ok := k.provisioner[platformName].(provisioner.PowerManager); ok {
+		var stateNodes []*state.Node
+		if !all {
+			stateNodes = toStateNodes(hosts)
+		}
+		if err := pm.PowerOn(stateNodes); err != nil {
+			return err
+		}
+		if err := k.refreshAddresses(); err != nil {
+			return err
+		}
+	} else if err := k.execServices(hosts, startServicesCMD, true); err != nil {
+		return err
+	}
+
+	if err := k.uncordonNodes(hosts); err != nil {
+		return err
+	}
+
+	k.State[platformName].Status = RunningStatus.String()
+
+	return nil
+}
+
+// Stop stops the cluster, or only the given nodes or the nodes in the given
+// pools. When only some nodes are stopped they are drained first. On the
+// platforms with a provisioner able to power off the nodes, they are powered
+// off, otherwise the Kubernetes services are stopped on them
+func (k *Kluster) Stop(nodes, pools []string) error {
+	platformName := k.Platform()
+	logPrefix := fmt.Sprintf("KubeKit [ %s@%s ]", k.Name, platformName)
+	k.ui.SetLogPrefix(logPrefix)
+
+	if err := k.LoadState(); err != nil {
+		return err
+	}
+
+	hosts, all, err := k.powerHosts(nodes, pools)
+	if err != nil {
+		return err
+	}
+
+	k.ui.Log.Infof("stopping %d nodes of cluster %q", len(hosts), k.Name)
+
+	// There is no place to move the pods when the entire cluster is stopped, so
+	// the nodes are only cordoned and drained when some of them are stopped
+	if !all {
+		if err := k.cordonNodes(hosts); err != nil {
+			return err
+		}
+	}
+
+	if pm, ok := k.provisioner[platformName].(provisioner.PowerManager); ok {
+		var stateNodes []*state.Node
+		if !all {
+			stateNodes = toStateNodes(hosts)
+		}
+		if err := pm.PowerOff(stateNodes); err != nil {
+			return err
+		}
+	} else if err := k.execServices(hosts, stopServicesCMD, false); err != nil {
+		return err
+	}
+
+	if all {
+		k.State[platformName].Status = StoppedStatus.String()
+	}
+
+	return nil
+}
+
+// Restart stops and starts the cluster, or only the given nodes or the nodes in
+// the given pools
+func (k *Kluster) Restart(nodes, pools []string) error {
+	if err := k.Stop(nodes, pools); err != nil {
+		return err
+	}
+	return k.Start(nodes, pools)
+}
+
+// powerHosts returns the hosts to start or stop and if they are all the cluster
+// nodes
+func (k *Kluster) powerHosts(nodes, pools []string) (configurator.Hosts, bool, error) {
+	platformName := k.Platform()
+
+	switch platformName {
+	case "eks":
+		return nil, false, fmt.Errorf("the %s platform does not support to start or stop the cluster nodes", platformName)
+	}
+
+	allHosts := k.State[platformName].Nodes
+	hosts := allHosts
+	if len(nodes) != 0 {
+		hosts = allHosts.FilterByNode(nodes...)
+	} else if len(pools) != 0 {
+		hosts = allHosts.FilterByPool(pools...)
+	}
+	if len(hosts) == 0 {
+		return nil, false, fmt.Errorf("no nodes found in the cluster %q matching the given nodes or pools", k.Name)
+	}
+
+	return hosts, len(hosts) == len(allHosts), nil
+}
+
+// execServices executes the given command to start or stop the Kubernetes
+// services on the given hosts. The masters are started before the workers and
+// stopped after them
+func (k *Kluster) execServices(hosts configurator.Hosts, command string, mastersFirst bool) error {
+	masters := hosts.FilterByRolePrefix("master")
+	workers := hosts.Difference(masters)
+
+	groups := []configurator.Hosts{workers, masters}
+	if mastersFirst {
+		groups = []configurator.Hosts{masters, workers}
+	}
+
+	for _, group := range groups {
+		if len(group) == 0 {
+			continue
+		}
+		addresses := []string{}
+		for _, h := range group {
+			addresses = append(addresses, h.PublicIP)
+		}
+		if _, err := k.Exec(command, "", addresses, nil, true); err != nil {
+			return fmt.Errorf("failed to execute %q on the nodes %v. %s", command, addresses, err)
+		}
+	}
+
+	return nil
+}
+
+// refreshAddresses updates the state of the cluster after the nodes are
+// powered on. On EC2 the nodes without an elastic IP get a new public IP so the
+// state and the kubeconfig file have to be updated. The state is only
+// refreshed, the infrastructure is not changed
+func (k *Kluster) refreshAddresses() error {
+	platformName := k.Platform()
+	if platformName != "ec2" {
+		return nil
+	}
+
+	r, ok := k.provisioner[platformName].(provisioner.Refresher)
+	if !ok {
+		return nil
+	}
+
+	unlock, err := k.lockState("refresh")
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	k.ui.Log.Infof("refreshing the state of cluster %q to get the new IP addresses", k.Name)
+	if err := r.Refresh(); err != nil {
+		return fmt.Errorf("failed to refresh the state of cluster %q. %s", k.Name, err)
+	}
+	if err := k.SaveState(); err != nil {
+		return err
+	}
+	if err := k.LoadState(); err != nil {
+		return err
+	}
+
+	return k.CreateKubeConfigFile()
+}
+
+// cordonNodes cordons and drains the given nodes
+func (k *Kluster) cordonNodes(hosts configurator.Hosts) error {
+	client, err := k.KubeClient()
+	if err != nil {
+		return fmt.Errorf("failed to create the Kubernetes client to drain the nodes. %s", err)
+	}
+
+	for _, h := range hosts {
+		name, err := client.NodeName(h.PrivateDNS, h.PublicDNS, h.PrivateIP, h.PublicIP)
+		if err != nil {
+			return err
+		}
+		if len(name) == 0 {
+			k.ui.Log.Warnf("the node %s was not found in Kubernetes, it won't be drained", h.PublicIP)
+			continue
+		}
+		k.ui.Log.Infof("draining node %s", name)
+		if err := client.DrainNode(name, DrainTimeout); err != nil {
+			return fmt.Errorf("failed to drain node %s. %s", name, err)
+		}
+	}
+
+	return nil
+}
+
+// uncordonNodes waits for the Kubernetes nodes of the given hosts to be ready
+// and makes them schedulable again
+func (k *Kluster) uncordonNodes(hosts configurator.Hosts) error {
+	client, err := k.KubeClient()
+	if err != nil {
+		return fmt.Errorf("failed to create the Kubernetes client to uncordon the nodes. %s", err)
+	}
+
+	if err := k.waitNodesReady(client, hosts, StartTimeout); err != nil {
+		return err
+	}
+
+	for _, h := range hosts {
+		name, err := client.NodeName(h.PrivateDNS, h.PublicDNS, h.PrivateIP, h.PublicIP)
+		if err != nil {
+			return err
+		}
+		if len(name) == 0 {
+			continue
+		}
+		if err := client.CordonNode(name, false); err != nil {
+			return fmt.Errorf("failed to uncordon node %s. %s", name, err)
+		}
+	}
+
+	return nil
+}
+
+// waitNodesReady waits until the Kubernetes nodes of the given hosts are ready
+// or the timeout is reached. If no hosts are given, it waits for all the
+// Kubernetes nodes of the cluster
+func (k *Kluster) waitNodesReady(client *kube.Client, hosts configurator.Hosts, timeout time.Duration) error {
+	if len(hosts) == 0 {
+		k.ui.Log.Infof("waiting for the Kubernetes nodes to be ready")
+	} else {
+		k.ui.Log.Infof("waiting for %d Kubernetes nodes to be ready", len(hosts))
+	}
+	expired := time.After(timeout)
+	tick := time.Tick(10 * time.Second)
+	for {
+		notReady, err := nodesNotReady(client, hosts)
+		if err == nil && len(notReady) == 0 {
+			return nil
+		}
+		select {
+		case <-expired:
+			if err != nil {
+				return fmt.Errorf("timeout waiting for the Kubernetes nodes to be ready. %s", err)
+			}
+			return fmt.Errorf("timeout waiting for the Kubernetes nodes to be ready, the nodes %s are not ready", strings.Join(notReady, ", "))
+		case <-tick:
+		}
+	}
+}
+
+// nodesNotReady returns the Kubernetes nodes of the given hosts that are not
+// ready, or all the nodes that are not ready if no hosts are given. The hosts
+// without a Kubernetes node are returned by their IP address
+func nodesNotReady(client *kube.Client, hosts configurator.Hosts) ([]string, error) {
+	if len(hosts) == 0 {
+		return client.NodesNotReady()
+	}
+
+	notReady := []string{}
+	for _, h := range hosts {
+		name, err := client.NodeName(h.PrivateDNS, h.PublicDNS, h.PrivateIP, h.PublicIP)
+		if err != nil {
+			return nil, err
+		}
+		if len(name) == 0 {
+			notReady = append(notReady, h.PublicIP)
+			continue
+		}
+		ready, err := client.NodeReady(name)
+		if err != nil {
+			return nil, err
+		}
+		if !ready {
+			notReady = append(notReady, name)
+		}
+	}
+
+	return notReady, nil
+}
+
+// toStateNodes converts the given hosts to the nodes used by the provisioners
+func toStateNodes(hosts configurator.Hosts) []*state.Node {
+	nodes := make([]*state.Node, 0, len(hosts))
+	for _, h := range hosts {
+		nodes = append(nodes, &state.Node{
+			PublicIP:   h.PublicIP,
+			PrivateIP:  h.PrivateIP,
+			PublicDNS:  h.PublicDNS,
+			PrivateDNS: h.PrivateDNS,
+			RoleName:   h.RoleName,
+			Pool:       h.Pool,
+		})
+	}
+	return nodes
+}
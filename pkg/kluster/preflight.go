@@ -0,0 +1,85 @@
+package kluster
+
+import (
+	"github.com/liferaft/kubekit/pkg/preflight"
+)
+
+// Preflight verifies the cluster is ready to be provisioned or configured. If
+// withNodes is false, only the cluster checks are executed, otherwise the
+// checks are also executed on the nodes through SSH. The checks with a name in
+// skip are not executed
+func (k *Kluster) Preflight(withNodes bool, skip ...string) (preflight.Results, error) {
+	for _, name := range skip {
+		if name == preflight.SkipAll {
+			return preflight.Results{}, nil
+		}
+	}
+
+	target, err := k.preflightTarget(withNodes)
+	if err != nil {
+		return nil, err
+	}
+
+	// the nodes of eks and aks are not accessible through SSH
+	platform := k.Platform()
+	if len(target.Nodes) != 0 && platform != "eks" && platform != "aks" {
+		c, err := k.newCommandFor(nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		defer c.Close()
+		for i := range c.Hosts {
+			if sshConfig := c.Hosts[i].GetSSHConfig(); sshConfig != nil {
+				target.Nodes[i].Runner = sshConfig
+			}
+		}
+	}
+
+	return preflight.Run(target, preflight.DefaultChecks(), skip...), nil
+}
+
+func (k *Kluster) preflightTarget(withNodes bool) (*preflight.Target, error) {
+	platform := k.Platform()
+
+	target := &preflight.Target{
+		Cluster:  k.Name,
+		Platform: platform,
+	}
+	if k.Config != nil {
+		target.ClusterCIDR = k.Config.KubeClusterCidr
+		target.ServicesCIDR = k.Config.KubeServicesCidr
+	}
+
+	switch platform {
+	case "raw", "stacki", "vra":
+	default:
+		credentials, err := k.loadCredentials()
+		if err != nil {
+			return nil, err
+		}
+		target.Credentials = credentials
+	}
+
+	state, ok := k.State[platform]
+	if !ok || !withNodes {
+		return target, nil
+	}
+
+	switch ParseStatus(state.Status) {
+	case CreatedStatus, RunningStatus, StoppedStatus, FailedConfigurationStatus:
+		target.Configured = true
+	}
+
+	for _, host := range state.Nodes {
+		target.Nodes = append(target.Nodes, preflight.Node{
+			Name:       host.PublicIP,
+			Role:       host.RoleName,
+			PublicIP:   host.PublicIP,
+			PrivateIP:  host.PrivateIP,
+			PublicDNS:  host.PublicDNS,
+			PrivateDNS: host.PrivateDNS,
+		})
+	}
+
+	return target, nil
+}
@@ -2,8 +2,6 @@ package kluster
 
 import (
 	"fmt"
-
-	"github.com/kraken/terraformer"
 )
 
 func (k *Kluster) provision(destroy bool) error {
@@ -11,6 +9,19 @@ func (k *Kluster) provision(destroy bool) error {
 	logPrefix := fmt.Sprintf("KubeKit [ %s@%s ]", k.Name, platformName)
 	k.ui.SetLogPrefix(logPrefix)
 
+	operation := "provision"
+	if destroy {
+		operation = "terminate"
+	}
+	unlock, err := k.lockState(operation)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	k.ui.SetCluster(k.Name)
+	k.ui.EmitPhase(operation)
+
 	k.LoadState()
 	k.ui.Log.Debug("state(s) loaded")
 
@@ -22,7 +33,8 @@ func (k *Kluster) provision(destroy bool) error {
 	logPrefix = fmt.Sprintf("Provisioner [ %s@%s ]", k.Name, platformName)
 	k.ui.SetLogPrefix(logPrefix)
 
-	err := p.Apply(destroy)
+	k.setKnownHosts(p)
+	err = p.Apply(destroy)
 	defer k.SaveState()
 	defer k.ui.TerminateAllNotifications("")
 
@@ -38,8 +50,13 @@ func (k *Kluster) provision(destroy bool) error {
 	} else {
 		if destroy {
 			k.State[platformName].Status = TerminatedStatus.String()
+			// the IP addresses of the terminated nodes may be used by other hosts
+			if err := k.DeleteAllHostKeys(); err != nil {
+				k.ui.Log.Warnf("failed to delete the SSH host keys of the terminated nodes. %s", err)
+			}
 		} else {
 			k.State[platformName].Status = ProvisionedStatus.String()
+			k.seedHostKeys(p)
 		}
 	}
 	return err
@@ -54,31 +71,3 @@ func (k *Kluster) Create() error {
 func (k *Kluster) Terminate() error {
 	return k.provision(true)
 }
-
-// Plan prints to the UI and Log the plan before applying the changes
-func (k *Kluster) Plan(destroy bool) error {
-	platformName := k.Platform()
-
-	k.LoadState()
-	k.ui.Log.Debug("state(s) loaded")
-
-	p := k.provisioner[platformName]
-
-	logPrefix := fmt.Sprintf("Provisioner [ %s@%s ]", k.Name, platformName)
-	k.ui.SetLogPrefix(logPrefix)
-
-	plan, err := p.Plan(destroy)
-	if err != nil {
-		return err
-	}
-	k.ui.Log.Debugf("plan to apply: %v", plan)
-
-	stats := terraformer.NewStats(plan)
-	statStr := fmt.Sprintf("Actions: %d to add, %d to change, %d to destroy", stats.Add, stats.Change, stats.Destroy)
-	k.ui.Log.Infof(statStr)
-
-	logPrefix = fmt.Sprintf("KubeKit [ %s@%s ]", k.Name, platformName)
-	k.ui.SetLogPrefix(logPrefix)
-
-	return err
-}
@@ -0,0 +1,258 @@
+package kluster
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/liferaft/kubekit/pkg/configurator"
+	"github.com/liferaft/kubekit/pkg/configurator/ssh"
+	"github.com/liferaft/kubekit/pkg/crypto/tls"
+)
+
+// serviceAccountKeyName is the CA key pair used to sign the service account
+// tokens
+const serviceAccountKeyName = "srv_acc"
+
+// certAliases are the names accepted to identify a certificate to rotate
+// besides the certificate name
+var certAliases = map[string]string{
+	"apiserver":          APIServerCertName,
+	"kube-apiserver":     APIServerCertName,
+	"proxy":              "kube_proxy",
+	"kube-proxy":         "kube_proxy",
+	"controller-manager": "kube_controller",
+	"kube-controller":    "kube_controller",
+	"scheduler":          "kube_scheduler",
+	"kube-scheduler":     "kube_scheduler",
+	"etcd":               "etcd_node",
+}
+
+// certServices are the services to restart on the nodes when a certificate is
+// rotated
+var certServices = map[string][]string{
+	APIServerCertName: {"kube-apiserver"},
+	"admin":           {"kube-apiserver"},
+	"etcd_node":       {"etcd", "kube-apiserver"},
+	"kube_controller": {"kube-controller-manager"},
+	"kube_scheduler":  {"kube-scheduler"},
+	"kubelet":         {"kubelet"},
+	"kube_proxy":      {"kube-proxy"},
+}
+
+// masterServices are the services running only on the master nodes, in the
+// order to restart them
+var masterServices = []string{"etcd", "kube-apiserver", "kube-controller-manager", "kube-scheduler"}
+
+// nodeServices are the services running on every node, in the order to
+// restart them
+var nodeServices = []string{"kubelet", "kube-proxy"}
+
+// CertNamesFrom returns the certificate names from the given names or aliases,
+// i.e. 'apiserver' is the certificate 'node'
+func CertNamesFrom(names []string) ([]string, error) {
+	certNames := []string{}
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if certName, ok := certAliases[name]; ok {
+			name = certName
+		}
+		if _, ok := CertNames[name]; !ok {
+			return nil, fmt.Errorf("unknown certificate %q", name)
+		}
+		if !inList(certNames, name) {
+			certNames = append(certNames, name)
+		}
+	}
+	return certNames, nil
+}
+
+// servicesFor returns the services to restart on the master nodes and the
+// services to restart on every node when the given certificates are rotated
+func servicesFor(certNames []string) (masters []string, nodes []string) {
+	services := []string{}
+	for _, name := range certNames {
+		services = append(services, certServices[name]...)
+	}
+	for _, service := range masterServices {
+		if inList(services, service) {
+			masters = append(masters, service)
+		}
+	}
+	for _, service := range nodeServices {
+		if inList(services, service) {
+			nodes = append(nodes, service)
+		}
+	}
+	return masters, nodes
+}
+
+// restartServicesCmd returns the command to restart the given services on a
+// node. The kubelet is restarted with systemd, the other services are
+// containers restarted by the kubelet when they are stopped
+func restartServicesCmd(services []string) string {
+	commands := []string{}
+	for _, service := range services {
+		if service == "kubelet" {
+			commands = append(commands, "systemctl restart kubelet")
+			continue
+		}
+		commands = append(commands, fmt.Sprintf("docker ps -q --filter name=k8s_%s_ | xargs -r docker stop", service))
+	}
+	return strings.Join(commands, "; ")
+}
+
+// RotateCertificates regenerates the certificates of the cluster, all of them
+// or only the given certificate names, signed by the current CA certificates,
+// uploads them to the nodes and restarts the affected services one node at a
+// time, the masters first. The CA certificates are not rotated, the pods trust
+// the CA certificate of their service account and would not trust a new one
+func (k *Kluster) RotateCertificates(names []string) error {
+	platformName := k.Platform()
+	switch platformName {
+	case "eks", "aks":
+		return fmt.Errorf("the certificates of the %s platform cannot be rotated by KubeKit", platformName)
+	}
+
+	logPrefix := fmt.Sprintf("Certificates [ %s@%s ]", k.Name, platformName)
+	k.ui.SetLogPrefix(logPrefix)
+
+	k.LoadState()
+
+	certNames, err := CertNamesFrom(names)
+	if err != nil {
+		return err
+	}
+	if len(certNames) == 0 {
+		for name := range CertNames {
+			certNames = append(certNames, name)
+		}
+		sort.Strings(certNames)
+	}
+
+	baseCertsDir, err := k.makeCertDir(platformName)
+	if err != nil {
+		return err
+	}
+	if err := k.loadCertificates(baseCertsDir); err != nil {
+		return err
+	}
+
+	k.ui.Log.Infof("rotating the certificates %s", strings.Join(certNames, ", "))
+	if _, err := k.genCertificates(baseCertsDir, platformName, true, certNames...); err != nil {
+		return err
+	}
+	if inList(certNames, "admin") {
+		if err := k.CreateKubeConfigFile(); err != nil {
+			return err
+		}
+	}
+
+	if err := k.ApplyClientCertificates(false); err != nil {
+		return err
+	}
+
+	for _, name := range certNames {
+		if _, ok := certServices[name]; !ok {
+			k.ui.Log.Warnf("the certificate %s is used by the Kubernetes resources, apply the configuration to use the new certificate", name)
+		}
+	}
+
+	masters, nodes := servicesFor(certNames)
+	return k.restartServices(masters, nodes)
+}
+
+// loadCertificates loads the CA certificates and the signed certificates, not
+// the certificates per node, from the certificates directory
+func (k *Kluster) loadCertificates(baseCertsDir string) error {
+	k.certificates = make(tls.KeyPairs, len(CACertNames)+len(CertNames))
+
+	external, err := k.externalCAs(baseCertsDir, nil)
+	if err != nil {
+		return err
+	}
+	for name, caCert := range CACertNames {
+		// The external CAs do not have a private key, only the certificate
+		if external[name] {
+			kp, err := tls.NewExternalCAKeyPair("", baseCertsDir, name, caCert.CN)
+			if err != nil {
+				return fmt.Errorf("failed to load the external CA certificate %s. %s", name, err)
+			}
+			k.certificates[name] = kp
+			continue
+		}
+
+		kp, err := tls.Load(baseCertsDir, name, caCert.CN)
+		if err == nil {
+			k.certificates[name] = kp
+			continue
+		}
+		if os.IsNotExist(err) {
+			return fmt.Errorf("the CA certificate %s was not found, generate the certificates with 'kubekit init certificates'. %s", name, err)
+		}
+		return fmt.Errorf("failed to load the CA certificate %s. %s", name, err)
+	}
+
+	for name, cert := range CertNames {
+		if kp, err := tls.Load(baseCertsDir, name, cert.CN); err == nil {
+			k.certificates[name] = kp
+		}
+	}
+
+	return nil
+}
+
+// restartServices restarts the given services on the masters and on every
+// node, one node at a time starting with the masters, waiting for the
+// Kubernetes node to be ready before to continue with the next node
+func (k *Kluster) restartServices(mastersServices, nodesServices []string) error {
+	if len(mastersServices) == 0 && len(nodesServices) == 0 {
+		return nil
+	}
+
+	hosts := k.State[k.Platform()].Nodes
+	masters := hosts.FilterByRolePrefix("master")
+	workers := hosts.Difference(masters)
+
+	client, err := k.KubeClient()
+	if err != nil {
+		k.ui.Log.Warnf("failed to create the Kubernetes client, the nodes readiness won't be verified. %s", err)
+	}
+
+	restart := func(host configurator.Host, services []string) error {
+		if len(services) == 0 {
+			return nil
+		}
+		k.ui.Log.Infof("restarting %s on node %s", strings.Join(services, ", "), host.PublicIP)
+		if _, err := k.Exec("sh -c "+ssh.ShellQuote(restartServicesCmd(services)), "", []string{host.PublicIP}, nil, true); err != nil {
+			return fmt.Errorf("failed to restart the services on the node %s. %s", host.PublicIP, err)
+		}
+		if client == nil {
+			return nil
+		}
+		return k.waitNodesReady(client, configurator.Hosts{host}, StartTimeout)
+	}
+
+	for _, host := range masters {
+		if err := restart(host, append(append([]string{}, mastersServices...), nodesServices...)); err != nil {
+			return err
+		}
+	}
+	for _, host := range workers {
+		if err := restart(host, nodesServices); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func inList(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,77 @@
+package kluster
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCertNamesFrom(t *testing.T) {
+	tests := []struct {
+		name    string
+		names   []string
+		want    []string
+		wantErr bool
+	}{
+		{"none", []string{}, []string{}, false},
+		{"certificate names", []string{"kubelet", "kube_proxy"}, []string{"kubelet", "kube_proxy"}, false},
+		{"aliases", []string{"apiserver", " etcd", "scheduler"}, []string{"node", "etcd_node", "kube_scheduler"}, false},
+		{"duplicated", []string{"apiserver", "node"}, []string{"node"}, false},
+		{"unknown", []string{"kubelet", "foo"}, nil, true},
+		{"ca", []string{"root_ca"}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CertNamesFrom(tt.names)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CertNamesFrom() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CertNamesFrom() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServicesFor(t *testing.T) {
+	tests := []struct {
+		name        string
+		certNames   []string
+		wantMasters []string
+		wantNodes   []string
+	}{
+		{"kubelet", []string{"kubelet"}, nil, []string{"kubelet"}},
+		{"apiserver and etcd", []string{"node", "etcd_node"}, []string{"etcd", "kube-apiserver"}, nil},
+		{"all", []string{"kube_proxy", "kube_scheduler", "kubelet", "admin", "kube_controller"}, []string{"kube-apiserver", "kube-controller-manager", "kube-scheduler"}, []string{"kubelet", "kube-proxy"}},
+		{"no services", []string{"ingress", "opa"}, nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotMasters, gotNodes := servicesFor(tt.certNames)
+			if !reflect.DeepEqual(gotMasters, tt.wantMasters) {
+				t.Errorf("servicesFor() masters = %v, want %v", gotMasters, tt.wantMasters)
+			}
+			if !reflect.DeepEqual(gotNodes, tt.wantNodes) {
+				t.Errorf("servicesFor() nodes = %v, want %v", gotNodes, tt.wantNodes)
+			}
+		})
+	}
+}
+
+func TestRestartServicesCmd(t *testing.T) {
+	tests := []struct {
+		name     string
+		services []string
+		want     string
+	}{
+		{"kubelet", []string{"kubelet"}, "systemctl restart kubelet"},
+		{"containers", []string{"etcd", "kube-apiserver"}, "docker ps -q --filter name=k8s_etcd_ | xargs -r docker stop; docker ps -q --filter name=k8s_kube-apiserver_ | xargs -r docker stop"},
+		{"kubelet and container", []string{"kubelet", "kube-proxy"}, "systemctl restart kubelet; docker ps -q --filter name=k8s_kube-proxy_ | xargs -r docker stop"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := restartServicesCmd(tt.services); got != tt.want {
+				t.Errorf("restartServicesCmd() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package kluster
+
+import (
+	"crypto/x509"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// defaultServicesCIDR is the Kubernetes services CIDR when the parameter
+// `kube_services_cidr` is not set
+const defaultServicesCIDR = "172.21.0.0/16"
+
+// KubernetesServiceIP returns the IP address of the `kubernetes` service for
+// the given services CIDR, which is always the first IP address of the range
+func KubernetesServiceIP(cidr string) (string, error) {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return "", fmt.Errorf("invalid services CIDR %q. %s", cidr, err)
+	}
+	if ones, bits := ipNet.Mask.Size(); bits-ones < 2 {
+		return "", fmt.Errorf("the services CIDR %q is too small", cidr)
+	}
+
+	ip := make(net.IP, len(ipNet.IP))
+	copy(ip, ipNet.IP)
+	for i := len(ip) - 1; i >= 0; i-- {
+		ip[i]++
+		if ip[i] != 0 {
+			break
+		}
+	}
+
+	return ip.String(), nil
+}
+
+// kubeServiceIP returns the IP address of the `kubernetes` service from the
+// services CIDR in the cluster configuration. The parameter `kube_service_ip`
+// is ignored if it's not the first IP address of the services CIDR
+func (k *Kluster) kubeServiceIP() (string, error) {
+	cidr := defaultServicesCIDR
+	if k.Config != nil && len(k.Config.KubeServicesCidr) != 0 {
+		cidr = k.Config.KubeServicesCidr
+	}
+	serviceIP, err := KubernetesServiceIP(cidr)
+	if err != nil {
+		return "", err
+	}
+
+	if k.Config != nil && len(k.Config.KubeServiceIP) != 0 && k.Config.KubeServiceIP != serviceIP {
+		k.ui.Log.Warnf("the kube_service_ip %s is not the first IP address of the kube_services_cidr %s, the certificates will use %s", k.Config.KubeServiceIP, cidr, serviceIP)
+	}
+
+	return serviceIP, nil
+}
+
+// extraSANs returns the additional DNS names and IP addresses for each
+// certificate from the parameters `certificates_extra_dns_names` and
+// `certificates_extra_ips`. The certificates are identified by name or alias,
+// i.e. 'apiserver' is the certificate 'node'
+func (k *Kluster) extraSANs() (dnsNames map[string][]string, ipAddresses map[string][]string, err error) {
+	dnsNames = map[string][]string{}
+	ipAddresses = map[string][]string{}
+	if k.Config == nil {
+		return dnsNames, ipAddresses, nil
+	}
+
+	for name, names := range k.Config.CertificatesExtraDNSNames {
+		certNames, err := CertNamesFrom([]string{name})
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid parameter certificates_extra_dns_names. %s", err)
+		}
+		for _, dnsName := range names {
+			dnsName = strings.TrimSpace(dnsName)
+			if len(dnsName) == 0 || net.ParseIP(dnsName) != nil {
+				return nil, nil, fmt.Errorf("invalid DNS name %q for the certificate %s, use certificates_extra_ips for the IP addresses", dnsName, name)
+			}
+			dnsNames[certNames[0]] = append(dnsNames[certNames[0]], dnsName)
+		}
+	}
+
+	for name, ips := range k.Config.CertificatesExtraIPs {
+		certNames, err := CertNamesFrom([]string{name})
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid parameter certificates_extra_ips. %s", err)
+		}
+		for _, ip := range ips {
+			ip = strings.TrimSpace(ip)
+			if net.ParseIP(ip) == nil {
+				return nil, nil, fmt.Errorf("invalid IP address %q for the certificate %s", ip, name)
+			}
+			ipAddresses[certNames[0]] = append(ipAddresses[certNames[0]], ip)
+		}
+	}
+
+	return dnsNames, ipAddresses, nil
+}
+
+// verifySANs returns an error if any of the given addresses, IP addresses or
+// DNS names, is not a Subject Alternative Name of the certificate
+func verifySANs(cert *x509.Certificate, addresses []string) error {
+	missing := []string{}
+	for _, address := range addresses {
+		if len(address) == 0 || inList(missing, address) {
+			continue
+		}
+		if err := cert.VerifyHostname(address); err != nil {
+			missing = append(missing, address)
+		}
+	}
+	if len(missing) != 0 {
+		return fmt.Errorf("the certificate %s does not include the addresses %s", cert.Subject.CommonName, strings.Join(missing, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+package kluster
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/liferaft/kubekit/pkg/configurator"
+	"github.com/liferaft/kubekit/pkg/crypto/tls"
+)
+
+func TestKubernetesServiceIP(t *testing.T) {
+	tests := []struct {
+		name    string
+		cidr    string
+		want    string
+		wantErr bool
+	}{
+		{"default", "172.21.0.0/16", "172.21.0.1", false},
+		{"host bits set", "10.96.12.34/12", "10.96.0.1", false},
+		{"small range", "192.168.1.0/30", "192.168.1.1", false},
+		{"single address", "10.0.0.255/32", "", true},
+		{"ipv6", "fd00:10:96::/112", "fd00:10:96::1", false},
+		{"invalid", "172.21.0.0", "", true},
+		{"too small", "10.0.0.0/31", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := KubernetesServiceIP(tt.cidr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("KubernetesServiceIP() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("KubernetesServiceIP() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateCluster_kubeServiceIP(t *testing.T) {
+	tests := []struct {
+		name      string
+		variables map[string]string
+		want      string
+	}{
+		{"default", nil, "172.21.0.1"},
+		{"custom services CIDR", map[string]string{"kube_services_cidr": "10.96.0.0/12"}, "10.96.0.1"},
+		{"custom service IP", map[string]string{"kube_services_cidr": "10.96.0.0/12", "kube_service_ip": "10.96.0.10"}, "10.96.0.10"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "kubekit-sans")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+
+			k, err := CreateCluster("kkdemo", "ec2", dir, "yaml", tt.variables, parentUI)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := k.Config.KubeServiceIP; got != tt.want {
+				t.Errorf("CreateCluster() kube_service_ip = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKluster_extraSANs(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *configurator.Config
+		wantDNS map[string][]string
+		wantIPs map[string][]string
+		wantErr bool
+	}{
+		{"no config", nil, map[string][]string{}, map[string][]string{}, false},
+		{"none", &configurator.Config{}, map[string][]string{}, map[string][]string{}, false},
+		{
+			"by name and alias",
+			&configurator.Config{
+				CertificatesExtraDNSNames: map[string][]string{"apiserver": {"api.example.com"}, "ingress": {"*.apps.example.com", " apps.example.com"}},
+				CertificatesExtraIPs:      map[string][]string{"node": {"10.1.0.10"}},
+			},
+			map[string][]string{"node": {"api.example.com"}, "ingress": {"*.apps.example.com", "apps.example.com"}},
+			map[string][]string{"node": {"10.1.0.10"}},
+			false,
+		},
+		{"unknown certificate", &configurator.Config{CertificatesExtraIPs: map[string][]string{"foo": {"10.1.0.10"}}}, nil, nil, true},
+		{"invalid IP", &configurator.Config{CertificatesExtraIPs: map[string][]string{"node": {"api.example.com"}}}, nil, nil, true},
+		{"IP as DNS name", &configurator.Config{CertificatesExtraDNSNames: map[string][]string{"node": {"10.1.0.10"}}}, nil, nil, true},
+		{"empty DNS name", &configurator.Config{CertificatesExtraDNSNames: map[string][]string{"node": {""}}}, nil, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := &Kluster{Config: tt.config}
+			gotDNS, gotIPs, err := k.extraSANs()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Kluster.extraSANs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(gotDNS, tt.wantDNS) {
+				t.Errorf("Kluster.extraSANs() dnsNames = %v, want %v", gotDNS, tt.wantDNS)
+			}
+			if !reflect.DeepEqual(gotIPs, tt.wantIPs) {
+				t.Errorf("Kluster.extraSANs() ipAddresses = %v, want %v", gotIPs, tt.wantIPs)
+			}
+		})
+	}
+}
+
+func TestKluster_genCertificates_SANs(t *testing.T) {
+	clusterDir, err := ioutil.TempDir("", "sans")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(clusterDir)
+
+	k := &Kluster{
+		Name:      "kkdemo",
+		Platforms: map[string]interface{}{"ec2": nil},
+		State: map[string]*State{
+			"ec2": {
+				Address: "kkdemo-api.example.com",
+				Nodes: configurator.Hosts{
+					{PublicIP: "54.1.2.3", PrivateIP: "10.0.0.5", PublicDNS: "ec2-54-1-2-3.compute.amazonaws.com", PrivateDNS: "ip-10-0-0-5.ec2.internal", RoleName: "master"},
+					{PublicIP: "54.1.2.4", PrivateIP: "10.0.0.6", PublicDNS: "ec2-54-1-2-4.compute.amazonaws.com", PrivateDNS: "ip-10-0-0-6.ec2.internal", RoleName: "worker"},
+				},
+			},
+		},
+		Config: &configurator.Config{
+			KubeServicesCidr:          "10.96.0.0/12",
+			CertificatesExtraDNSNames: map[string][]string{"apiserver": {"api.example.com"}, "ingress": {"*.apps.example.com"}},
+			CertificatesExtraIPs:      map[string][]string{"apiserver": {"192.168.0.10"}},
+		},
+		path: filepath.Join(clusterDir, DefaultConfigFilename+".yaml"),
+		ui:   parentUI,
+	}
+	baseCertsDir, err := k.makeCertDir("ec2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	k.certificates = make(tls.KeyPairs, len(CACertNames)+len(CertNames))
+	for name, caCert := range CACertNames {
+		ca, err := tls.NewCAKeyPair(&tls.KeyPair{}, baseCertsDir, name, caCert.CN, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		k.certificates[name] = ca
+	}
+
+	certificates, err := k.genCertificates(baseCertsDir, "ec2", false, APIServerCertName, "ingress", "opa")
+	if err != nil {
+		t.Fatalf("Kluster.genCertificates() error = %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		certName     string
+		wantCovered  []string
+		wantExcluded []string
+	}{
+		{"API server", APIServerCertName, []string{"10.96.0.1", "kubernetes.default", "api.example.com", "192.168.0.10", "kkdemo-api.example.com", "54.1.2.3", "ec2-54-1-2-3.compute.amazonaws.com"}, []string{"172.21.0.1", "app.apps.example.com"}},
+		{"ingress", "ingress", []string{"app.apps.example.com", "54.1.2.4"}, []string{"api.example.com", "10.96.0.1"}},
+		{"opa", "opa", []string{"10.96.0.1", "opa.opa.svc"}, []string{"172.21.0.1", "api.example.com"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kp, ok := certificates[tt.certName]
+			if !ok {
+				t.Fatalf("Kluster.genCertificates() did not generate the certificate %s", tt.certName)
+			}
+			if err := verifySANs(kp.Certificate, tt.wantCovered); err != nil {
+				t.Errorf("Kluster.genCertificates() %s", err)
+			}
+			for _, address := range tt.wantExcluded {
+				if verifySANs(kp.Certificate, []string{address}) == nil {
+					t.Errorf("Kluster.genCertificates() certificate %s includes the address %s", tt.certName, address)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,288 @@
+package kluster
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/liferaft/kubekit/pkg/configurator"
+	"github.com/liferaft/kubekit/pkg/configurator/kube"
+	"github.com/liferaft/kubekit/pkg/provisioner"
+	"github.com/liferaft/kubekit/pkg/provisioner/state"
+)
+
+// DrainTimeout is the maximum time to wait for the pods of a node to be evicted
+const DrainTimeout = 5 * time.Minute
+
+// stopKubeletCMD stops the kubelet on the nodes that are removed from the
+// cluster but are not destroyed by the provisioner
+const stopKubeletCMD = "systemctl stop kubelet; systemctl disable kubelet"
+
+// KubeconfigFile returns the path to the kubeconfig file of this cluster
+func (k *Kluster) KubeconfigFile() string {
+	return filepath.Join(k.CertsDir(), "kubeconfig")
+}
+
+// KubeClient returns a Kubernetes client to access this cluster
+func (k *Kluster) KubeClient() (*kube.Client, error) {
+	return kube.NewClientE("", k.KubeconfigFile(), k.ui)
+}
+
+// PoolSize returns the number of nodes of the given node pool
+func (k *Kluster) PoolSize(pool string) (int, error) {
+	platformName := k.Platform()
+	scaler, ok := k.provisioner[platformName].(provisioner.Scaler)
+	if !ok {
+		return 0, fmt.Errorf("the %s platform does not support scaling", platformName)
+	}
+	return scaler.PoolSize(pool)
+}
+
+// Scale changes the number of nodes of the given node pool to the given size.
+// When the pool is scaled down the departing nodes are drained before the
+// provisioner removes them and they are deleted from Kubernetes. When the pool
+// is scaled up the new nodes are created by the provisioner, or taken from
+// `newNodes` on the platforms that do not create nodes (raw and stacki). It
+// returns the new nodes, which have to be configured with ConfigureNodes
+func (k *Kluster) Scale(pool string, size int, newNodes []*state.Node) (configurator.Hosts, error) {
+	platformName := k.Platform()
+	logPrefix := fmt.Sprintf("KubeKit [ %s@%s ]", k.Name, platformName)
+	k.ui.SetLogPrefix(logPrefix)
+
+	if err := k.LoadState(); err != nil {
+		return nil, err
+	}
+
+	scaler, ok := k.provisioner[platformName].(provisioner.Scaler)
+	if !ok {
+		return nil, fmt.Errorf("the %s platform does not support scaling", platformName)
+	}
+
+	if err := k.ValidateScaleNodes(newNodes); err != nil {
+		return nil, err
+	}
+
+	current, err := scaler.PoolSize(pool)
+	if err != nil {
+		return nil, err
+	}
+	if size == current {
+		k.ui.Log.Infof("the node pool %q already has %d nodes, nothing to scale", pool, size)
+		return configurator.Hosts{}, nil
+	}
+	if size == 0 && pool == "master" {
+		return nil, fmt.Errorf("the node pool %q cannot be scaled to 0 nodes", pool)
+	}
+
+	k.ui.Log.Infof("scaling the node pool %q from %d to %d nodes", pool, current, size)
+
+	status := k.State[platformName].Status
+	before := k.State[platformName].Nodes
+
+	// The Kubernetes node name is required after the nodes are destroyed, so
+	// get them before scale down
+	nodeNames := map[string]string{}
+	if size < current {
+		departing, err := k.departingNodes(pool, current-size, newNodes)
+		if err != nil {
+			return nil, err
+		}
+		if nodeNames, err = k.drainNodes(departing); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := scaler.Scale(pool, size, newNodes); err != nil {
+		return nil, err
+	}
+
+	if err := k.Create(); err != nil {
+		return nil, err
+	}
+	if err := k.LoadState(); err != nil {
+		return nil, err
+	}
+	k.State[platformName].Status = status
+
+	after := k.State[platformName].Nodes
+
+	if removed := before.Difference(after); len(removed) != 0 {
+		if err := k.deleteKubeNodes(removed, nodeNames); err != nil {
+			return nil, err
+		}
+	}
+
+	return after.Difference(before), nil
+}
+
+// ConfigureNodes configures Kubernetes only on the given nodes, identified by
+// IP or DNS, to join them to the existing cluster
+func (k *Kluster) ConfigureNodes(nodes ...string) error {
+	platformName := k.Platform()
+	logPrefix := fmt.Sprintf("KubeKit [ %s@%s ]", k.Name, platformName)
+	k.ui.SetLogPrefix(logPrefix)
+
+	k.ui.Log.Debugf("starting the configuration of nodes %v of cluster %q on %s", nodes, k.Name, platformName)
+
+	pConf := k.provisioner[platformName].Config()
+	clusterDir := k.Dir()
+
+	conf, err := configurator.New(k.Name, platformName, k.State[platformName].Address, k.State[platformName].Port, k.State[platformName].Nodes, k.State[platformName].Data, pConf, k.Config, k.Resources, clusterDir, k.ui)
+	if err != nil {
+		return err
+	}
+	defer conf.Close()
+	conf.SetParallel(k.parallel)
+
+	if err := conf.ConfigureNodes(nodes...); err != nil {
+		k.State[platformName].Status = FailedConfigurationStatus.String()
+		return err
+	}
+
+	k.State[platformName].Status = RunningStatus.String()
+
+	return nil
+}
+
+// ValidateScaleNodes returns an error if the nodes to add or remove are given
+// but the platform does not use them. The platforms that create the nodes
+// (i.e. ec2 or vsphere) always add or remove the last nodes of the pool, so
+// draining other nodes would leave them cordoned
+func (k *Kluster) ValidateScaleNodes(nodes []*state.Node) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	switch platformName := k.Platform(); platformName {
+	case "raw", "stacki":
+		return nil
+	default:
+		return fmt.Errorf("the nodes to add or remove cannot be selected on the %s platform, it always adds or removes the last nodes of the pool. The flag '--addresses' is only for raw and stacki", platformName)
+	}
+}
+
+// departingNodes returns the nodes to remove from the given pool, or an error
+// if they are not exactly `count` nodes of the pool. It's validated before
+// draining the nodes, so a wrong selection does not leave them cordoned. If the
+// nodes to remove are not given, the provisioner removes the last nodes of the
+// pool. The platforms that do not create the nodes (raw and stacki) require
+// the nodes to remove, the last nodes of their address pool may not be the
+// last nodes in the cluster state
+func (k *Kluster) departingNodes(pool string, count int, nodes []*state.Node) (configurator.Hosts, error) {
+	platformName := k.Platform()
+	hosts := k.State[platformName].Nodes.FilterByPool(pool)
+
+	if len(nodes) == 0 {
+		switch platformName {
+		case "raw", "stacki":
+			return nil, fmt.Errorf("the addresses of the %d nodes to remove from the node pool %q are required on the %s platform, use the flag '--addresses'", count, pool, platformName)
+		}
+		if count > len(hosts) {
+			return nil, fmt.Errorf("cannot remove %d nodes from the node pool %q, it has %d nodes in the cluster state", count, pool, len(hosts))
+		}
+		return hosts[len(hosts)-count:], nil
+	}
+
+	if len(nodes) != count {
+		return nil, fmt.Errorf("%d nodes have to be removed to scale down the node pool %q, %d were given", count, pool, len(nodes))
+	}
+
+	departing := configurator.Hosts{}
+	for _, n := range nodes {
+		found := false
+		for _, h := range hosts {
+			if (len(n.PublicIP) != 0 && n.PublicIP == h.PublicIP) || (len(n.PrivateIP) != 0 && n.PrivateIP == h.PrivateIP) {
+				if len(departing.FilterByNode(h.PublicIP)) != 0 {
+					return nil, fmt.Errorf("the node %s is given more than once", h.PublicIP)
+				}
+				departing = append(departing, h)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("the node %s is not in the node pool %q", nodeAddress(n), pool)
+		}
+	}
+
+	return departing, nil
+}
+
+// nodeAddress returns the public IP of the given node, or the private IP if it
+// doesn't have a public one
+func nodeAddress(n *state.Node) string {
+	if len(n.PublicIP) != 0 {
+		return n.PublicIP
+	}
+	return n.PrivateIP
+}
+
+// drainNodes drains the given nodes and returns their Kubernetes node name
+// indexed by the node public IP. On the platforms where KubeKit does not
+// destroy the nodes, the kubelet is stopped so they do not join again
+func (k *Kluster) drainNodes(hosts configurator.Hosts) (map[string]string, error) {
+	nodeNames := make(map[string]string, len(hosts))
+	if len(hosts) == 0 {
+		return nodeNames, nil
+	}
+
+	client, err := k.KubeClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the Kubernetes client to drain the nodes. %s", err)
+	}
+
+	addresses := []string{}
+	for _, h := range hosts {
+		name, err := client.NodeName(h.PrivateDNS, h.PublicDNS, h.PrivateIP, h.PublicIP)
+		if err != nil {
+			return nil, err
+		}
+		if len(name) == 0 {
+			k.ui.Log.Warnf("the node %s was not found in Kubernetes, it won't be drained", h.PublicIP)
+			continue
+		}
+		k.ui.Log.Infof("draining node %s", name)
+		if err := client.DrainNode(name, DrainTimeout); err != nil {
+			return nil, fmt.Errorf("failed to drain node %s. %s", name, err)
+		}
+		nodeNames[h.PublicIP] = name
+		addresses = append(addresses, h.PublicIP)
+	}
+
+	switch k.Platform() {
+	case "raw", "stacki", "vra":
+		if len(addresses) == 0 {
+			break
+		}
+		if _, err := k.Exec(stopKubeletCMD, "", addresses, nil, true); err != nil {
+			k.ui.Log.Warnf("failed to stop the kubelet on the nodes %v. %s", addresses, err)
+		}
+	}
+
+	return nodeNames, nil
+}
+
+// deleteKubeNodes deletes from Kubernetes the given nodes
+func (k *Kluster) deleteKubeNodes(hosts configurator.Hosts, nodeNames map[string]string) error {
+	client, err := k.KubeClient()
+	if err != nil {
+		return fmt.Errorf("failed to create the Kubernetes client to delete the nodes. %s", err)
+	}
+
+	for _, h := range hosts {
+		name, ok := nodeNames[h.PublicIP]
+		if !ok {
+			if name, err = client.NodeName(h.PrivateDNS, h.PublicDNS, h.PrivateIP, h.PublicIP); err != nil {
+				return err
+			}
+		}
+		if len(name) == 0 {
+			continue
+		}
+		k.ui.Log.Infof("deleting node %s", name)
+		if err := client.DeleteNode(name); err != nil {
+			return fmt.Errorf("failed to delete node %s. %s", name, err)
+		}
+	}
+
+	return nil
+}
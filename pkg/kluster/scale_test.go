@@ -0,0 +1,82 @@
+package kluster
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/liferaft/kubekit/pkg/configurator"
+	"github.com/liferaft/kubekit/pkg/provisioner/state"
+)
+
+func TestKluster_ValidateScaleNodes(t *testing.T) {
+	nodes := []*state.Node{{PublicIP: "10.0.0.5"}}
+	tests := []struct {
+		name     string
+		platform string
+		nodes    []*state.Node
+		wantErr  bool
+	}{
+		{"raw", "raw", nodes, false},
+		{"stacki", "stacki", nodes, false},
+		{"ec2", "ec2", nodes, true},
+		{"vsphere", "vsphere", nodes, true},
+		{"ec2 without nodes", "ec2", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := &Kluster{Platforms: map[string]interface{}{tt.platform: nil}}
+			if err := k.ValidateScaleNodes(tt.nodes); (err != nil) != tt.wantErr {
+				t.Errorf("Kluster.ValidateScaleNodes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestKluster_departingNodes(t *testing.T) {
+	nodes := configurator.Hosts{
+		{PublicIP: "10.0.0.1", PrivateIP: "192.168.0.1", RoleName: "master", Pool: "master"},
+		{PublicIP: "10.0.0.2", PrivateIP: "192.168.0.2", RoleName: "worker", Pool: "worker"},
+		{PublicIP: "10.0.0.3", PrivateIP: "192.168.0.3", RoleName: "worker", Pool: "worker"},
+		{PublicIP: "10.0.0.4", PrivateIP: "192.168.0.4", RoleName: "worker", Pool: "worker"},
+	}
+	tests := []struct {
+		name     string
+		platform string
+		count    int
+		nodes    []*state.Node
+		want     []string
+		wantErr  bool
+	}{
+		{"last nodes", "ec2", 2, nil, []string{"10.0.0.3", "10.0.0.4"}, false},
+		{"more nodes than the pool", "ec2", 4, nil, nil, true},
+		{"raw without addresses", "raw", 1, nil, nil, true},
+		{"stacki without addresses", "stacki", 1, nil, nil, true},
+		{"given nodes", "raw", 2, []*state.Node{{PrivateIP: "192.168.0.2"}, {PublicIP: "10.0.0.4"}}, []string{"10.0.0.2", "10.0.0.4"}, false},
+		{"less nodes than the count", "raw", 2, []*state.Node{{PublicIP: "10.0.0.2"}}, nil, true},
+		{"node in other pool", "raw", 1, []*state.Node{{PublicIP: "10.0.0.1"}}, nil, true},
+		{"unknown node", "stacki", 1, []*state.Node{{PublicIP: "10.0.0.9"}}, nil, true},
+		{"repeated node", "raw", 2, []*state.Node{{PublicIP: "10.0.0.2"}, {PrivateIP: "192.168.0.2"}}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := &Kluster{
+				Platforms: map[string]interface{}{tt.platform: nil},
+				State:     map[string]*State{tt.platform: {Nodes: nodes}},
+			}
+			departing, err := k.departingNodes("worker", tt.count, tt.nodes)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Kluster.departingNodes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			got := []string{}
+			for _, h := range departing {
+				got = append(got, h.PublicIP)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Kluster.departingNodes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package kluster
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// errSignPending is returned by a Signer when the certificate request is
+// going to be signed later, outside of KubeKit
+var errSignPending = errors.New("the certificate request is waiting to be signed")
+
+// signerTimeout is the time to wait for a Signer to sign a certificate request,
+// it's replaced in the tests
+var signerTimeout = 2 * time.Minute
+
+// Signer signs the certificate requests with a CA that is external to
+// KubeKit, so KubeKit does not hold the CA private key
+type Signer interface {
+	// Sign returns the PEM encoded certificate for the PEM encoded certificate
+	// request signed by the CA with the given name, or errSignPending if the
+	// request is going to be signed later
+	Sign(caName, certName string, csrPEM []byte) ([]byte, error)
+}
+
+// signer returns the Signer set in the cluster configuration: the HTTP signer
+// if `certificates_signer_url` is set, the command signer if
+// `certificates_signer_command` is set, otherwise the requests are signed by
+// the user from the CSR directory
+func (k *Kluster) signer() (Signer, error) {
+	if k.Config == nil {
+		return &directorySigner{}, nil
+	}
+	url := k.Config.CertificatesSignerURL
+	command := k.Config.CertificatesSignerCommand
+	switch {
+	case len(url) != 0 && len(command) != 0:
+		return nil, fmt.Errorf("only one of the parameters certificates_signer_url or certificates_signer_command can be set")
+	case len(url) != 0:
+		return &httpSigner{url: url, client: &http.Client{Timeout: signerTimeout}}, nil
+	case len(command) != 0:
+		return &commandSigner{command: command}, nil
+	}
+	return &directorySigner{}, nil
+}
+
+// directorySigner leaves the certificate requests in the CSR directory to be
+// signed by the user, the signed certificates are imported the next time the
+// certificates are generated
+type directorySigner struct{}
+
+func (s *directorySigner) Sign(caName, certName string, csrPEM []byte) ([]byte, error) {
+	return nil, errSignPending
+}
+
+// httpSigner sends the certificate requests to a signing endpoint with the
+// CFSSL sign API: a JSON with the `certificate_request` and the CA name as
+// `label`, the response is a JSON with the `result.certificate`
+type httpSigner struct {
+	url    string
+	client *http.Client
+}
+
+type cfsslSignRequest struct {
+	CertificateRequest string `json:"certificate_request"`
+	Label              string `json:"label,omitempty"`
+}
+
+type cfsslSignResponse struct {
+	Success bool `json:"success"`
+	Result  struct {
+		Certificate string `json:"certificate"`
+	} `json:"result"`
+	Errors []struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func (s *httpSigner) Sign(caName, certName string, csrPEM []byte) ([]byte, error) {
+	body, err := json.Marshal(cfsslSignRequest{
+		CertificateRequest: string(csrPEM),
+		Label:              caName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Post(s.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to send the certificate request for %s to %s. %s", certName, s.url, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the response from %s. %s", s.url, err)
+	}
+
+	var signResp cfsslSignResponse
+	if err := json.Unmarshal(data, &signResp); err != nil {
+		return nil, fmt.Errorf("failed to sign the certificate request for %s, unexpected response from %s (%s). %s", certName, s.url, resp.Status, err)
+	}
+	if !signResp.Success || resp.StatusCode != http.StatusOK {
+		messages := []string{}
+		for _, e := range signResp.Errors {
+			messages = append(messages, e.Message)
+		}
+		return nil, fmt.Errorf("failed to sign the certificate request for %s by %s (%s). %s", certName, s.url, resp.Status, strings.Join(messages, ", "))
+	}
+
+	return []byte(signResp.Result.Certificate), nil
+}
+
+// commandSigner executes a local command to sign the certificate requests,
+// such as `cfssl sign`. The command receives the PEM encoded request in the
+// standard input and the CA and certificate names in the environment
+// variables KUBEKIT_CA_NAME and KUBEKIT_CERT_NAME. It has to print the PEM
+// encoded certificate or the CFSSL JSON output with the certificate in `cert`
+type commandSigner struct {
+	command string
+}
+
+func (s *commandSigner) Sign(caName, certName string, csrPEM []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), signerTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "sh", "-c", s.command)
+	cmd.Stdin = bytes.NewReader(csrPEM)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.Env = append(os.Environ(), "KUBEKIT_CA_NAME="+caName, "KUBEKIT_CERT_NAME="+certName)
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, fmt.Errorf("timeout signing the certificate request for %s with the command %q after %s", certName, s.command, signerTimeout)
+		}
+		return nil, fmt.Errorf("failed to sign the certificate request for %s with the command %q. %s: %s", certName, s.command, err, strings.TrimSpace(stderr.String()))
+	}
+
+	output := bytes.TrimSpace(stdout.Bytes())
+	if bytes.HasPrefix(output, []byte("{")) {
+		var cfsslOutput struct {
+			Cert string `json:"cert"`
+		}
+		if err := json.Unmarshal(output, &cfsslOutput); err != nil {
+			return nil, fmt.Errorf("failed to parse the output of the command %q. %s", s.command, err)
+		}
+		return []byte(cfsslOutput.Cert), nil
+	}
+
+	return output, nil
+}
@@ -5,11 +5,11 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
-	"path/filepath"
 
 	"github.com/hashicorp/terraform/states"
 	"github.com/kraken/terraformer"
 	"github.com/liferaft/kubekit/pkg/configurator"
+	"github.com/liferaft/kubekit/pkg/provisioner"
 )
 
 // State represent the final state of one platform. It's basically the
@@ -20,9 +20,13 @@ type State struct {
 	Port    int                    `json:"port,omitempty" yaml:"port,omitempty" mapstructure:"port,omitempty"`
 	Nodes   configurator.Hosts     `json:"nodes,omitempty" yaml:"nodes,omitempty" mapstructure:"nodes,omitempty"`
 	Data    map[string]interface{} `json:"data,omitempty" yaml:"data,omitempty" mapstructure:"data,omitempty"`
+	Release string                 `json:"release,omitempty" yaml:"release,omitempty" mapstructure:"release,omitempty"`
+	Upgrade *UpgradeCheckpoint     `json:"upgrade,omitempty" yaml:"upgrade,omitempty" mapstructure:"upgrade,omitempty"`
 }
 
-// LoadState load the state from the state file for the given platform
+// LoadState load the state from the state backend for the given platform. The
+// state file in the cluster directory is the working copy of the state, it's
+// updated during the Terraform actions and saved to the backend with SaveState
 func (k *Kluster) LoadState() error {
 	platform := k.Platform()
 
@@ -31,20 +35,40 @@ func (k *Kluster) LoadState() error {
 	}
 	stateFilename := k.StateFile()
 
+	backend, err := k.StateBackend()
+	if err != nil {
+		return err
+	}
+
+	k.ui.Log.Debugf("Loading state from the %s state backend", k.backendType())
+	stateBytes, err := backend.Read(platform)
+	if err != nil {
+		return err
+	}
+
 	var state *states.State
 
-	if _, err := os.Stat(stateFilename); !os.IsNotExist(err) {
-		k.ui.Log.Debugf("Loading state from state file %q", stateFilename)
-		// If there is a state file, read it to assign it to the provisioner
-		stateBytes, err := ioutil.ReadFile(stateFilename)
+	if stateBytes != nil {
+		plainStateBytes, err := decryptAtRest(stateBytes)
 		if err != nil {
-			return err
+			return fmt.Errorf("can't decrypt the state from the %s state backend. %s", k.backendType(), err)
 		}
-		stateBuffer := bytes.NewBuffer(stateBytes)
+		state, err = terraformer.LoadState(bytes.NewBuffer(plainStateBytes))
+		if err != nil {
+			return fmt.Errorf("can't load the state from the %s state backend. %s", k.backendType(), err)
+		}
+	}
 
-		state, err = terraformer.LoadState(stateBuffer)
+	// The working copy of a remote state may be outdated, replace it with the
+	// state in the backend
+	if _, isLocal := backend.(*localBackend); !isLocal {
+		if stateBytes != nil {
+			err = ioutil.WriteFile(stateFilename, stateBytes, 0644)
+		} else if _, errStat := os.Stat(stateFilename); errStat == nil {
+			err = os.Remove(stateFilename)
+		}
 		if err != nil {
-			return fmt.Errorf("can't load the state from %q. %s", stateFilename, err)
+			return fmt.Errorf("failed to update the state file %q with the remote state. %s", stateFilename, err)
 		}
 	}
 
@@ -61,7 +85,14 @@ func (k *Kluster) LoadState() error {
 	// 		- the platform was created without state, so it has the empty state
 	// 		- the function PersistStateToFile() creates the state file with the empty state
 	// 		- make sure the state is always up dated in the file
-	p.PersistStateToFile(stateFilename)
+	// If the encryption at rest is enabled, the state file is encrypted
+	if encrypter, ok := p.(provisioner.StateEncrypter); ok && Encryption() != nil {
+		if err := encrypter.PersistStateToEncryptedFile(stateFilename, Encryption()); err != nil {
+			return err
+		}
+	} else {
+		p.PersistStateToFile(stateFilename)
+	}
 
 	if _, ok := k.State[platform]; !ok {
 		k.State[platform] = &State{
@@ -112,50 +143,148 @@ func (k *Kluster) LoadState() error {
 		}
 	}
 
-	k.ui.Log.Debugf("loaded state for cluster on %s from %s", platform, stateFilename)
+	k.ui.Log.Debugf("loaded state for cluster on %s from the %s state backend", platform, k.backendType())
 	return nil
 }
 
-// SaveState saves the state to the state file for the given platform
-// If the state is persisted to a file, there is no need to use this func
+// SaveState saves the state to the state backend for the given platform
 func (k *Kluster) SaveState() error {
 	platform := k.Platform()
 
-	stateDirname, err := k.makeStateDir()
-	if err != nil {
+	if _, err := k.makeStateDir(); err != nil {
 		return err
 	}
 
-	stateFilename := filepath.Join(stateDirname, platform+".tfstate")
-
 	p := k.provisioner[platform]
 	state := p.State()
 
 	if state == nil || state.Empty() {
-		k.ui.Log.Debugf("the state of cluster %q is empty, the tfstate file won't be saved", k.Name)
+		k.ui.Log.Debugf("the state of cluster %q is empty, the state won't be saved", k.Name)
 		return nil
 	}
 
-	lock, err := lockFile(stateFilename)
-	defer lock.Unlock()
+	backend, err := k.StateBackend()
 	if err != nil {
 		return err
 	}
 
-	if _, err := os.Stat(stateFilename); os.IsExist(err) {
-		os.Rename(stateFilename, stateFilename+".bkp")
-		k.ui.Log.Debugf("previous state of cluster %q was backup to %s", k.Name, stateFilename+".bkp")
-	}
-
 	var stateBytes bytes.Buffer
 	if err := terraformer.SaveState(&stateBytes, state); err != nil {
 		return err
 	}
 
-	if err := ioutil.WriteFile(stateFilename, stateBytes.Bytes(), 0644); err != nil {
+	data, err := encryptAtRest(stateBytes.Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to encrypt the state of cluster %q. %s", k.Name, err)
+	}
+
+	if err := backend.Write(platform, data); err != nil {
 		return err
 	}
 
-	k.ui.Log.Debugf("saved state of cluster %q to %s", k.Name, stateFilename)
+	k.ui.Log.Debugf("saved state of cluster %q to the %s state backend", k.Name, k.backendType())
 	return nil
 }
+
+// StateHistory returns the versions of the state of the cluster in the state
+// backend history, the newest version first
+func (k *Kluster) StateHistory() ([]StateVersion, error) {
+	backend, err := k.StateBackend()
+	if err != nil {
+		return nil, err
+	}
+	return backend.History(k.Platform())
+}
+
+// RestoreState replaces the state of the cluster with the given version of the
+// state history. The restored state is saved as a new version, so the previous
+// state can be restored back
+func (k *Kluster) RestoreState(id string) error {
+	platform := k.Platform()
+	logPrefix := fmt.Sprintf("KubeKit [ %s@%s ]", k.Name, platform)
+	k.ui.SetLogPrefix(logPrefix)
+
+	unlock, err := k.lockState("restore the state of")
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	backend, err := k.StateBackend()
+	if err != nil {
+		return err
+	}
+	data, err := backend.ReadVersion(platform, id)
+	if err != nil {
+		return err
+	}
+
+	// the version may be encrypted with a previous key, it's saved with the
+	// current one
+	plainData, err := decryptAtRest(data)
+	if err != nil {
+		return fmt.Errorf("can't decrypt version %s of the state. %s", id, err)
+	}
+	if _, err := terraformer.LoadState(bytes.NewBuffer(plainData)); err != nil {
+		return fmt.Errorf("version %s is not a valid state. %s", id, err)
+	}
+	if data, err = encryptAtRest(plainData); err != nil {
+		return fmt.Errorf("failed to encrypt the state of cluster %q. %s", k.Name, err)
+	}
+	if err := backend.Write(platform, data); err != nil {
+		return err
+	}
+	k.ui.Log.Infof("state of cluster %q restored to version %s", k.Name, id)
+
+	// update the working copy of the state and the nodes of the cluster
+	return k.LoadState()
+}
+
+// StateBackend returns the backend where the Terraform state of the cluster is
+// stored
+func (k *Kluster) StateBackend() (StateBackend, error) {
+	if k.stateBackend != nil {
+		return k.stateBackend, nil
+	}
+	backend, err := NewStateBackend(k.Backend, k.Name, k.StateDir())
+	if err != nil {
+		return nil, err
+	}
+	k.stateBackend = backend
+	return backend, nil
+}
+
+// lockState locks the state of the cluster platform in the state backend for
+// the given operation. The returned function unlocks the state
+func (k *Kluster) lockState(operation string) (func(), error) {
+	platform := k.Platform()
+
+	if _, err := k.makeStateDir(); err != nil {
+		return nil, err
+	}
+	backend, err := k.StateBackend()
+	if err != nil {
+		return nil, err
+	}
+
+	lock, err := backend.Lock(platform, operation)
+	if err != nil {
+		return nil, fmt.Errorf("cannot %s the cluster %q. %s", operation, k.Name, err)
+	}
+	k.ui.Log.Debugf("state of cluster %q locked with ID %s", k.Name, lock.ID)
+
+	return func() {
+		if err := backend.Unlock(platform, lock); err != nil {
+			k.ui.Log.Errorf("failed to unlock the state of cluster %q, the lock ID is %s. %s", k.Name, lock.ID, err)
+			return
+		}
+		k.ui.Log.Debugf("state of cluster %q unlocked", k.Name)
+	}, nil
+}
+
+func (k *Kluster) backendType() string {
+	if k.Backend == nil || len(k.Backend.Type) == 0 {
+		return LocalBackend
+	}
+	return k.Backend.Type
+}
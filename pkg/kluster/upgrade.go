@@ -0,0 +1,271 @@
+package kluster
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/liferaft/kubekit/pkg/configurator"
+	"github.com/liferaft/kubekit/pkg/configurator/kube"
+	"github.com/liferaft/kubekit/pkg/manifest"
+)
+
+// DefaultUpgradeBatchSize is the default number of workers upgraded at the same
+// time
+const DefaultUpgradeBatchSize = 1
+
+// etcdUpgradeTags are the Ansible tags to execute to upgrade only etcd
+var etcdUpgradeTags = []string{"manifest", "etcd"}
+
+// UpgradeCheckpoint records the progress of an upgrade, so a failed upgrade can
+// be resumed from the last node successfully upgraded
+type UpgradeCheckpoint struct {
+	From string `json:"from" yaml:"from" mapstructure:"from"`
+	To   string `json:"to" yaml:"to" mapstructure:"to"`
+	// PackageInstalled is true when the KubeKit package of the release was
+	// installed on the nodes before the upgrade steps
+	PackageInstalled bool     `json:"package_installed,omitempty" yaml:"package_installed,omitempty" mapstructure:"package_installed,omitempty"`
+	Upgraded         []string `json:"upgraded,omitempty" yaml:"upgraded,omitempty" mapstructure:"upgraded,omitempty"`
+}
+
+// done returns true if the given step was completed on the given host
+func (c *UpgradeCheckpoint) done(step string, host configurator.Host) bool {
+	key := step + "/" + host.PublicIP
+	for _, u := range c.Upgraded {
+		if u == key {
+			return true
+		}
+	}
+	return false
+}
+
+// complete records the given step as completed on the given hosts
+func (c *UpgradeCheckpoint) complete(step string, hosts ...configurator.Host) {
+	for _, h := range hosts {
+		c.Upgraded = append(c.Upgraded, step+"/"+h.PublicIP)
+	}
+}
+
+// Release returns the KubeKit release installed in the cluster. If it's not in
+// the state, it's read from the VERSION file uploaded to the masters
+func (k *Kluster) Release() (string, error) {
+	platformName := k.Platform()
+	if release := k.State[platformName].Release; len(release) != 0 {
+		return release, nil
+	}
+
+	masters := k.State[platformName].Nodes.FilterByRolePrefix("master")
+	if len(masters) == 0 {
+		return "", fmt.Errorf("cannot find the release of cluster %q, it does not have masters", k.Name)
+	}
+
+	versionFile := configurator.ConfiguratorBaseDir + "/VERSION"
+	result, err := k.Exec("cat "+versionFile, "", []string{masters[0].PublicIP}, nil, false)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the release of cluster %q. %s", k.Name, err)
+	}
+	for _, r := range result.Hosts.GetSnapshot() {
+		if r.ExitStatus == 0 {
+			if release := strings.TrimSpace(r.Stdout); len(release) != 0 {
+				return release, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("cannot find the release of cluster %q in %s", k.Name, versionFile)
+}
+
+// ValidateUpgrade returns an error if the cluster cannot be upgraded to the
+// given release, by default the release of this KubeKit, with the upgrade path
+// in the manifest. It returns the checkpoint of the upgrade to start, or of the
+// failed upgrade to resume, which is set in the cluster state. If the cluster
+// is already in the given release, there is no checkpoint
+func (k *Kluster) ValidateUpgrade(to string) (*UpgradeCheckpoint, error) {
+	platformName := k.Platform()
+
+	switch platformName {
+	case "eks", "aks":
+		return nil, fmt.Errorf("the Kubernetes version of the %s clusters is upgraded by the platform", platformName)
+	}
+
+	if len(to) == 0 {
+		to = manifest.Version
+	}
+	if to != manifest.Version {
+		return nil, fmt.Errorf("this KubeKit can only upgrade to the release %s, use KubeKit %s to upgrade to the release %s", manifest.Version, to, to)
+	}
+
+	checkpoint := k.State[platformName].Upgrade
+	if checkpoint == nil || checkpoint.To != to {
+		status := k.State[platformName].Status
+		if status != RunningStatus.String() {
+			return nil, fmt.Errorf("the cluster %q cannot be upgraded, it's %s", k.Name, status)
+		}
+		from, err := k.Release()
+		if err != nil {
+			return nil, err
+		}
+		checkpoint = &UpgradeCheckpoint{From: from, To: to}
+	}
+
+	path, err := manifest.UpgradePath(checkpoint.From, checkpoint.To)
+	if err != nil {
+		return nil, err
+	}
+	if len(path) == 0 {
+		return nil, nil
+	}
+	if len(path) > 1 {
+		return nil, fmt.Errorf("the cluster %q has to be upgraded to the release %s first", k.Name, path[0])
+	}
+
+	k.State[platformName].Upgrade = checkpoint
+
+	return checkpoint, nil
+}
+
+// Upgrade upgrades the cluster to the given release. The upgrade path is
+// validated with the manifest, then etcd is upgraded on every master, then the
+// masters are upgraded one at a time and finally the workers in batches of
+// `batchSize` nodes, cordoned and drained before the upgrade. The node
+// readiness is verified after every step. If a step fails the upgrade stops and
+// the progress is saved, the next upgrade to the same release resumes from the
+// failed step
+func (k *Kluster) Upgrade(to string, batchSize int) error {
+	platformName := k.Platform()
+	logPrefix := fmt.Sprintf("KubeKit [ %s@%s ]", k.Name, platformName)
+	k.ui.SetLogPrefix(logPrefix)
+
+	if err := k.LoadState(); err != nil {
+		return err
+	}
+
+	checkpoint, err := k.ValidateUpgrade(to)
+	if err != nil {
+		return err
+	}
+	if checkpoint == nil {
+		k.ui.Log.Infof("the cluster %q is already in release %s", k.Name, manifest.Version)
+		return nil
+	}
+	if batchSize < 1 {
+		batchSize = DefaultUpgradeBatchSize
+	}
+
+	if len(checkpoint.Upgraded) != 0 {
+		k.ui.Log.Infof("resuming the upgrade of cluster %q from release %s to %s", k.Name, checkpoint.From, checkpoint.To)
+	} else {
+		k.ui.Log.Infof("upgrading cluster %q from release %s to %s", k.Name, checkpoint.From, checkpoint.To)
+	}
+
+	if err := k.upgrade(checkpoint, batchSize); err != nil {
+		k.State[platformName].Status = FailedConfigurationStatus.String()
+		return fmt.Errorf("%s. Execute the upgrade again to resume it", err)
+	}
+
+	k.State[platformName].Release = checkpoint.To
+	k.State[platformName].Upgrade = nil
+	k.State[platformName].Status = RunningStatus.String()
+
+	return nil
+}
+
+// upgrade executes every upgrade step not completed yet in the given
+// checkpoint. The checkpoint is saved after every completed step
+func (k *Kluster) upgrade(checkpoint *UpgradeCheckpoint, batchSize int) error {
+	platformName := k.Platform()
+
+	pConf := k.provisioner[platformName].Config()
+	conf, err := configurator.New(k.Name, platformName, k.State[platformName].Address, k.State[platformName].Port, k.State[platformName].Nodes, k.State[platformName].Data, pConf, k.Config, k.Resources, k.Dir(), k.ui)
+	if err != nil {
+		return err
+	}
+	defer conf.Close()
+	conf.SetParallel(k.parallel)
+
+	client, err := k.KubeClient()
+	if err != nil {
+		return fmt.Errorf("failed to create the Kubernetes client to verify the nodes. %s", err)
+	}
+	if err := k.waitNodesReady(client, nil, StartTimeout); err != nil {
+		return err
+	}
+
+	masters := k.State[platformName].Nodes.FilterByRolePrefix("master")
+	workers := k.State[platformName].Nodes.Difference(masters)
+
+	// etcd, one master at a time
+	for _, m := range masters {
+		if checkpoint.done("etcd", m) {
+			continue
+		}
+		k.ui.Log.Infof("upgrading etcd on master %s", m.PublicIP)
+		if err := conf.ConfigureNodesWithTags(etcdUpgradeTags, m.PublicIP); err != nil {
+			return fmt.Errorf("failed to upgrade etcd on master %s. %s", m.PublicIP, err)
+		}
+		if err := k.completeUpgradeStep(checkpoint, client, "etcd", m); err != nil {
+			return err
+		}
+	}
+
+	// control plane, one master at a time
+	for _, m := range masters {
+		if checkpoint.done("master", m) {
+			continue
+		}
+		k.ui.Log.Infof("upgrading master %s", m.PublicIP)
+		if err := conf.ConfigureNodes(m.PublicIP); err != nil {
+			return fmt.Errorf("failed to upgrade master %s. %s", m.PublicIP, err)
+		}
+		if err := k.completeUpgradeStep(checkpoint, client, "master", m); err != nil {
+			return err
+		}
+	}
+
+	// workers, in batches
+	pending := configurator.Hosts{}
+	for _, w := range workers {
+		if !checkpoint.done("worker", w) {
+			pending = append(pending, w)
+		}
+	}
+	for len(pending) != 0 {
+		size := batchSize
+		if size > len(pending) {
+			size = len(pending)
+		}
+		batch := pending[:size]
+		pending = pending[size:]
+
+		addresses := []string{}
+		for _, w := range batch {
+			addresses = append(addresses, w.PublicIP)
+		}
+		k.ui.Log.Infof("upgrading workers %v", addresses)
+
+		if err := k.cordonNodes(batch); err != nil {
+			return err
+		}
+		if err := conf.ConfigureNodes(addresses...); err != nil {
+			return fmt.Errorf("failed to upgrade workers %v. %s", addresses, err)
+		}
+		if err := k.uncordonNodes(batch); err != nil {
+			return err
+		}
+		if err := k.completeUpgradeStep(checkpoint, client, "worker", batch...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// completeUpgradeStep verifies the nodes are ready after an upgrade step and
+// saves the step as completed in the checkpoint
+func (k *Kluster) completeUpgradeStep(checkpoint *UpgradeCheckpoint, client *kube.Client, step string, hosts ...configurator.Host) error {
+	if err := k.waitNodesReady(client, hosts, StartTimeout); err != nil {
+		return err
+	}
+	checkpoint.complete(step, hosts...)
+
+	return k.Save()
+}
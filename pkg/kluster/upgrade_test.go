@@ -0,0 +1,79 @@
+package kluster
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/liferaft/kubekit/pkg/configurator"
+	"github.com/liferaft/kubekit/pkg/manifest"
+)
+
+func TestUpgradeCheckpoint_done(t *testing.T) {
+	master := configurator.Host{PublicIP: "10.0.0.1", RoleName: "master"}
+	worker := configurator.Host{PublicIP: "10.0.0.2", RoleName: "worker"}
+
+	checkpoint := &UpgradeCheckpoint{From: "2.0.16", To: "2.1.0"}
+	checkpoint.complete("etcd", master)
+	checkpoint.complete("master", master)
+
+	tests := []struct {
+		name string
+		step string
+		host configurator.Host
+		want bool
+	}{
+		{"etcd upgraded", "etcd", master, true},
+		{"master upgraded", "master", master, true},
+		{"worker not upgraded", "worker", worker, false},
+		{"etcd not upgraded on worker", "etcd", worker, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := checkpoint.done(tt.step, tt.host); got != tt.want {
+				t.Errorf("UpgradeCheckpoint.done() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKluster_ValidateUpgrade(t *testing.T) {
+	running := RunningStatus.String()
+	failed := FailedConfigurationStatus.String()
+	resumed := &UpgradeCheckpoint{From: "2.0.16", To: manifest.Version, PackageInstalled: true}
+
+	tests := []struct {
+		name     string
+		platform string
+		to       string
+		state    *State
+		want     *UpgradeCheckpoint
+		wantErr  bool
+	}{
+		{"upgrade", "ec2", "", &State{Status: running, Release: "2.0.16"}, &UpgradeCheckpoint{From: "2.0.16", To: manifest.Version}, false},
+		{"already upgraded", "ec2", manifest.Version, &State{Status: running, Release: manifest.Version}, nil, false},
+		{"resume", "ec2", "", &State{Status: failed, Release: "2.0.16", Upgrade: resumed}, resumed, false},
+		{"not running", "ec2", "", &State{Status: failed, Release: "2.0.16"}, nil, true},
+		{"other release", "ec2", "9.9.9", &State{Status: running, Release: "2.0.16"}, nil, true},
+		{"downgrade", "ec2", "", &State{Status: running, Release: "9.9.9"}, nil, true},
+		{"eks", "eks", "", &State{Status: running, Release: "2.0.16"}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := &Kluster{
+				Name:      "kkdemo",
+				Platforms: map[string]interface{}{tt.platform: nil},
+				State:     map[string]*State{tt.platform: tt.state},
+			}
+			got, err := k.ValidateUpgrade(tt.to)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Kluster.ValidateUpgrade() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Kluster.ValidateUpgrade() = %+v, want %+v", got, tt.want)
+			}
+			if got != nil && k.State[tt.platform].Upgrade != got {
+				t.Errorf("Kluster.ValidateUpgrade() did not set the checkpoint in the state")
+			}
+		})
+	}
+}
@@ -0,0 +1,267 @@
+package kluster
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"text/template"
+	"time"
+
+	"github.com/liferaft/kubekit/pkg/crypto/tls"
+)
+
+// OIDCCAFilename is the file name, in the certificates directory, of the CA
+// certificate to verify the OIDC issuer. It's uploaded to the nodes with the
+// other certificates
+const OIDCCAFilename = "oidc_ca.crt"
+
+// oidcEnabled returns true if the cluster is configured to authenticate the
+// users with OIDC
+func (k *Kluster) oidcEnabled() bool {
+	return k.Config != nil && (len(k.Config.OIDCIssuerURL) != 0 || len(k.Config.OIDCClientID) != 0)
+}
+
+// validateOIDC returns an error if the OIDC parameters of the cluster
+// configuration are not valid for the Kubernetes API server
+func (k *Kluster) validateOIDC() error {
+	if !k.oidcEnabled() {
+		if k.Config != nil && (len(k.Config.OIDCUsernameClaim) != 0 || len(k.Config.OIDCGroupsClaim) != 0 || len(k.Config.OIDCCAFile) != 0) {
+			return fmt.Errorf("the parameters oidc_issuer_url and oidc_client_id are required to use OIDC")
+		}
+		return nil
+	}
+
+	if len(k.Config.OIDCIssuerURL) == 0 || len(k.Config.OIDCClientID) == 0 {
+		return fmt.Errorf("both parameters oidc_issuer_url and oidc_client_id are required to use OIDC")
+	}
+	issuer, err := url.Parse(k.Config.OIDCIssuerURL)
+	if err != nil {
+		return fmt.Errorf("invalid oidc_issuer_url %q. %s", k.Config.OIDCIssuerURL, err)
+	}
+	if issuer.Scheme != "https" || len(issuer.Host) == 0 {
+		return fmt.Errorf("invalid oidc_issuer_url %q, the Kubernetes API server only accepts an HTTPS URL", k.Config.OIDCIssuerURL)
+	}
+
+	return nil
+}
+
+// saveOIDCCA copies the CA certificate of the OIDC issuer from the parameter
+// `oidc_ca_file` to the certificates directory, or removes the previous one if
+// it's no longer set
+func (k *Kluster) saveOIDCCA(baseCertsDir string) error {
+	if err := k.validateOIDC(); err != nil {
+		return err
+	}
+
+	filename := filepath.Join(baseCertsDir, OIDCCAFilename)
+	if k.Config == nil || len(k.Config.OIDCCAFile) == 0 {
+		if err := os.Remove(filename); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	caFile := k.Config.OIDCCAFile
+	if !filepath.IsAbs(caFile) {
+		caFile = filepath.Join(k.Dir(), caFile)
+	}
+	certPEM, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return fmt.Errorf("failed to read the OIDC CA certificate from oidc_ca_file %s. %s", caFile, err)
+	}
+	block, _ := pem.Decode(certPEM)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return fmt.Errorf("cannot find a PEM encoded certificate in the oidc_ca_file %s", caFile)
+	}
+	if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+		return fmt.Errorf("failed to parse the OIDC CA certificate in %s. %s", caFile, err)
+	}
+
+	k.ui.Log.Infof("saving the OIDC CA certificate from %s", caFile)
+	return ioutil.WriteFile(filename, certPEM, 0600)
+}
+
+// GenerateUserKubeConfig generates a KubeConfig file for the given user and
+// groups with a client certificate signed by the cluster CA and valid for the
+// given TTL. The certificate is not stored, so it cannot be revoked, use a
+// short TTL. The cluster state has to be loaded
+func (k *Kluster) GenerateUserKubeConfig(user string, groups []string, ttl time.Duration) ([]byte, error) {
+	platform := k.Platform()
+	switch platform {
+	case "eks", "aks":
+		return nil, fmt.Errorf("the client certificates of the %s platform cannot be signed by KubeKit", platform)
+	}
+	if k.State[platform] == nil || len(k.State[platform].Address) == 0 {
+		return nil, fmt.Errorf("the cluster %s has not been provisioned", k.Name)
+	}
+
+	opts, err := k.KeyOptions()
+	if err != nil {
+		return nil, err
+	}
+	baseCertsDir, err := k.makeCertDir(platform)
+	if err != nil {
+		return nil, err
+	}
+	caName := CertNames["admin"].FromCA
+	external, err := k.externalCAs(baseCertsDir, nil)
+	if err != nil {
+		return nil, err
+	}
+	if external[caName] {
+		return nil, fmt.Errorf("the CA %s is external, KubeKit cannot sign the client certificates. Configure OIDC and use the flag '--oidc'", caName)
+	}
+	ca, err := tls.Load(baseCertsDir, caName, CACertNames[caName].CN)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("the CA certificate %s was not found, generate the certificates with 'kubekit init certificates'. %s", caName, err)
+		}
+		return nil, err
+	}
+
+	for _, group := range groups {
+		if group == "system:masters" {
+			k.ui.Log.Warnf("the user %s is in the group system:masters, it has the same privileges as the admin user", user)
+		}
+	}
+
+	kp, err := tls.NewClientKeyPair(ca, user, groups, ttl, opts)
+	if err != nil {
+		return nil, err
+	}
+	k.ui.Log.Infof("generated the client certificate for %s, it expires on %s", user, kp.Certificate.NotAfter.Local().Format(time.RFC1123))
+
+	return renderKubeconfig(KubeconfigUserTmpl, KubeconfigData{
+		ClusterName:              k.Name,
+		Server:                   k.State[platform].Address,
+		Port:                     k.State[platform].Port,
+		CertificateAuthorityData: base64.StdEncoding.EncodeToString(ca.CertificatePEM),
+		ClientCertData:           base64.StdEncoding.EncodeToString(kp.CertificatePEM),
+		ClientKeyData:            base64.StdEncoding.EncodeToString(kp.PrivateKeyPEM),
+		User:                     user,
+	})
+}
+
+// GenerateOIDCKubeConfig generates a KubeConfig file to authenticate with the
+// OIDC issuer of the cluster configuration. The user gets the token with the
+// kubectl plugin 'oidc-login', so the KubeConfig has no credentials. The
+// cluster state has to be loaded
+func (k *Kluster) GenerateOIDCKubeConfig() ([]byte, error) {
+	platform := k.Platform()
+	switch platform {
+	case "eks", "aks":
+		return nil, fmt.Errorf("the OIDC authentication of the %s platform is not configured by KubeKit", platform)
+	}
+	if err := k.validateOIDC(); err != nil {
+		return nil, err
+	}
+	if !k.oidcEnabled() {
+		return nil, fmt.Errorf("OIDC is not configured for the cluster %s, set the parameters oidc_issuer_url and oidc_client_id and apply the configuration", k.Name)
+	}
+	if k.State[platform] == nil || len(k.State[platform].Address) == 0 {
+		return nil, fmt.Errorf("the cluster %s has not been provisioned", k.Name)
+	}
+
+	caName := CertNames["admin"].FromCA
+	caCertPEM, err := ioutil.ReadFile(filepath.Join(k.CertsDir(), platform, caName+".crt"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the CA certificate %s, generate the certificates with 'kubekit init certificates'. %s", caName, err)
+	}
+
+	// the OIDC CA certificate is saved from `oidc_ca_file` when the
+	// configuration is applied
+	var oidcCAData string
+	if len(k.Config.OIDCCAFile) != 0 {
+		oidcCAPEM, err := ioutil.ReadFile(filepath.Join(k.CertsDir(), platform, OIDCCAFilename))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the OIDC CA certificate, apply the configuration to save it from oidc_ca_file. %s", err)
+		}
+		oidcCAData = base64.StdEncoding.EncodeToString(oidcCAPEM)
+	}
+
+	return renderKubeconfig(KubeconfigOIDCTmpl, KubeconfigData{
+		ClusterName:              k.Name,
+		Server:                   k.State[platform].Address,
+		Port:                     k.State[platform].Port,
+		CertificateAuthorityData: base64.StdEncoding.EncodeToString(caCertPEM),
+		OIDCIssuerURL:            k.Config.OIDCIssuerURL,
+		OIDCClientID:             k.Config.OIDCClientID,
+		OIDCCAData:               oidcCAData,
+	})
+}
+
+func renderKubeconfig(tmpl string, data KubeconfigData) ([]byte, error) {
+	var content bytes.Buffer
+	kubeconfigTmpl := template.Must(template.New("kubeconfig").Parse(tmpl))
+	err := kubeconfigTmpl.Execute(&content, data)
+
+	return content.Bytes(), err
+}
+
+// KubeconfigUserTmpl is the kubeconfig template for a user with a client
+// certificate
+const KubeconfigUserTmpl = `
+apiVersion: v1
+clusters:
+- cluster:
+    certificate-authority-data: |-
+      {{ .CertificateAuthorityData }}
+    server: https://{{ .Server }}:{{ .Port }}
+  name: {{ .ClusterName }}
+contexts:
+- context:
+    cluster: {{ .ClusterName }}
+    user:  {{ .ClusterName }}-{{ .User }}
+  name:  {{ .User }}@{{ .ClusterName }}
+current-context:  {{ .User }}@{{ .ClusterName }}
+kind: Config
+preferences: {}
+users:
+- name:  {{ .ClusterName }}-{{ .User }}
+  user:
+    client-certificate-data: |-
+      {{ .ClientCertData }}
+    client-key-data: |-
+      {{ .ClientKeyData }}
+`
+
+// KubeconfigOIDCTmpl is the kubeconfig template for the users authenticated
+// with OIDC, the token is provided by the kubectl plugin 'oidc-login'. The
+// plugin verifies the issuer with the OIDC CA certificate, if any
+const KubeconfigOIDCTmpl = `
+apiVersion: v1
+clusters:
+- cluster:
+    certificate-authority-data: |-
+      {{ .CertificateAuthorityData }}
+    server: https://{{ .Server }}:{{ .Port }}
+  name: {{ .ClusterName }}
+contexts:
+- context:
+    cluster: {{ .ClusterName }}
+    user:  {{ .ClusterName }}-oidc
+  name:  oidc@{{ .ClusterName }}
+current-context:  oidc@{{ .ClusterName }}
+kind: Config
+preferences: {}
+users:
+- name:  {{ .ClusterName }}-oidc
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: kubectl
+      args:
+        - "oidc-login"
+        - "get-token"
+        - "--oidc-issuer-url={{ .OIDCIssuerURL }}"
+        - "--oidc-client-id={{ .OIDCClientID }}"
+{{- if .OIDCCAData }}
+        - "--certificate-authority-data={{ .OIDCCAData }}"
+{{- end }}
+`
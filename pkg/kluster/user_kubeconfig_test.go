@@ -0,0 +1,312 @@
+package kluster
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/liferaft/kubekit/pkg/configurator"
+	"github.com/liferaft/kubekit/pkg/crypto/tls"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// testKubeconfig is the part of a KubeConfig file read by the tests
+type testKubeconfig struct {
+	Clusters []struct {
+		Cluster struct {
+			CertificateAuthorityData string `yaml:"certificate-authority-data"`
+			Server                   string `yaml:"server"`
+		} `yaml:"cluster"`
+	} `yaml:"clusters"`
+	CurrentContext string `yaml:"current-context"`
+	Users          []struct {
+		Name string `yaml:"name"`
+		User struct {
+			ClientCertificateData string `yaml:"client-certificate-data"`
+			ClientKeyData         string `yaml:"client-key-data"`
+			Exec                  struct {
+				Command string   `yaml:"command"`
+				Args    []string `yaml:"args"`
+			} `yaml:"exec"`
+		} `yaml:"user"`
+	} `yaml:"users"`
+}
+
+func newTestKluster(t *testing.T, clusterDir string, config *configurator.Config) (*Kluster, string) {
+	k := &Kluster{
+		Name:      "kkdemo",
+		Platforms: map[string]interface{}{"ec2": nil},
+		State: map[string]*State{
+			"ec2": {Address: "kkdemo-api.example.com", Port: 6443},
+		},
+		Config: config,
+		path:   filepath.Join(clusterDir, DefaultConfigFilename+".yaml"),
+		ui:     parentUI,
+	}
+	baseCertsDir, err := k.makeCertDir("ec2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return k, baseCertsDir
+}
+
+func TestKluster_validateOIDC(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *configurator.Config
+		wantErr bool
+	}{
+		{"no config", nil, false},
+		{"no OIDC", &configurator.Config{}, false},
+		{"OIDC", &configurator.Config{OIDCIssuerURL: "https://accounts.example.com", OIDCClientID: "kubekit", OIDCUsernameClaim: "email", OIDCGroupsClaim: "groups"}, false},
+		{"no client ID", &configurator.Config{OIDCIssuerURL: "https://accounts.example.com"}, true},
+		{"no issuer", &configurator.Config{OIDCClientID: "kubekit"}, true},
+		{"HTTP issuer", &configurator.Config{OIDCIssuerURL: "http://accounts.example.com", OIDCClientID: "kubekit"}, true},
+		{"issuer without host", &configurator.Config{OIDCIssuerURL: "https:///realms/kubekit", OIDCClientID: "kubekit"}, true},
+		{"claims without issuer", &configurator.Config{OIDCUsernameClaim: "email"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := &Kluster{Config: tt.config}
+			if err := k.validateOIDC(); (err != nil) != tt.wantErr {
+				t.Errorf("Kluster.validateOIDC() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestKluster_saveOIDCCA(t *testing.T) {
+	clusterDir, err := ioutil.TempDir("", "oidc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(clusterDir)
+
+	ca, err := tls.NewCAKeyPair(&tls.KeyPair{}, clusterDir, "oidc", "oidc-ca", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(clusterDir, "oidc-ca.pem"), ca.CertificatePEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(clusterDir, "invalid.pem"), []byte("not a certificate"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	oidc := func(caFile string) *configurator.Config {
+		return &configurator.Config{OIDCIssuerURL: "https://accounts.example.com", OIDCClientID: "kubekit", OIDCCAFile: caFile}
+	}
+
+	tests := []struct {
+		name     string
+		config   *configurator.Config
+		previous bool
+		wantCA   bool
+		wantErr  bool
+	}{
+		{"relative path", oidc("oidc-ca.pem"), false, true, false},
+		{"absolute path", oidc(filepath.Join(clusterDir, "oidc-ca.pem")), false, true, false},
+		{"no CA file", oidc(""), false, false, false},
+		{"remove the previous CA", oidc(""), true, false, false},
+		{"no OIDC", &configurator.Config{}, true, false, false},
+		{"not found", oidc("missing.pem"), false, false, true},
+		{"not a certificate", oidc("invalid.pem"), false, false, true},
+		{"CA without issuer", &configurator.Config{OIDCCAFile: "oidc-ca.pem"}, false, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, baseCertsDir := newTestKluster(t, clusterDir, tt.config)
+			filename := filepath.Join(baseCertsDir, OIDCCAFilename)
+			os.Remove(filename)
+			if tt.previous {
+				if err := ioutil.WriteFile(filename, ca.CertificatePEM, 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if err := k.saveOIDCCA(baseCertsDir); (err != nil) != tt.wantErr {
+				t.Fatalf("Kluster.saveOIDCCA() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			got, err := ioutil.ReadFile(filename)
+			if gotCA := err == nil; gotCA != tt.wantCA {
+				t.Fatalf("Kluster.saveOIDCCA() saved the CA = %v, want %v", gotCA, tt.wantCA)
+			}
+			if tt.wantCA && !reflect.DeepEqual(got, ca.CertificatePEM) {
+				t.Errorf("Kluster.saveOIDCCA() saved a different CA certificate")
+			}
+		})
+	}
+}
+
+func TestKluster_GenerateUserKubeConfig(t *testing.T) {
+	clusterDir, err := ioutil.TempDir("", "user_kubeconfig")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(clusterDir)
+
+	k, baseCertsDir := newTestKluster(t, clusterDir, &configurator.Config{})
+	caName := CertNames["admin"].FromCA
+	ca, err := tls.NewCAKeyPair(&tls.KeyPair{}, baseCertsDir, caName, CACertNames[caName].CN, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ca.SavePrivateKey(true); err != nil {
+		t.Fatal(err)
+	}
+	if err := ca.SaveCertificate(true); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		kluster func() *Kluster
+		user    string
+		groups  []string
+		wantErr bool
+	}{
+		{"user and groups", func() *Kluster { return k }, "alice", []string{"devs"}, false},
+		{"not provisioned", func() *Kluster {
+			nk := *k
+			nk.State = map[string]*State{"ec2": {}}
+			return &nk
+		}, "alice", nil, true},
+		{"external CA", func() *Kluster {
+			nk := *k
+			nk.Config = &configurator.Config{CertificatesExternalCA: []string{caName}}
+			return &nk
+		}, "alice", nil, true},
+		{"no user", func() *Kluster { return k }, "", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, err := tt.kluster().GenerateUserKubeConfig(tt.user, tt.groups, time.Hour)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Kluster.GenerateUserKubeConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			var kubeconfig testKubeconfig
+			if err := yaml.Unmarshal(content, &kubeconfig); err != nil {
+				t.Fatalf("Kluster.GenerateUserKubeConfig() is not a valid KubeConfig. %s", err)
+			}
+			if len(kubeconfig.Clusters) != 1 || kubeconfig.Clusters[0].Cluster.Server != "https://kkdemo-api.example.com:6443" {
+				t.Errorf("Kluster.GenerateUserKubeConfig() clusters = %+v", kubeconfig.Clusters)
+			}
+			if want := tt.user + "@kkdemo"; kubeconfig.CurrentContext != want {
+				t.Errorf("Kluster.GenerateUserKubeConfig() current context = %q, want %q", kubeconfig.CurrentContext, want)
+			}
+			if len(kubeconfig.Users) != 1 {
+				t.Fatalf("Kluster.GenerateUserKubeConfig() users = %+v", kubeconfig.Users)
+			}
+			certPEM, err := base64.StdEncoding.DecodeString(kubeconfig.Users[0].User.ClientCertificateData)
+			if err != nil {
+				t.Fatal(err)
+			}
+			block, _ := pem.Decode(certPEM)
+			if block == nil {
+				t.Fatal("Kluster.GenerateUserKubeConfig() client certificate is not PEM encoded")
+			}
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cert.Subject.CommonName != tt.user || !reflect.DeepEqual(cert.Subject.Organization, tt.groups) {
+				t.Errorf("Kluster.GenerateUserKubeConfig() subject = %v, want CN=%s O=%v", cert.Subject, tt.user, tt.groups)
+			}
+			if err := cert.CheckSignatureFrom(ca.Certificate); err != nil {
+				t.Errorf("Kluster.GenerateUserKubeConfig() client certificate is not signed by the CA. %s", err)
+			}
+		})
+	}
+}
+
+func TestKluster_GenerateOIDCKubeConfig(t *testing.T) {
+	clusterDir, err := ioutil.TempDir("", "oidc_kubeconfig")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(clusterDir)
+
+	caName := CertNames["admin"].FromCA
+	oidcWithCA := &configurator.Config{OIDCIssuerURL: "https://accounts.example.com", OIDCClientID: "kubekit", OIDCCAFile: "oidc-ca.pem"}
+	tests := []struct {
+		name     string
+		config   *configurator.Config
+		oidcCA   string
+		wantArgs []string
+		wantErr  bool
+	}{
+		{
+			"OIDC",
+			&configurator.Config{OIDCIssuerURL: "https://accounts.example.com", OIDCClientID: "kubekit"},
+			"",
+			[]string{"oidc-login", "get-token", "--oidc-issuer-url=https://accounts.example.com", "--oidc-client-id=kubekit"},
+			false,
+		},
+		{
+			"OIDC with CA",
+			oidcWithCA,
+			"OIDC CA",
+			[]string{"oidc-login", "get-token", "--oidc-issuer-url=https://accounts.example.com", "--oidc-client-id=kubekit", "--certificate-authority-data=" + base64.StdEncoding.EncodeToString([]byte("OIDC CA"))},
+			false,
+		},
+		{"OIDC CA not saved", oidcWithCA, "", nil, true},
+		{"no OIDC", &configurator.Config{}, "", nil, true},
+		{"invalid OIDC", &configurator.Config{OIDCIssuerURL: "http://accounts.example.com", OIDCClientID: "kubekit"}, "", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, baseCertsDir := newTestKluster(t, clusterDir, tt.config)
+			os.Remove(filepath.Join(baseCertsDir, OIDCCAFilename))
+			if len(tt.oidcCA) != 0 {
+				if err := ioutil.WriteFile(filepath.Join(baseCertsDir, OIDCCAFilename), []byte(tt.oidcCA), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if _, err := os.Stat(filepath.Join(baseCertsDir, caName+".crt")); os.IsNotExist(err) {
+				ca, err := tls.NewCAKeyPair(&tls.KeyPair{}, baseCertsDir, caName, CACertNames[caName].CN, nil)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := ca.SaveCertificate(true); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			content, err := k.GenerateOIDCKubeConfig()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Kluster.GenerateOIDCKubeConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			var kubeconfig testKubeconfig
+			if err := yaml.Unmarshal(content, &kubeconfig); err != nil {
+				t.Fatalf("Kluster.GenerateOIDCKubeConfig() is not a valid KubeConfig. %s", err)
+			}
+			if len(kubeconfig.Users) != 1 {
+				t.Fatalf("Kluster.GenerateOIDCKubeConfig() users = %+v", kubeconfig.Users)
+			}
+			user := kubeconfig.Users[0].User
+			if len(user.ClientCertificateData) != 0 || len(user.ClientKeyData) != 0 {
+				t.Errorf("Kluster.GenerateOIDCKubeConfig() has client credentials")
+			}
+			if user.Exec.Command != "kubectl" || !reflect.DeepEqual(user.Exec.Args, tt.wantArgs) {
+				t.Errorf("Kluster.GenerateOIDCKubeConfig() exec = %s %v, want kubectl %v", user.Exec.Command, user.Exec.Args, tt.wantArgs)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package manifest
+
+import (
+	"fmt"
+)
+
+// UpgradePath returns the releases to go through to upgrade a cluster from the
+// `from` release to the `to` release, following the previous version of every
+// release. The `from` release is not included in the list
+func (m *Manifest) UpgradePath(from, to string) ([]string, error) {
+	if from == to {
+		return []string{}, nil
+	}
+
+	if path := m.releasesBetween(from, to); path != nil {
+		return path, nil
+	}
+	if m.releasesBetween(to, from) != nil {
+		return nil, fmt.Errorf("cannot downgrade from release %s to %s", from, to)
+	}
+	if _, ok := m.Releases[to]; !ok {
+		return nil, fmt.Errorf("the release %s is not in the manifest", to)
+	}
+
+	return nil, fmt.Errorf("there is no upgrade path from release %s to %s", from, to)
+}
+
+// releasesBetween returns the releases from the release after `from` to `to`,
+// or nil if `from` is not a previous version of `to`
+func (m *Manifest) releasesBetween(from, to string) []string {
+	path := []string{}
+	visited := map[string]bool{}
+	for current := to; !visited[current]; {
+		visited[current] = true
+		release, ok := m.Releases[current]
+		if !ok {
+			return nil
+		}
+		path = append([]string{current}, path...)
+		if release.PreviousVersion == from {
+			return path
+		}
+		current = release.PreviousVersion
+	}
+	return nil
+}
+
+// UpgradePath returns the releases in the KubeManifest to go through to upgrade
+// a cluster from the `from` release to the `to` release
+func UpgradePath(from, to string) ([]string, error) {
+	return KubeManifest.UpgradePath(from, to)
+}
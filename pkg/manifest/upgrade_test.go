@@ -0,0 +1,47 @@
+package manifest_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/liferaft/kubekit/pkg/manifest"
+)
+
+var upgradeManifestTest = manifest.Manifest{
+	Releases: map[string]manifest.Release{
+		"1.1.0": manifest.Release{PreviousVersion: "1.0.0"},
+		"1.2.0": manifest.Release{PreviousVersion: "1.1.0"},
+		"1.3.0": manifest.Release{PreviousVersion: "1.2.0"},
+		"2.0.0": manifest.Release{PreviousVersion: "1.9.0"},
+	},
+}
+
+func TestManifest_UpgradePath(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    string
+		to      string
+		want    []string
+		wantErr bool
+	}{
+		{"same release", "1.2.0", "1.2.0", []string{}, false},
+		{"next release", "1.1.0", "1.2.0", []string{"1.2.0"}, false},
+		{"previous version not in manifest", "1.0.0", "1.1.0", []string{"1.1.0"}, false},
+		{"multiple releases", "1.0.0", "1.3.0", []string{"1.1.0", "1.2.0", "1.3.0"}, false},
+		{"downgrade", "1.3.0", "1.1.0", nil, true},
+		{"unknown release", "1.3.0", "3.0.0", nil, true},
+		{"no upgrade path", "1.3.0", "2.0.0", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := upgradeManifestTest.UpgradePath(tt.from, tt.to)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Manifest.UpgradePath() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Manifest.UpgradePath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
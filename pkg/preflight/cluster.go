@@ -0,0 +1,216 @@
+package preflight
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+)
+
+// lookupHost resolves a DNS name, it's replaced in the tests
+var lookupHost = net.LookupHost
+
+// CredentialsCheck validates the credentials to access the platform
+type CredentialsCheck struct{}
+
+// Name returns the name of the check
+func (c *CredentialsCheck) Name() string { return "credentials" }
+
+// Run executes the check
+func (c *CredentialsCheck) Run(target *Target) Results {
+	if target.Credentials == nil {
+		return Results{{Check: c.Name(), Status: Skip, Message: fmt.Sprintf("the platform %s does not use credentials", target.Platform)}}
+	}
+	if err := target.Credentials.Validate(); err != nil {
+		return Results{{Check: c.Name(), Status: Fail, Message: fmt.Sprintf("invalid %s credentials. %s", target.Platform, err)}}
+	}
+	return Results{{Check: c.Name(), Status: Pass}}
+}
+
+// CIDRCheck verifies the pods and services CIDRs are valid and do not overlap
+// with each other or with the nodes IP addresses
+type CIDRCheck struct{}
+
+// Name returns the name of the check
+func (c *CIDRCheck) Name() string { return "cidr" }
+
+// Run executes the check
+func (c *CIDRCheck) Run(target *Target) Results {
+	cidrs := []struct {
+		name  string
+		value string
+		ipNet *net.IPNet
+	}{
+		{name: "kube_cluster_cidr", value: target.ClusterCIDR},
+		{name: "kube_services_cidr", value: target.ServicesCIDR},
+	}
+
+	results := Results{}
+	for i, cidr := range cidrs {
+		if len(cidr.value) == 0 {
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(cidr.value)
+		if err != nil {
+			results = append(results, Result{Check: c.Name(), Status: Fail, Message: fmt.Sprintf("invalid %s %q. %s", cidr.name, cidr.value, err)})
+			continue
+		}
+		cidrs[i].ipNet = ipNet
+	}
+
+	if a, b := cidrs[0], cidrs[1]; a.ipNet != nil && b.ipNet != nil && overlap(a.ipNet, b.ipNet) {
+		results = append(results, Result{Check: c.Name(), Status: Fail, Message: fmt.Sprintf("%s %s overlaps with %s %s", a.name, a.value, b.name, b.value)})
+	}
+
+	for _, node := range target.Nodes {
+		for _, address := range []string{node.PrivateIP, node.PublicIP} {
+			ip := net.ParseIP(address)
+			if ip == nil {
+				continue
+			}
+			for _, cidr := range cidrs {
+				if cidr.ipNet != nil && cidr.ipNet.Contains(ip) {
+					results = append(results, Result{Check: c.Name(), Node: node.Name, Status: Fail, Message: fmt.Sprintf("the node IP %s is in the %s %s", address, cidr.name, cidr.value)})
+				}
+			}
+		}
+	}
+
+	if len(results) == 0 {
+		results = append(results, Result{Check: c.Name(), Status: Pass})
+	}
+	return results
+}
+
+func overlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+// DuplicateAddressCheck verifies every node has a unique IP address and DNS
+// name
+type DuplicateAddressCheck struct{}
+
+// Name returns the name of the check
+func (c *DuplicateAddressCheck) Name() string { return "duplicate-addresses" }
+
+// Run executes the check
+func (c *DuplicateAddressCheck) Run(target *Target) Results {
+	owners := map[string][]string{}
+	addresses := []string{}
+	for _, node := range target.Nodes {
+		seen := map[string]bool{}
+		for _, address := range []string{node.PublicIP, node.PrivateIP, node.PublicDNS, node.PrivateDNS} {
+			if len(address) == 0 || seen[address] {
+				continue
+			}
+			seen[address] = true
+			if _, ok := owners[address]; !ok {
+				addresses = append(addresses, address)
+			}
+			owners[address] = append(owners[address], node.Name)
+		}
+	}
+
+	results := Results{}
+	for _, address := range addresses {
+		if nodes := owners[address]; len(nodes) > 1 {
+			results = append(results, Result{Check: c.Name(), Status: Fail, Message: fmt.Sprintf("the address %s is used by the nodes %s", address, strings.Join(nodes, ", "))})
+		}
+	}
+
+	if len(results) == 0 {
+		results = append(results, Result{Check: c.Name(), Status: Pass})
+	}
+	return results
+}
+
+// DNSCheck verifies the nodes DNS names resolve to the nodes IP addresses. The
+// public names are resolved locally, the private names may only resolve inside
+// the cluster network, such as the EC2 `*.compute.internal` names, so they are
+// resolved on the node. A name that cannot be resolved is a warning, the nodes
+// are reached by IP address
+type DNSCheck struct{}
+
+// Name returns the name of the check
+func (c *DNSCheck) Name() string { return "dns" }
+
+// Run executes the check
+func (c *DNSCheck) Run(target *Target) Results {
+	results := make(Results, len(target.Nodes))
+
+	var wg sync.WaitGroup
+	for i, node := range target.Nodes {
+		wg.Add(1)
+		go func(i int, node Node) {
+			defer wg.Done()
+			result := c.check(node)
+			result.Check, result.Node = c.Name(), node.Name
+			results[i] = result
+		}(i, node)
+	}
+	wg.Wait()
+
+	return results
+}
+
+func (c *DNSCheck) check(node Node) Result {
+	if len(node.PublicDNS) == 0 && len(node.PrivateDNS) == 0 {
+		return Result{Status: Skip, Message: "the node does not have DNS names"}
+	}
+
+	type name struct {
+		name, ip string
+		lookup   func(string) ([]string, error)
+	}
+	names := []name{}
+	if len(node.PublicDNS) != 0 {
+		names = append(names, name{node.PublicDNS, node.PublicIP, lookupHost})
+	}
+	if len(node.PrivateDNS) != 0 && node.PrivateDNS != node.PublicDNS && node.Runner != nil {
+		lookupOnNode := func(name string) ([]string, error) { return lookupHostOnNode(node, name) }
+		names = append(names, name{node.PrivateDNS, node.PrivateIP, lookupOnNode})
+	}
+	if len(names) == 0 {
+		return Result{Status: Skip, Message: "the node is not accessible to resolve its private DNS name"}
+	}
+
+	messages := []string{}
+	for _, n := range names {
+		addrs, err := n.lookup(n.name)
+		if err != nil {
+			messages = append(messages, fmt.Sprintf("failed to resolve %s. %s", n.name, err))
+			continue
+		}
+		if len(n.ip) != 0 && !contains(addrs, n.ip) {
+			messages = append(messages, fmt.Sprintf("%s resolves to %s, not to %s", n.name, strings.Join(addrs, ", "), n.ip))
+		}
+	}
+	if len(messages) != 0 {
+		return Result{Status: Warn, Message: strings.Join(messages, ". ")}
+	}
+	return Result{Status: Pass}
+}
+
+// lookupHostOnNode resolves a DNS name on the given node
+func lookupHostOnNode(node Node, name string) ([]string, error) {
+	output, err := exec(node, fmt.Sprintf("getent hosts %s", name))
+	if err != nil {
+		return nil, err
+	}
+	addrs := []string{}
+	for _, line := range strings.Split(output, "\n") {
+		if fields := strings.Fields(line); len(fields) != 0 {
+			addrs = append(addrs, fields[0])
+		}
+	}
+	return addrs, nil
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
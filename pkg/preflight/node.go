@@ -0,0 +1,254 @@
+package preflight
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Default values of the node checks
+var (
+	// DefaultMaxClockSkew is the maximum difference allowed between the local
+	// and the nodes clock, the certificates may not be valid with a bigger skew
+	DefaultMaxClockSkew = 180 * time.Second
+	// DefaultDiskPath is the directory where the images and containers are
+	// stored
+	DefaultDiskPath = "/var/lib"
+	// DefaultMinFreeDiskMB is the minimum free disk space in DefaultDiskPath
+	DefaultMinFreeDiskMB = 10240
+	// DefaultKernelModules are the kernel modules required by Docker and the
+	// CNI
+	DefaultKernelModules = []string{"br_netfilter", "overlay"}
+	// DefaultPorts are the ports used by Kubernetes, by node role prefix
+	DefaultPorts = map[string][]int{
+		"master": {2379, 2380, 6443, 10250, 10251, 10252},
+		"worker": {10250},
+	}
+)
+
+// now returns the local time, it's replaced in the tests
+var now = time.Now
+
+// runOnNodes executes fn on every node at the same time. The nodes without a
+// Runner are skipped
+func runOnNodes(target *Target, check string, fn func(node Node) Result) Results {
+	results := make(Results, len(target.Nodes))
+
+	var wg sync.WaitGroup
+	for i, node := range target.Nodes {
+		if node.Runner == nil {
+			results[i] = Result{Check: check, Node: node.Name, Status: Skip, Message: "the node is not accessible"}
+			continue
+		}
+		wg.Add(1)
+		go func(i int, node Node) {
+			defer wg.Done()
+			result := fn(node)
+			result.Check, result.Node = check, node.Name
+			results[i] = result
+		}(i, node)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// exec executes the command on the node and returns its output, or an error
+// if it fails
+func exec(node Node, command string) (string, error) {
+	stdout, stderr, exitStatus, err := node.Runner.ExecAndWait(command)
+	if err != nil {
+		return "", fmt.Errorf("failed to execute %q. %s", command, err)
+	}
+	if exitStatus != 0 {
+		return "", fmt.Errorf("failed to execute %q, exit status %d. %s", command, exitStatus, strings.TrimSpace(stderr))
+	}
+	return strings.TrimSpace(stdout), nil
+}
+
+// ClockCheck verifies the nodes clock is synchronized with the local clock,
+// the certificates generated locally are not valid in nodes with a big skew
+type ClockCheck struct {
+	MaxSkew time.Duration
+}
+
+// Name returns the name of the check
+func (c *ClockCheck) Name() string { return "clock" }
+
+// Run executes the check
+func (c *ClockCheck) Run(target *Target) Results {
+	return runOnNodes(target, c.Name(), func(node Node) Result {
+		out, err := exec(node, "date +%s")
+		if err != nil {
+			return Result{Status: Fail, Message: err.Error()}
+		}
+		remoteSec, err := strconv.ParseInt(out, 10, 64)
+		if err != nil {
+			return Result{Status: Fail, Message: fmt.Sprintf("unexpected date %q", out)}
+		}
+
+		skew := now().Sub(time.Unix(remoteSec, 0))
+		if skew < 0 {
+			skew = -skew
+		}
+		if skew > c.MaxSkew {
+			return Result{Status: Fail, Message: fmt.Sprintf("the clock skew is %s, more than the %s allowed", skew.Round(time.Second), c.MaxSkew)}
+		}
+		return Result{Status: Pass}
+	})
+}
+
+// SwapCheck verifies the swap is disabled, the kubelet does not start with
+// swap enabled
+type SwapCheck struct{}
+
+// Name returns the name of the check
+func (c *SwapCheck) Name() string { return "swap" }
+
+// Run executes the check
+func (c *SwapCheck) Run(target *Target) Results {
+	return runOnNodes(target, c.Name(), func(node Node) Result {
+		out, err := exec(node, "tail -n +2 /proc/swaps")
+		if err != nil {
+			return Result{Status: Fail, Message: err.Error()}
+		}
+		if len(out) != 0 {
+			devices := []string{}
+			for _, line := range strings.Split(out, "\n") {
+				if fields := strings.Fields(line); len(fields) != 0 {
+					devices = append(devices, fields[0])
+				}
+			}
+			return Result{Status: Fail, Message: fmt.Sprintf("the swap is enabled on %s", strings.Join(devices, ", "))}
+		}
+		return Result{Status: Pass}
+	})
+}
+
+// DiskCheck verifies there is enough free disk space in a directory
+type DiskCheck struct {
+	Path      string
+	MinFreeMB int
+}
+
+// Name returns the name of the check
+func (c *DiskCheck) Name() string { return "disk" }
+
+// Run executes the check
+func (c *DiskCheck) Run(target *Target) Results {
+	return runOnNodes(target, c.Name(), func(node Node) Result {
+		out, err := exec(node, fmt.Sprintf("df -Pm %s | tail -n 1", c.Path))
+		if err != nil {
+			return Result{Status: Fail, Message: err.Error()}
+		}
+		// Filesystem 1048576-blocks Used Available Capacity Mounted-on
+		fields := strings.Fields(out)
+		if len(fields) < 4 {
+			return Result{Status: Fail, Message: fmt.Sprintf("unexpected disk usage %q", out)}
+		}
+		free, err := strconv.Atoi(fields[3])
+		if err != nil {
+			return Result{Status: Fail, Message: fmt.Sprintf("unexpected disk usage %q", out)}
+		}
+		if free < c.MinFreeMB {
+			return Result{Status: Fail, Message: fmt.Sprintf("%dMB free in %s, at least %dMB are required", free, c.Path, c.MinFreeMB)}
+		}
+		return Result{Status: Pass, Message: fmt.Sprintf("%dMB free in %s", free, c.Path)}
+	})
+}
+
+// KernelModulesCheck verifies the kernel modules are loaded. It's a warning,
+// the configuration tries to load them
+type KernelModulesCheck struct {
+	Modules []string
+}
+
+// Name returns the name of the check
+func (c *KernelModulesCheck) Name() string { return "kernel-modules" }
+
+// Run executes the check
+func (c *KernelModulesCheck) Run(target *Target) Results {
+	command := fmt.Sprintf("for m in %s; do test -d /sys/module/$m || echo $m; done", strings.Join(c.Modules, " "))
+
+	return runOnNodes(target, c.Name(), func(node Node) Result {
+		out, err := exec(node, command)
+		if err != nil {
+			return Result{Status: Fail, Message: err.Error()}
+		}
+		if missing := strings.Fields(out); len(missing) != 0 {
+			return Result{Status: Warn, Message: fmt.Sprintf("the kernel modules %s are not loaded", strings.Join(missing, ", "))}
+		}
+		return Result{Status: Pass}
+	})
+}
+
+// PortsCheck verifies the ports used by Kubernetes are free on new nodes. The
+// ports are set by node role prefix, the nodes with a role not matching any
+// prefix use the "worker" ports
+type PortsCheck struct {
+	Ports map[string][]int
+}
+
+// Name returns the name of the check
+func (c *PortsCheck) Name() string { return "ports" }
+
+// Run executes the check
+func (c *PortsCheck) Run(target *Target) Results {
+	if target.Configured {
+		return Results{{Check: c.Name(), Status: Skip, Message: "Kubernetes is running on the nodes"}}
+	}
+
+	return runOnNodes(target, c.Name(), func(node Node) Result {
+		ports := c.nodePorts(node)
+		if len(ports) == 0 {
+			return Result{Status: Pass}
+		}
+
+		out, err := exec(node, "ss -Htln 2>/dev/null || netstat -tln")
+		if err != nil {
+			return Result{Status: Fail, Message: err.Error()}
+		}
+		listening := map[int]bool{}
+		for _, line := range strings.Split(out, "\n") {
+			for _, field := range strings.Fields(line) {
+				i := strings.LastIndex(field, ":")
+				if i < 0 {
+					continue
+				}
+				if port, err := strconv.Atoi(field[i+1:]); err == nil {
+					listening[port] = true
+					break
+				}
+			}
+		}
+
+		used := []string{}
+		for _, port := range ports {
+			if listening[port] {
+				used = append(used, strconv.Itoa(port))
+			}
+		}
+		if len(used) != 0 {
+			return Result{Status: Fail, Message: fmt.Sprintf("the ports %s are in use", strings.Join(used, ", "))}
+		}
+		return Result{Status: Pass}
+	})
+}
+
+func (c *PortsCheck) nodePorts(node Node) []int {
+	prefixes := make([]string, 0, len(c.Ports))
+	for prefix := range c.Ports {
+		prefixes = append(prefixes, prefix)
+	}
+	// the longest prefix wins
+	sort.Slice(prefixes, func(i, j int) bool { return len(prefixes[i]) > len(prefixes[j]) })
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(node.Role, prefix) {
+			return c.Ports[prefix]
+		}
+	}
+	return c.Ports["worker"]
+}
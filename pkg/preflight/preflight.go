@@ -0,0 +1,182 @@
+// Package preflight verifies the cluster nodes, network and credentials are
+// ready before provisioning or configuring a cluster. Every verification is a
+// Check, the checks are executed on a Target with the cluster information and
+// return one Result per node or one for the entire cluster.
+package preflight
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"text/tabwriter"
+)
+
+// Status of a check result
+const (
+	Pass = "pass"
+	Warn = "warn"
+	Fail = "fail"
+	Skip = "skip"
+)
+
+// SkipAll is the name to use to skip all the checks
+const SkipAll = "all"
+
+// Runner executes a command on a node, it's implemented by the SSH client of
+// the node
+type Runner interface {
+	ExecAndWait(command string) (stdout string, stderr string, exitStatus int, err error)
+}
+
+// Validator validates the credentials to access the platform
+type Validator interface {
+	Validate() error
+}
+
+// Node is a cluster node to check
+type Node struct {
+	Name       string
+	Role       string
+	PublicIP   string
+	PrivateIP  string
+	PublicDNS  string
+	PrivateDNS string
+	// Runner executes the commands on the node. If nil, the node checks are
+	// skipped, for example, on platforms where the nodes are not accessible
+	Runner Runner
+}
+
+// Target is the cluster to check
+type Target struct {
+	Cluster      string
+	Platform     string
+	Nodes        []Node
+	ClusterCIDR  string
+	ServicesCIDR string
+	// Credentials are validated if set
+	Credentials Validator
+	// Configured is true if Kubernetes is already running on the nodes, so the
+	// checks that are only valid on new nodes are skipped
+	Configured bool
+}
+
+// Result is the result of a check on a node, or on the cluster if Node is empty
+type Result struct {
+	Check   string `json:"check" yaml:"check"`
+	Node    string `json:"node,omitempty" yaml:"node,omitempty"`
+	Status  string `json:"status" yaml:"status"`
+	Message string `json:"message,omitempty" yaml:"message,omitempty"`
+}
+
+// Results are the results of all the checks
+type Results []Result
+
+// Check is a preflight check
+type Check interface {
+	// Name identifies the check, it's used to skip it
+	Name() string
+	// Run executes the check on the target
+	Run(target *Target) Results
+}
+
+// DefaultChecks returns all the checks, in the order they are executed
+func DefaultChecks() []Check {
+	return []Check{
+		&CredentialsCheck{},
+		&CIDRCheck{},
+		&DuplicateAddressCheck{},
+		&DNSCheck{},
+		&ClockCheck{MaxSkew: DefaultMaxClockSkew},
+		&SwapCheck{},
+		&DiskCheck{Path: DefaultDiskPath, MinFreeMB: DefaultMinFreeDiskMB},
+		&KernelModulesCheck{Modules: DefaultKernelModules},
+		&PortsCheck{Ports: DefaultPorts},
+	}
+}
+
+// Run executes the given checks on the target, except the checks with a name
+// in skip. If skip contains SkipAll no check is executed
+func Run(target *Target, checks []Check, skip ...string) Results {
+	skipped := map[string]bool{}
+	for _, name := range skip {
+		skipped[name] = true
+	}
+	results := Results{}
+	if skipped[SkipAll] {
+		return results
+	}
+
+	for _, check := range checks {
+		if skipped[check.Name()] {
+			results = append(results, Result{Check: check.Name(), Status: Skip, Message: "skipped by the user"})
+			continue
+		}
+		results = append(results, check.Run(target)...)
+	}
+
+	return results
+}
+
+// Failed returns true if any check failed
+func (r Results) Failed() bool {
+	for _, result := range r {
+		if result.Status == Fail {
+			return true
+		}
+	}
+	return false
+}
+
+// Count returns the number of results with the given status
+func (r Results) Count(status string) int {
+	n := 0
+	for _, result := range r {
+		if result.Status == status {
+			n++
+		}
+	}
+	return n
+}
+
+// Filter returns the results with any of the given status
+func (r Results) Filter(status ...string) Results {
+	filtered := Results{}
+	for _, result := range r {
+		for _, s := range status {
+			if result.Status == s {
+				filtered = append(filtered, result)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// String returns the results in a table
+func (r Results) String() string {
+	var b bytes.Buffer
+	w := tabwriter.NewWriter(&b, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "CHECK\tNODE\tSTATUS\tMESSAGE")
+	for _, result := range r {
+		node := result.Node
+		if len(node) == 0 {
+			node = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", result.Check, node, result.Status, result.Message)
+	}
+	w.Flush()
+
+	fmt.Fprintf(&b, "\n%d passed, %d warnings, %d failed, %d skipped\n", r.Count(Pass), r.Count(Warn), r.Count(Fail), r.Count(Skip))
+
+	return b.String()
+}
+
+// Names returns the names of the given checks, sorted
+func Names(checks []Check) []string {
+	names := make([]string, 0, len(checks))
+	for _, check := range checks {
+		names = append(names, check.Name())
+	}
+	sort.Strings(names)
+	return names
+}
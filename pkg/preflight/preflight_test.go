@@ -0,0 +1,218 @@
+package preflight
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeRunner replies to the commands starting with a key of the outputs map
+type fakeRunner struct {
+	outputs map[string]string
+	err     error
+}
+
+func (r *fakeRunner) ExecAndWait(command string) (string, string, int, error) {
+	if r.err != nil {
+		return "", "", -1, r.err
+	}
+	for prefix, output := range r.outputs {
+		if strings.HasPrefix(command, prefix) {
+			return output, "", 0, nil
+		}
+	}
+	return "", "command not found", 127, nil
+}
+
+type fakeValidator struct{ err error }
+
+func (v fakeValidator) Validate() error { return v.err }
+
+func statuses(results Results) []string {
+	s := []string{}
+	for _, r := range results {
+		s = append(s, r.Status)
+	}
+	return s
+}
+
+func TestRun(t *testing.T) {
+	target := &Target{Platform: "ec2", Credentials: fakeValidator{}, ClusterCIDR: "172.24.0.0/16", ServicesCIDR: "172.21.0.0/16"}
+	checks := []Check{&CredentialsCheck{}, &CIDRCheck{}}
+
+	tests := []struct {
+		name string
+		skip []string
+		want []string
+	}{
+		{"all checks", nil, []string{Pass, Pass}},
+		{"skip one", []string{"cidr"}, []string{Pass, Skip}},
+		{"skip all", []string{SkipAll}, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := statuses(Run(target, checks, tt.skip...)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Run() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCredentialsCheck(t *testing.T) {
+	tests := []struct {
+		name        string
+		credentials Validator
+		want        string
+	}{
+		{"no credentials", nil, Skip},
+		{"valid", fakeValidator{}, Pass},
+		{"invalid", fakeValidator{fmt.Errorf("expired token")}, Fail},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := (&CredentialsCheck{}).Run(&Target{Platform: "ec2", Credentials: tt.credentials})
+			if got[0].Status != tt.want {
+				t.Errorf("CredentialsCheck.Run() = %v, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCIDRCheck(t *testing.T) {
+	nodes := []Node{{Name: "node1", PrivateIP: "10.0.0.1", PublicIP: "54.1.1.1"}}
+
+	tests := []struct {
+		name         string
+		clusterCIDR  string
+		servicesCIDR string
+		nodes        []Node
+		want         []string
+	}{
+		{"defaults", "172.24.0.0/16", "172.21.0.0/16", nodes, []string{Pass}},
+		{"not set", "", "", nodes, []string{Pass}},
+		{"invalid", "172.24.0.0/33", "172.21.0.0/16", nodes, []string{Fail}},
+		{"overlap", "172.16.0.0/12", "172.21.0.0/16", nodes, []string{Fail}},
+		{"node in cidr", "10.0.0.0/16", "172.21.0.0/16", nodes, []string{Fail}},
+		{"node in both", "10.0.0.0/16", "10.0.0.0/24", nodes, []string{Fail, Fail, Fail}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &Target{ClusterCIDR: tt.clusterCIDR, ServicesCIDR: tt.servicesCIDR, Nodes: tt.nodes}
+			if got := statuses((&CIDRCheck{}).Run(target)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CIDRCheck.Run() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDuplicateAddressCheck(t *testing.T) {
+	tests := []struct {
+		name  string
+		nodes []Node
+		want  []string
+	}{
+		{"unique", []Node{{Name: "a", PrivateIP: "10.0.0.1"}, {Name: "b", PrivateIP: "10.0.0.2"}}, []string{Pass}},
+		{"same public and private", []Node{{Name: "a", PrivateIP: "10.0.0.1", PublicIP: "10.0.0.1"}}, []string{Pass}},
+		{"duplicate IP", []Node{{Name: "a", PrivateIP: "10.0.0.1"}, {Name: "b", PrivateIP: "10.0.0.1"}}, []string{Fail}},
+		{"duplicate IP and DNS", []Node{{Name: "a", PrivateIP: "10.0.0.1", PrivateDNS: "node"}, {Name: "b", PublicIP: "10.0.0.1", PrivateDNS: "node"}}, []string{Fail, Fail}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := statuses((&DuplicateAddressCheck{}).Run(&Target{Nodes: tt.nodes})); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DuplicateAddressCheck.Run() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDNSCheck(t *testing.T) {
+	prevLookupHost := lookupHost
+	defer func() { lookupHost = prevLookupHost }()
+	lookupHost = func(name string) ([]string, error) {
+		switch name {
+		case "node1.example.com":
+			return []string{"10.0.0.1"}, nil
+		case "node2.example.com":
+			return []string{"10.0.0.9"}, nil
+		}
+		return nil, fmt.Errorf("no such host")
+	}
+
+	resolver := &fakeRunner{outputs: map[string]string{
+		"getent hosts ip-10-0-0-1.ec2.internal": "10.0.0.1        ip-10-0-0-1.ec2.internal",
+	}}
+
+	tests := []struct {
+		name string
+		node Node
+		want string
+	}{
+		{"resolved", Node{PublicIP: "10.0.0.1", PublicDNS: "node1.example.com"}, Pass},
+		{"resolved to other IP", Node{PublicIP: "10.0.0.2", PublicDNS: "node2.example.com"}, Warn},
+		{"not resolved", Node{PublicIP: "10.0.0.3", PublicDNS: "node3.example.com"}, Warn},
+		{"private resolved on the node", Node{PrivateIP: "10.0.0.1", PrivateDNS: "ip-10-0-0-1.ec2.internal", Runner: resolver}, Pass},
+		{"private not resolved on the node", Node{PrivateIP: "10.0.0.3", PrivateDNS: "ip-10-0-0-3.ec2.internal", Runner: resolver}, Warn},
+		{"private not accessible", Node{PrivateIP: "10.0.0.1", PrivateDNS: "ip-10-0-0-1.ec2.internal"}, Skip},
+		{"no DNS", Node{PrivateIP: "10.0.0.4"}, Skip},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := (&DNSCheck{}).Run(&Target{Nodes: []Node{tt.node}})
+			if got[0].Status != tt.want {
+				t.Errorf("DNSCheck.Run() = %v, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNodeChecks(t *testing.T) {
+	prevNow := now
+	defer func() { now = prevNow }()
+	now = func() time.Time { return time.Unix(1600000000, 0) }
+
+	healthy := map[string]string{
+		"date":  "1600000010",
+		"tail":  "",
+		"df":    "/dev/sda1 51200 10240 40960 20% /",
+		"for m": "",
+		"ss":    "LISTEN 0 128 0.0.0.0:22 0.0.0.0:*",
+	}
+	unhealthy := map[string]string{
+		"date":  "1599999000",
+		"tail":  "/dev/sda2 partition 2097148 0 -2",
+		"df":    "/dev/sda1 51200 50000 1200 98% /",
+		"for m": "br_netfilter",
+		"ss":    "LISTEN 0 128 [::]:6443 [::]:*\nLISTEN 0 128 127.0.0.1:10250 0.0.0.0:*",
+	}
+
+	checks := []Check{
+		&ClockCheck{MaxSkew: DefaultMaxClockSkew},
+		&SwapCheck{},
+		&DiskCheck{Path: DefaultDiskPath, MinFreeMB: DefaultMinFreeDiskMB},
+		&KernelModulesCheck{Modules: DefaultKernelModules},
+		&PortsCheck{Ports: DefaultPorts},
+	}
+
+	tests := []struct {
+		name       string
+		node       Node
+		configured bool
+		want       []string
+	}{
+		{"healthy", Node{Name: "master", Role: "master", Runner: &fakeRunner{outputs: healthy}}, false, []string{Pass, Pass, Pass, Pass, Pass}},
+		{"unhealthy", Node{Name: "master", Role: "master", Runner: &fakeRunner{outputs: unhealthy}}, false, []string{Fail, Fail, Fail, Warn, Fail}},
+		{"configured", Node{Name: "master", Role: "master", Runner: &fakeRunner{outputs: unhealthy}}, true, []string{Fail, Fail, Fail, Warn, Skip}},
+		{"unreachable", Node{Name: "worker", Role: "worker", Runner: &fakeRunner{err: fmt.Errorf("connection refused")}}, false, []string{Fail, Fail, Fail, Fail, Fail}},
+		{"not accessible", Node{Name: "worker", Role: "worker"}, false, []string{Skip, Skip, Skip, Skip, Skip}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &Target{Nodes: []Node{tt.node}, Configured: tt.configured}
+			if got := statuses(Run(target, checks)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Run() = %v, want %v", Run(target, checks), tt.want)
+			}
+		})
+	}
+}
@@ -5,16 +5,18 @@ import (
 
 	"github.com/kraken/terraformer"
 	"github.com/kraken/ui"
+	"github.com/liferaft/kubekit/pkg/configurator/ssh"
 )
 
 // Platform implements the Provisioner interface for Azure AKS
 type Platform struct {
-	name    string
-	config  *Config
-	t       *terraformer.Terraformer
-	logger  *log.Logger
-	ui      *ui.UI
-	version string
+	name       string
+	config     *Config
+	t          *terraformer.Terraformer
+	logger     *log.Logger
+	ui         *ui.UI
+	version    string
+	knownHosts *ssh.KnownHosts
 }
 
 // New creates a new Plaform with the given environment configuration
@@ -1,6 +1,10 @@
 package aks
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/liferaft/kubekit/pkg/configurator/ssh"
+)
 
 // GetPublicKey return the public key and file from the configuration, also if
 // this platform requires a public key for provisioning
@@ -26,6 +30,11 @@ func (p *Platform) PrivateKey(file string, encKey, key []byte) {
 	p.config.PrivateKey = string(encKey)
 }
 
+// SetKnownHosts sets the known hosts to verify the host key of the jumpbox
+func (p *Platform) SetKnownHosts(knownHosts *ssh.KnownHosts) {
+	p.knownHosts = knownHosts
+}
+
 // Credentials is to assign the credentials to the configuration
 func (p *Platform) Credentials(params ...string) {
 	if len(params) != 4 {
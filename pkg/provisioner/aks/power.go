@@ -0,0 +1,178 @@
+package aks
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/liferaft/azure"
+	"github.com/liferaft/kubekit/pkg/provisioner/state"
+)
+
+// PowerOn starts the given nodes, or all the cluster nodes if none is given
+func (p *Platform) PowerOn(nodes []*state.Node) error {
+	return p.power(nodes, true)
+}
+
+// PowerOff stops the given nodes, or all the cluster nodes if none is given
+func (p *Platform) PowerOff(nodes []*state.Node) error {
+	return p.power(nodes, false)
+}
+
+// power starts or stops the nodes. The nodes in a virtual machine scale set
+// (the node pool) are selected by their instance ID, so only the given nodes
+// are started or stopped and not the entire scale set
+func (p *Platform) power(nodes []*state.Node, on bool) error {
+	if p.t == nil || p.t.State == nil || p.t.State.Empty() {
+		return fmt.Errorf("cannot power on/off the nodes, the %s platform does not have a state", p.name)
+	}
+
+	authInfo := &azure.AuthInfo{
+		SubscriptionID: p.config.SubscriptionID,
+		TenantID:       p.config.TenantID,
+		ClientID:       p.config.ClientID,
+		ClientSecret:   p.config.ClientSecret,
+	}
+	session, err := azure.NewSession(authInfo, false)
+	if err != nil {
+		return fmt.Errorf("issues connecting to Azure: %s", err)
+	}
+	vmssClient, err := azure.VMSSClientByEnvStr(p.config.Environment, session)
+	if err != nil {
+		return fmt.Errorf("issues connecting to Azure via VMSS Client: %s", err)
+	}
+	vmsClient, err := azure.VirtualMachinesClientByEnvStr(p.config.Environment, session)
+	if err != nil {
+		return fmt.Errorf("issues connecting to Azure via Virtual Machines Client: %s", err)
+	}
+	nicsClient, err := azure.NicsClientByEnvStr(p.config.Environment, session)
+	if err != nil {
+		return fmt.Errorf("issues connecting to Azure via Interface Client: %s", err)
+	}
+	publicIPsClient, err := azure.PublicIPAddressesClientByEnvStr(p.config.Environment, session)
+	if err != nil {
+		return fmt.Errorf("issues connecting to Azure via Public IP Addresses Client: %s", err)
+	}
+
+	output := p.t.State.RootModule().OutputValues
+	defaultNodeResourceGroup := "MC_" + p.config.ClusterName + "_" + p.config.ClusterName + "_" + reformatRGLocation(p.config.ResourceGroupLocation)
+	nodeResourceGroup := state.OutputKeysValueAsStringDefault(output, "node_resource_group", defaultNodeResourceGroup)
+
+	// the instance IDs of every scale set, no instance ID is all the instances
+	vmssInstances := map[string][]string{}
+	var vmNames []string
+	if len(nodes) == 0 {
+		vmssNames, err := azure.ListVMSSNames(vmssClient, nodeResourceGroup)
+		if err != nil {
+			return err
+		}
+		for _, name := range vmssNames {
+			vmssInstances[name] = nil
+		}
+		if vmNames, err = azure.ListVMNames(vmsClient, nodeResourceGroup); err != nil {
+			return err
+		}
+	} else {
+		var vmssNodes map[string][]*state.Node
+		vmssNodes, vmNames = groupNodes(nodes)
+		for name, poolNodes := range vmssNodes {
+			nics, err := azure.ListVMSSPrimaryIPs(nicsClient, publicIPsClient, nodeResourceGroup, name)
+			if err != nil {
+				return fmt.Errorf("issues retrieving the network interfaces of the scale set %s: %s", name, err)
+			}
+			if vmssInstances[name], err = instanceIDs(name, nics, poolNodes); err != nil {
+				return err
+			}
+		}
+	}
+
+	ctx := context.Background()
+
+	vmssNames := make([]string, 0, len(vmssInstances))
+	for name := range vmssInstances {
+		vmssNames = append(vmssNames, name)
+	}
+	sort.Strings(vmssNames)
+
+	for _, name := range vmssNames {
+		ids := vmssInstances[name]
+		instances := "all the nodes"
+		if len(ids) != 0 {
+			instances = fmt.Sprintf("the instances %s", strings.Join(ids, ", "))
+		}
+		if on {
+			p.ui.Log.Infof("starting %s of the scale set %s", instances, name)
+			err = azure.StartVMSSWithContext(vmssClient, ctx, nodeResourceGroup, name, ids...)
+		} else {
+			p.ui.Log.Infof("stopping %s of the scale set %s", instances, name)
+			err = azure.PowerOffVMSSWithContext(vmssClient, ctx, nodeResourceGroup, name, ids...)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, name := range vmNames {
+		if on {
+			p.ui.Log.Infof("starting node %s", name)
+			err = azure.StartVMWithContext(vmsClient, ctx, nodeResourceGroup, name)
+		} else {
+			p.ui.Log.Infof("stopping node %s", name)
+			err = azure.PowerOffVMWithContext(vmsClient, ctx, nodeResourceGroup, name)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// groupNodes returns the given nodes indexed by their scale set name and the
+// VM names of the nodes that are not in a scale set
+func groupNodes(nodes []*state.Node) (vmssNodes map[string][]*state.Node, vmNames []string) {
+	vmssNodes = map[string][]*state.Node{}
+	for _, n := range nodes {
+		if n.RoleName == "jumpbox" {
+			continue
+		}
+		if len(n.Pool) != 0 {
+			vmssNodes[n.Pool] = append(vmssNodes[n.Pool], n)
+			continue
+		}
+		vmNames = append(vmNames, strings.Split(n.PrivateDNS, ".")[0])
+	}
+	return vmssNodes, vmNames
+}
+
+// instanceIDs returns the instance IDs, in the given scale set, of the given
+// nodes. The instance is found by the private IP of its network interface, the
+// instance ID is the last element of the virtual machine resource ID
+func instanceIDs(vmssName string, nics []network.Interface, nodes []*state.Node) ([]string, error) {
+	ids := []string{}
+	for _, n := range nodes {
+		id := ""
+		for _, nic := range nics {
+			if nic.VirtualMachine == nil || nic.VirtualMachine.ID == nil || nic.IPConfigurations == nil {
+				continue
+			}
+			for _, ipConfig := range *nic.IPConfigurations {
+				if ipConfig.PrivateIPAddress != nil && *ipConfig.PrivateIPAddress == n.PrivateIP {
+					vmID := strings.Split(*nic.VirtualMachine.ID, "/")
+					id = vmID[len(vmID)-1]
+					break
+				}
+			}
+			if len(id) != 0 {
+				break
+			}
+		}
+		if len(id) == 0 {
+			return nil, fmt.Errorf("the node %s was not found in the scale set %s", n.PrivateIP, vmssName)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
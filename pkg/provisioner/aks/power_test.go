@@ -0,0 +1,89 @@
+package aks
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/liferaft/kubekit/pkg/provisioner/state"
+)
+
+func Test_groupNodes(t *testing.T) {
+	pool1Node0 := &state.Node{PrivateDNS: "aks-pool1-0", Pool: "aks-pool1"}
+	pool1Node1 := &state.Node{PrivateDNS: "aks-pool1-1", Pool: "aks-pool1"}
+	pool2Node0 := &state.Node{PrivateDNS: "aks-pool2-0", Pool: "aks-pool2"}
+
+	tests := []struct {
+		name          string
+		nodes         []*state.Node
+		wantVMSSNodes map[string][]*state.Node
+		wantVMName    []string
+	}{
+		{"no nodes", nil, map[string][]*state.Node{}, nil},
+		{"scale set nodes", []*state.Node{pool1Node0, pool1Node1, pool2Node0}, map[string][]*state.Node{
+			"aks-pool1": {pool1Node0, pool1Node1},
+			"aks-pool2": {pool2Node0},
+		}, nil},
+		{"availability set nodes", []*state.Node{
+			&state.Node{PrivateDNS: "aks-worker-0.example.com"},
+			&state.Node{PrivateDNS: "aks-worker-1.example.com"},
+		}, map[string][]*state.Node{}, []string{"aks-worker-0", "aks-worker-1"}},
+		{"skip jumpbox", []*state.Node{
+			&state.Node{PrivateDNS: "jumpbox.example.com", RoleName: "jumpbox"},
+			pool1Node0,
+			&state.Node{PrivateDNS: "aks-worker-0.example.com"},
+		}, map[string][]*state.Node{"aks-pool1": {pool1Node0}}, []string{"aks-worker-0"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotVMSSNodes, gotVMNames := groupNodes(tt.nodes)
+			if !reflect.DeepEqual(gotVMSSNodes, tt.wantVMSSNodes) {
+				t.Errorf("groupNodes() VMSS nodes = %v, want %v", gotVMSSNodes, tt.wantVMSSNodes)
+			}
+			if !reflect.DeepEqual(gotVMNames, tt.wantVMName) {
+				t.Errorf("groupNodes() VM names = %v, want %v", gotVMNames, tt.wantVMName)
+			}
+		})
+	}
+}
+
+func Test_instanceIDs(t *testing.T) {
+	nic := func(vmID, privateIP string) network.Interface {
+		return network.Interface{
+			InterfacePropertiesFormat: &network.InterfacePropertiesFormat{
+				VirtualMachine: &network.SubResource{ID: &vmID},
+				IPConfigurations: &[]network.InterfaceIPConfiguration{
+					{InterfaceIPConfigurationPropertiesFormat: &network.InterfaceIPConfigurationPropertiesFormat{PrivateIPAddress: &privateIP}},
+				},
+			},
+		}
+	}
+	vmssID := "/subscriptions/1234/resourceGroups/MC_kkdemo/providers/Microsoft.Compute/virtualMachineScaleSets/aks-pool1/virtualMachines/"
+	nics := []network.Interface{
+		nic(vmssID+"0", "10.240.0.4"),
+		nic(vmssID+"1", "10.240.0.5"),
+		nic(vmssID+"3", "10.240.0.7"),
+	}
+
+	tests := []struct {
+		name    string
+		nodes   []*state.Node
+		want    []string
+		wantErr bool
+	}{
+		{"one node", []*state.Node{{PrivateIP: "10.240.0.5"}}, []string{"1"}, false},
+		{"some nodes", []*state.Node{{PrivateIP: "10.240.0.4"}, {PrivateIP: "10.240.0.7"}}, []string{"0", "3"}, false},
+		{"unknown node", []*state.Node{{PrivateIP: "10.240.0.9"}}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := instanceIDs("aks-pool1", nics, tt.nodes)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("instanceIDs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("instanceIDs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
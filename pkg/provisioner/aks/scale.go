@@ -0,0 +1,40 @@
+package aks
+
+import (
+	"fmt"
+
+	"github.com/liferaft/kubekit/pkg/provisioner/state"
+)
+
+// PoolSize returns the number of nodes the given node pool should have
+func (p *Platform) PoolSize(pool string) (int, error) {
+	nodePool, ok := p.config.NodePools[pool]
+	if !ok {
+		return 0, fmt.Errorf("node pool %q not found in the %s configuration", pool, p.name)
+	}
+	return nodePool.Count, nil
+}
+
+// Scale sets the number of nodes of the given node pool. The nodes are created
+// or destroyed by the provisioner the next time the changes are applied, so the
+// given nodes are ignored
+func (p *Platform) Scale(pool string, count int, nodes []*state.Node) error {
+	nodePool, ok := p.config.NodePools[pool]
+	if !ok {
+		return fmt.Errorf("node pool %q not found in the %s configuration", pool, p.name)
+	}
+	if count < 0 {
+		return fmt.Errorf("cannot scale the node pool %q to %d nodes", pool, count)
+	}
+	if nodePool.EnableAutoScaling {
+		return fmt.Errorf("the node pool %q has auto scaling enabled, its size is managed by %s", pool, p.name)
+	}
+
+	nodePool.Count = count
+	p.config.NodePools[pool] = nodePool
+
+	// The Terraform code has to be rendered again with the new number of nodes
+	p.t = nil
+
+	return nil
+}
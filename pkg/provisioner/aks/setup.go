@@ -193,7 +193,7 @@ func (p *Platform) setupJumpbox(session *azure.Session, output map[string]*state
 				p.config.Jumpbox.PrivateKey = string(privateKey)
 			}
 
-			sshConfig, err := ssh.New(p.config.Jumpbox.AdminUsername, hostIP, p.config.Jumpbox.PrivateKey, "")
+			sshConfig, err := ssh.New(p.config.Jumpbox.AdminUsername, hostIP, p.config.Jumpbox.PrivateKey, "", p.knownHosts)
 			if err != nil {
 				return fmt.Errorf("unable to create ssh config: %s", err)
 			}
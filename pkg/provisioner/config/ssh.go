@@ -0,0 +1,24 @@
+package config
+
+// SSHBastion defines the bastion or jump host to connect with SSH to the
+// cluster nodes. The user and the private key of the cluster are used if they
+// are not set
+type SSHBastion struct {
+	Host           string `json:"host" yaml:"host" mapstructure:"host"`
+	Port           int    `json:"port,omitempty" yaml:"port,omitempty" mapstructure:"port"`
+	User           string `json:"user,omitempty" yaml:"user,omitempty" mapstructure:"user"`
+	PrivateKeyFile string `json:"private_key_file,omitempty" yaml:"private_key_file,omitempty" mapstructure:"private_key_file"`
+}
+
+// GetSSHBastion extracts a SSHBastion from an map[interface{}]interface{}
+func GetSSHBastion(m map[interface{}]interface{}) *SSHBastion {
+	if len(m) == 0 {
+		return nil
+	}
+	b := SSHBastion{}
+	for k, v := range m {
+		name := k.(string)
+		SetField(&b, name, v)
+	}
+	return &b
+}
@@ -381,8 +381,26 @@ resource "null_resource" "wait-{{ Dash ( Lower $v.Name ) }}" {
     timeout     = "{{ $v.ConnectionTimeout }}"
     user        = "{{ $.Username }}"
     private_key = "${var.private_key}"
+    {{- if SSHAgent }}
+    agent       = true
+    {{- end }}
+    {{- if $.SSHPort }}
+    port        = {{ $.SSHPort }}
+    {{- end }}
+    {{- with Bastion $v }}
+    bastion_host = "{{ .Host }}"
+    {{- if .Port }}
+    bastion_port = {{ .Port }}
+    {{- end }}
+    {{- if .User }}
+    bastion_user = "{{ .User }}"
+    {{- end }}
+    {{- if .PrivateKeyFile }}
+    bastion_private_key = "${file("{{ .PrivateKeyFile }}")}"
+    {{- end }}
+    {{- end }}
     host        =
-      {{- if $.ConfigureFromPrivateNet -}}
+      {{- if or $.ConfigureFromPrivateNet (Bastion $v) -}}
         element( data.aws_instance.{{- Dash $v.Name }}.*.private_ip, count.index )
       {{- else -}}
         element( data.aws_instance.{{- Dash $v.Name }}.*.public_ip, count.index )
@@ -88,6 +88,12 @@ type Config struct {
 	PrivateKeyFile          string                             `json:"private_key_file" yaml:"private_key_file" mapstructure:"private_key_file"`
 	PublicKey               string                             `json:"public_key,omitempty" yaml:"public_key,omitempty" mapstructure:"public_key"`
 	PublicKeyFile           string                             `json:"public_key_file" yaml:"public_key_file" mapstructure:"public_key_file"`
+	SSHKeyType              string                             `json:"ssh_key_type,omitempty" yaml:"ssh_key_type,omitempty" mapstructure:"ssh_key_type"`
+	SSHAuthMethods          []string                           `json:"ssh_auth_methods,omitempty" yaml:"ssh_auth_methods,omitempty" mapstructure:"ssh_auth_methods"`
+	SSHPrivateKeyFiles      []string                           `json:"ssh_private_key_files,omitempty" yaml:"ssh_private_key_files,omitempty" mapstructure:"ssh_private_key_files"`
+	SSHPort                 int                                `json:"ssh_port,omitempty" yaml:"ssh_port,omitempty" mapstructure:"ssh_port"`
+	SSHTimeout              string                             `json:"ssh_timeout,omitempty" yaml:"ssh_timeout,omitempty" mapstructure:"ssh_timeout"`
+	SSHBastion              *config.SSHBastion                 `json:"ssh_bastion,omitempty" yaml:"ssh_bastion,omitempty" mapstructure:"ssh_bastion"`
 	ConfigureFromPrivateNet bool                               `json:"configure_from_private_net" yaml:"configure_from_private_net" mapstructure:"configure_from_private_net"`
 	DNSServers              []string                           `json:"dns_servers" yaml:"dns_servers" mapstructure:"dns_servers"`
 	DNSSearch               []string                           `json:"dns_search" yaml:"dns_search" mapstructure:"dns_search"`
@@ -99,18 +105,19 @@ type Config struct {
 
 // NodePool defines the settings for group of instances on AWS
 type NodePool struct {
-	Name              string   `json:"-" yaml:"-" mapstructure:"name"`
-	Count             int      `json:"count" yaml:"count" mapstructure:"count"`
-	ConnectionTimeout string   `json:"connection_timeout,omitempty" yaml:"connection_timeout,omitempty" mapstructure:"connection_timeout"`
-	Ami               string   `json:"aws_ami,omitempty" yaml:"aws_ami,omitempty" mapstructure:"aws_ami"`
-	InstanceType      string   `json:"aws_instance_type,omitempty" yaml:"aws_instance_type,omitempty" mapstructure:"aws_instance_type"`
-	RootVolumeSize    int      `json:"root_volume_size,omitempty" yaml:"root_volume_size,omitempty" mapstructure:"root_volume_size"`
-	RootVolumeType    string   `json:"root_volume_type,omitempty" yaml:"root_volume_type,omitempty" mapstructure:"root_volume_type"`
-	PGStrategy        string   `json:"placementgroup_strategy,omitempty" yaml:"placementgroup_strategy,omitempty" mapstructure:"placementgroup_strategy"`
-	SecurityGroups    []string `json:"security_groups,omitempty" yaml:"security_groups,omitempty" mapstructure:"security_groups"`
-	Subnets           []string `json:"subnets,omitempty" yaml:"subnets,omitempty" mapstructure:"subnets"`
-	KubeletNodeLabels []string `json:"kubelet_node_labels,omitempty" yaml:"kubelet_node_labels,omitempty" mapstructure:"kubelet_node_labels"`
-	KubeletNodeTaints []string `json:"kubelet_node_taints,omitempty" yaml:"kubelet_node_taints,omitempty" mapstructure:"kubelet_node_taints"`
+	Name              string             `json:"-" yaml:"-" mapstructure:"name"`
+	Count             int                `json:"count" yaml:"count" mapstructure:"count"`
+	ConnectionTimeout string             `json:"connection_timeout,omitempty" yaml:"connection_timeout,omitempty" mapstructure:"connection_timeout"`
+	Ami               string             `json:"aws_ami,omitempty" yaml:"aws_ami,omitempty" mapstructure:"aws_ami"`
+	InstanceType      string             `json:"aws_instance_type,omitempty" yaml:"aws_instance_type,omitempty" mapstructure:"aws_instance_type"`
+	RootVolumeSize    int                `json:"root_volume_size,omitempty" yaml:"root_volume_size,omitempty" mapstructure:"root_volume_size"`
+	RootVolumeType    string             `json:"root_volume_type,omitempty" yaml:"root_volume_type,omitempty" mapstructure:"root_volume_type"`
+	PGStrategy        string             `json:"placementgroup_strategy,omitempty" yaml:"placementgroup_strategy,omitempty" mapstructure:"placementgroup_strategy"`
+	SecurityGroups    []string           `json:"security_groups,omitempty" yaml:"security_groups,omitempty" mapstructure:"security_groups"`
+	Subnets           []string           `json:"subnets,omitempty" yaml:"subnets,omitempty" mapstructure:"subnets"`
+	KubeletNodeLabels []string           `json:"kubelet_node_labels,omitempty" yaml:"kubelet_node_labels,omitempty" mapstructure:"kubelet_node_labels"`
+	KubeletNodeTaints []string           `json:"kubelet_node_taints,omitempty" yaml:"kubelet_node_taints,omitempty" mapstructure:"kubelet_node_taints"`
+	SSHBastion        *config.SSHBastion `json:"ssh_bastion,omitempty" yaml:"ssh_bastion,omitempty" mapstructure:"ssh_bastion"`
 }
 
 // MergeNodePools merges the node pools in this configuration with the given
@@ -192,12 +199,19 @@ func (c *Config) MergeWithMapConfig(m map[interface{}]interface{}) {
 		case "elastic_fileshares":
 			m1 := v.(map[interface{}]interface{})
 			c.ElasticFileshares = config.GetElasticFileshares(m1)
+		case "ssh_bastion":
+			m1, _ := v.(map[interface{}]interface{})
+			c.SSHBastion = config.GetSSHBastion(m1)
 		case "dns_servers":
 			c.DNSServers = config.GetListFromInterface(v)
 		case "dns_search":
 			c.DNSSearch = config.GetListFromInterface(v)
 		case "time_servers":
 			c.TimeServers = config.GetListFromInterface(v)
+		case "ssh_auth_methods":
+			c.SSHAuthMethods = config.GetListFromInterface(v)
+		case "ssh_private_key_files":
+			c.SSHPrivateKeyFiles = config.GetListFromInterface(v)
 		default:
 			config.SetField(c, name, v)
 		}
@@ -217,6 +231,9 @@ func getNodePool(m map[interface{}]interface{}) NodePool {
 			n.KubeletNodeLabels = config.GetListFromInterface(v)
 		case "kubelet_node_taints":
 			n.KubeletNodeTaints = config.GetListFromInterface(v)
+		case "ssh_bastion":
+			m1, _ := v.(map[interface{}]interface{})
+			n.SSHBastion = config.GetSSHBastion(m1)
 		default:
 			config.SetField(&n, name, v)
 		}
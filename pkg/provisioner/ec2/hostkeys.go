@@ -0,0 +1,65 @@
+package ec2
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/liferaft/kubekit/pkg/configurator/ssh"
+	"github.com/liferaft/kubekit/pkg/provisioner/state"
+)
+
+// HostKeys returns the SSH host keys of the given nodes, or all the cluster
+// nodes if none is given, indexed by the node public and private IP, as the
+// nodes behind a SSH bastion are reached by the private IP. The keys are taken
+// from the host keys printed by cloud-init in the console output, the nodes
+// without console output yet are skipped
+func (p *Platform) HostKeys(nodes []*state.Node) (map[string][]string, error) {
+	if len(nodes) == 0 {
+		nodes = p.Nodes()
+	}
+	svc, instances, err := p.describeInstances(nodes)
+	if err != nil || len(instances) == 0 {
+		return nil, err
+	}
+
+	publicIPs := map[string]string{}
+	for _, n := range nodes {
+		publicIPs[n.PrivateIP] = n.PublicIP
+	}
+
+	hostKeys := map[string][]string{}
+	for _, instance := range instances {
+		privateIP := aws.StringValue(instance.PrivateIpAddress)
+		publicIP, ok := publicIPs[privateIP]
+		if !ok {
+			continue
+		}
+
+		output, err := svc.GetConsoleOutput(&awsec2.GetConsoleOutputInput{InstanceId: instance.InstanceId})
+		if err != nil {
+			return hostKeys, fmt.Errorf("failed to get the console output of the instance %s. %s", aws.StringValue(instance.InstanceId), err)
+		}
+		if output.Output == nil {
+			p.ui.Log.Debugf("the console output of the instance %s is not available yet", aws.StringValue(instance.InstanceId))
+			continue
+		}
+		console, err := base64.StdEncoding.DecodeString(aws.StringValue(output.Output))
+		if err != nil {
+			return hostKeys, fmt.Errorf("failed to decode the console output of the instance %s. %s", aws.StringValue(instance.InstanceId), err)
+		}
+
+		keys := ssh.ParseHostKeys(string(console))
+		if len(keys) == 0 {
+			continue
+		}
+		for _, host := range []string{publicIP, privateIP} {
+			if len(host) != 0 {
+				hostKeys[host] = keys
+			}
+		}
+	}
+
+	return hostKeys, nil
+}
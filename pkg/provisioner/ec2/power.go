@@ -0,0 +1,105 @@
+package ec2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/liferaft/kubekit/pkg/provisioner/state"
+)
+
+// PowerOn starts the given nodes, or all the cluster nodes if none is given
+func (p *Platform) PowerOn(nodes []*state.Node) error {
+	svc, ids, err := p.instances(nodes)
+	if err != nil || len(ids) == 0 {
+		return err
+	}
+
+	p.ui.Log.Infof("starting %d instances", len(ids))
+	if _, err := svc.StartInstances(&awsec2.StartInstancesInput{InstanceIds: ids}); err != nil {
+		return fmt.Errorf("failed to start the instances. %s", err)
+	}
+
+	return svc.WaitUntilInstanceRunning(&awsec2.DescribeInstancesInput{InstanceIds: ids})
+}
+
+// PowerOff stops the given nodes, or all the cluster nodes if none is given
+func (p *Platform) PowerOff(nodes []*state.Node) error {
+	svc, ids, err := p.instances(nodes)
+	if err != nil || len(ids) == 0 {
+		return err
+	}
+
+	p.ui.Log.Infof("stopping %d instances", len(ids))
+	if _, err := svc.StopInstances(&awsec2.StopInstancesInput{InstanceIds: ids}); err != nil {
+		return fmt.Errorf("failed to stop the instances. %s", err)
+	}
+
+	return svc.WaitUntilInstanceStopped(&awsec2.DescribeInstancesInput{InstanceIds: ids})
+}
+
+// instances returns an EC2 client and the IDs of the instances of the given
+// nodes. The instances are identified by their private IP in the cluster VPC
+func (p *Platform) instances(nodes []*state.Node) (*awsec2.EC2, []*string, error) {
+	svc, instances, err := p.describeInstances(nodes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ids := []*string{}
+	for _, instance := range instances {
+		ids = append(ids, instance.InstanceId)
+	}
+
+	return svc, ids, nil
+}
+
+// describeInstances returns an EC2 client and the instances of the given
+// nodes, or all the cluster nodes if none is given
+func (p *Platform) describeInstances(nodes []*state.Node) (*awsec2.EC2, []*awsec2.Instance, error) {
+	if len(nodes) == 0 {
+		nodes = p.Nodes()
+	}
+	if len(nodes) == 0 {
+		return nil, nil, nil
+	}
+
+	sess, err := session.NewSession(&aws.Config{
+		Region:      aws.String(p.config.AwsRegion),
+		Credentials: credentials.NewStaticCredentials(p.config.AwsAccessKey, p.config.AwsSecretKey, p.config.AwsSessionToken),
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create the AWS session. %s", err)
+	}
+	svc := awsec2.New(sess)
+
+	privateIPs := []*string{}
+	for _, n := range nodes {
+		privateIPs = append(privateIPs, aws.String(n.PrivateIP))
+	}
+
+	input := &awsec2.DescribeInstancesInput{
+		Filters: []*awsec2.Filter{
+			{Name: aws.String("vpc-id"), Values: []*string{aws.String(p.config.AwsVpcID)}},
+			{Name: aws.String("private-ip-address"), Values: privateIPs},
+		},
+	}
+
+	instances := []*awsec2.Instance{}
+	err = svc.DescribeInstancesPages(input, func(page *awsec2.DescribeInstancesOutput, lastPage bool) bool {
+		for _, reservation := range page.Reservations {
+			instances = append(instances, reservation.Instances...)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get the instances of the nodes. %s", err)
+	}
+	if len(instances) != len(nodes) {
+		p.ui.Log.Warnf("found %d instances for %d nodes", len(instances), len(nodes))
+	}
+
+	return svc, instances, nil
+}
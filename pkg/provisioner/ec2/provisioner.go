@@ -8,7 +8,9 @@ import (
 
 	"github.com/hashicorp/terraform/builtin/provisioners/file"
 	"github.com/kraken/terraformer"
+	"github.com/liferaft/kubekit/pkg/configurator/ssh"
 	"github.com/liferaft/kubekit/pkg/crypto"
+	"github.com/liferaft/kubekit/pkg/provisioner/config"
 	"github.com/liferaft/kubekit/pkg/provisioner/utils"
 	"github.com/terraform-providers/terraform-provider-aws/aws"
 )
@@ -24,7 +26,14 @@ func (p *Platform) BeProvisioner(state *terraformer.State) error {
 		return nil
 	}
 
+	// a private key that cannot be decrypted is an error, instead of provisioning
+	// without it
+	key, err := privateKey(p.config.PrivateKeyFile, p.config.PrivateKey)
+	if err != nil {
+		return err
+	}
 	variables := p.Variables()
+	variables["private_key"] = key
 	rendered := p.Code()
 
 	// DEBUG
@@ -54,6 +63,56 @@ func (p *Platform) Plan(destroy bool) (plan *terraformer.Plan, err error) {
 	return p.t.Plan(destroy)
 }
 
+// Refresh refreshes the Terraform state with the real infrastructure of the
+// cluster on this platform. The refreshed state is not persisted
+func (p *Platform) Refresh() error {
+	if p.t == nil {
+		return fmt.Errorf("cannot refresh the state, the %s plaftorm is not a provisioner yet", p.name)
+	}
+
+	p.ui.Log.Debug("refreshing the cluster state")
+	return p.t.Refresh(false)
+}
+
+// SensitiveAttributes returns the attributes with sensitive values of every
+// resource in the state of this platform, indexed by the resource address
+func (p *Platform) SensitiveAttributes() (map[string]map[string]bool, error) {
+	if p.t == nil {
+		return nil, fmt.Errorf("cannot get the sensitive attributes, the %s plaftorm is not a provisioner yet", p.name)
+	}
+
+	return p.t.SensitiveAttributes()
+}
+
+// Changes returns the resource changes of the given plan of this platform
+func (p *Platform) Changes(plan *terraformer.Plan) ([]*terraformer.ResourceChange, error) {
+	if p.t == nil {
+		return nil, fmt.Errorf("cannot get the plan changes, the %s plaftorm is not a provisioner yet", p.name)
+	}
+
+	return p.t.Changes(plan)
+}
+
+// SavePlan saves the last plan of this platform to the given file
+func (p *Platform) SavePlan(filename string) error {
+	if p.t == nil {
+		return fmt.Errorf("cannot save the plan, the %s plaftorm is not a provisioner yet", p.name)
+	}
+
+	p.ui.Log.Debugf("saving the cluster plan to %s", filename)
+	return p.t.SavePlan(filename)
+}
+
+// ApplyPlan applies the changes of the plan saved in the given file
+func (p *Platform) ApplyPlan(filename string) error {
+	if p.t == nil {
+		return fmt.Errorf("cannot apply the plan, the %s plaftorm is not a provisioner yet", p.name)
+	}
+
+	p.ui.Log.Debugf("applying the cluster plan from %s", filename)
+	return p.t.ApplyPlan(filename)
+}
+
 // Apply apply the changes either to create or destroy the cluster on this platform
 func (p *Platform) Apply(destroy bool) error {
 	if p.t == nil {
@@ -173,6 +232,20 @@ func (p *Platform) Code() []byte {
 			}
 			return nets
 		},
+		"SSHAgent": func() bool {
+			for _, method := range p.config.SSHAuthMethods {
+				if method == ssh.AgentAuth {
+					return true
+				}
+			}
+			return false
+		},
+		"Bastion": func(n NodePool) *config.SSHBastion {
+			if n.SSHBastion != nil {
+				return n.SSHBastion
+			}
+			return p.config.SSHBastion
+		},
 		"IsFastEphemeral": func(n NodePool) bool {
 			for _, label := range n.KubeletNodeLabels {
 				if label == "ephemeral-volumes=fast" {
@@ -216,12 +289,16 @@ func (p *Platform) Code() []byte {
 // Note: Variables has been reduced to sensative data fields such as credentials
 // and private keys. All other values are rendered directly from Config.
 func (p *Platform) Variables() map[string]interface{} {
+	key, err := privateKey(p.config.PrivateKeyFile, p.config.PrivateKey)
+	if err != nil {
+		p.ui.Log.Errorf("failed to get the private key. %s", err)
+	}
 	return map[string]interface{}{
 		"aws_access_key": p.config.AwsAccessKey,
 		"aws_secret_key": p.config.AwsSecretKey,
 		"aws_region":     p.config.AwsRegion,
 		"aws_token":      p.config.AwsSessionToken,
-		"private_key":    cryptoKey(p.config.PrivateKey),
+		"private_key":    key,
 	}
 }
 
@@ -246,6 +323,17 @@ func (p *Platform) reconcileVersion(config *Config) {
 	}
 }
 
+// privateKey returns the private key to connect to the nodes, decrypted with
+// its passphrase if it's protected. An empty key makes Terraform use the SSH
+// agent
+func privateKey(name, key string) (string, error) {
+	decrypted, err := ssh.DecryptPrivateKey(name, []byte(cryptoKey(key)))
+	if err != nil {
+		return "", err
+	}
+	return string(decrypted), nil
+}
+
 func cryptoKey(key string) string {
 	if crypto.IsEncrypted(key) {
 		if c, err := crypto.New(nil); err == nil {
@@ -31,6 +31,15 @@ func (p *Platform) PersistStateToFile(filename string) error {
 	return p.t.PersistStateToFile(filename)
 }
 
+// PersistStateToEncryptedFile is like PersistStateToFile but the state file is
+// encrypted with the given cipher
+func (p *Platform) PersistStateToEncryptedFile(filename string, cipher terraformer.StateCipher) error {
+	if p.t == nil {
+		return nil
+	}
+	return p.t.PersistStateToEncryptedFile(filename, cipher)
+}
+
 // LoadState loads the given Terraform state in a buffer into the terraformer state
 func (p *Platform) LoadState(stateBuffer *bytes.Buffer) error {
 	if p.t == nil {
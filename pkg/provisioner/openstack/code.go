@@ -161,9 +161,31 @@ resource "null_resource" "wait-{{ Dash ( Lower $k ) }}" {
 
   connection {
     user        = "{{ $.Username }}"
+    {{- if Bastion $v }}
+    host        = element(openstack_compute_instance_v2.{{ Dash ( Lower $v.Name ) }}.*.access_ip_v4, count.index)
+    {{- else }}
     host        = element(openstack_networking_floatingip_v2.float-{{ Dash ( Lower $k ) }}.*.address, count.index)
+    {{- end }}
     private_key = var.private_key
+    {{- if SSHAgent }}
+    agent       = true
+    {{- end }}
     timeout     = "5m"
+    {{- if $.SSHPort }}
+    port        = {{ $.SSHPort }}
+    {{- end }}
+    {{- with Bastion $v }}
+    bastion_host = "{{ .Host }}"
+    {{- if .Port }}
+    bastion_port = {{ .Port }}
+    {{- end }}
+    {{- if .User }}
+    bastion_user = "{{ .User }}"
+    {{- end }}
+    {{- if .PrivateKeyFile }}
+    bastion_private_key = file("{{ .PrivateKeyFile }}")
+    {{- end }}
+    {{- end }}
   }
 
   provisioner "file" {
@@ -70,22 +70,28 @@ const requiredValue = "# Required value. Example: "
 type Config struct {
 	// Following fields are platform generic
 	// TODO: refactor common fields into common data structure
-	ClusterName             string   `json:"-" yaml:"-" mapstructure:"clustername"`
-	KubeAPISSLPort          int      `json:"kube_api_ssl_port" yaml:"kube_api_ssl_port" mapstructure:"kube_api_ssl_port"`
-	DisableMasterHA         bool     `json:"disable_master_ha" yaml:"disable_master_ha" mapstructure:"disable_master_ha"`
-	KubeVirtualIPShortname  string   `json:"kube_virtual_ip_shortname" yaml:"kube_virtual_ip_shortname" mapstructure:"kube_virtual_ip_shortname"`
-	KubeVirtualIPApi        string   `json:"kube_virtual_ip_api" yaml:"kube_virtual_ip_api" mapstructure:"kube_virtual_ip_api"`
-	KubeVIPAPISSLPort       int      `json:"kube_vip_api_ssl_port" yaml:"kube_vip_api_ssl_port" mapstructure:"kube_vip_api_ssl_port"`
-	PublicAPIServerDNSName  string   `json:"public_apiserver_dns_name" yaml:"public_apiserver_dns_name" mapstructure:"public_apiserver_dns_name"`
-	PrivateAPIServerDNSName string   `json:"private_apiserver_dns_name" yaml:"private_apiserver_dns_name" mapstructure:"private_apiserver_dns_name"`
-	Username                string   `json:"username" yaml:"username" mapstructure:"username"`
-	PrivateKey              string   `json:"private_key,omitempty" yaml:"private_key,omitempty" mapstructure:"private_key"`
-	PrivateKeyFile          string   `json:"private_key_file" yaml:"private_key_file" mapstructure:"private_key_file"`
-	PublicKey               string   `json:"public_key,omitempty" yaml:"public_key,omitempty" mapstructure:"public_key"`
-	PublicKeyFile           string   `json:"public_key_file" yaml:"public_key_file" mapstructure:"public_key_file"`
-	DNSServers              []string `json:"dns_servers" yaml:"dns_servers" mapstructure:"dns_servers"`
-	DNSSearch               []string `json:"dns_search" yaml:"dns_search" mapstructure:"dns_search"`
-	TimeServers             []string `json:"time_servers" yaml:"time_servers" mapstructure:"time_servers"`
+	ClusterName             string             `json:"-" yaml:"-" mapstructure:"clustername"`
+	KubeAPISSLPort          int                `json:"kube_api_ssl_port" yaml:"kube_api_ssl_port" mapstructure:"kube_api_ssl_port"`
+	DisableMasterHA         bool               `json:"disable_master_ha" yaml:"disable_master_ha" mapstructure:"disable_master_ha"`
+	KubeVirtualIPShortname  string             `json:"kube_virtual_ip_shortname" yaml:"kube_virtual_ip_shortname" mapstructure:"kube_virtual_ip_shortname"`
+	KubeVirtualIPApi        string             `json:"kube_virtual_ip_api" yaml:"kube_virtual_ip_api" mapstructure:"kube_virtual_ip_api"`
+	KubeVIPAPISSLPort       int                `json:"kube_vip_api_ssl_port" yaml:"kube_vip_api_ssl_port" mapstructure:"kube_vip_api_ssl_port"`
+	PublicAPIServerDNSName  string             `json:"public_apiserver_dns_name" yaml:"public_apiserver_dns_name" mapstructure:"public_apiserver_dns_name"`
+	PrivateAPIServerDNSName string             `json:"private_apiserver_dns_name" yaml:"private_apiserver_dns_name" mapstructure:"private_apiserver_dns_name"`
+	Username                string             `json:"username" yaml:"username" mapstructure:"username"`
+	PrivateKey              string             `json:"private_key,omitempty" yaml:"private_key,omitempty" mapstructure:"private_key"`
+	PrivateKeyFile          string             `json:"private_key_file" yaml:"private_key_file" mapstructure:"private_key_file"`
+	PublicKey               string             `json:"public_key,omitempty" yaml:"public_key,omitempty" mapstructure:"public_key"`
+	PublicKeyFile           string             `json:"public_key_file" yaml:"public_key_file" mapstructure:"public_key_file"`
+	SSHKeyType              string             `json:"ssh_key_type,omitempty" yaml:"ssh_key_type,omitempty" mapstructure:"ssh_key_type"`
+	SSHAuthMethods          []string           `json:"ssh_auth_methods,omitempty" yaml:"ssh_auth_methods,omitempty" mapstructure:"ssh_auth_methods"`
+	SSHPrivateKeyFiles      []string           `json:"ssh_private_key_files,omitempty" yaml:"ssh_private_key_files,omitempty" mapstructure:"ssh_private_key_files"`
+	SSHPort                 int                `json:"ssh_port,omitempty" yaml:"ssh_port,omitempty" mapstructure:"ssh_port"`
+	SSHTimeout              string             `json:"ssh_timeout,omitempty" yaml:"ssh_timeout,omitempty" mapstructure:"ssh_timeout"`
+	SSHBastion              *config.SSHBastion `json:"ssh_bastion,omitempty" yaml:"ssh_bastion,omitempty" mapstructure:"ssh_bastion"`
+	DNSServers              []string           `json:"dns_servers" yaml:"dns_servers" mapstructure:"dns_servers"`
+	DNSSearch               []string           `json:"dns_search" yaml:"dns_search" mapstructure:"dns_search"`
+	TimeServers             []string           `json:"time_servers" yaml:"time_servers" mapstructure:"time_servers"`
 
 	// Following are openstack specific fields
 	OpenstackTenantName string              `json:"openstack_tenant_name,omitempty" yaml:"openstack_tenant_name" mapstructure:"openstack_tenant_name"`
@@ -101,13 +107,14 @@ type Config struct {
 
 // NodePool defines the settings for group of instances on Openstack
 type NodePool struct {
-	Name              string   `json:"-" yaml:"-" mapstructure:"name"`
-	Count             int      `json:"count" yaml:"count" mapstructure:"count"`
-	OpenstackImageID  string   `json:"openstack_image_id,omitempty" yaml:"openstack_image_id,omitempty" mapstructure:"openstack_image_id"`
-	OpenstackFlavorID string   `json:"openstack_flavor_id,omitempty" yaml:"openstack_flavor_id,omitempty" mapstructure:"openstack_flavor_id"`
-	SecurityGroups    []string `json:"security_groups,omitempty" yaml:"security_groups,omitempty" mapstructure:"security_groups"`
-	KubeletNodeLabels []string `json:"kubelet_node_labels,omitempty" yaml:"kubelet_node_labels,omitempty" mapstructure:"kubelet_node_labels"`
-	KubeletNodeTaints []string `json:"kubelet_node_taints,omitempty" yaml:"kubelet_node_taints,omitempty" mapstructure:"kubelet_node_taints"`
+	Name              string             `json:"-" yaml:"-" mapstructure:"name"`
+	Count             int                `json:"count" yaml:"count" mapstructure:"count"`
+	OpenstackImageID  string             `json:"openstack_image_id,omitempty" yaml:"openstack_image_id,omitempty" mapstructure:"openstack_image_id"`
+	OpenstackFlavorID string             `json:"openstack_flavor_id,omitempty" yaml:"openstack_flavor_id,omitempty" mapstructure:"openstack_flavor_id"`
+	SecurityGroups    []string           `json:"security_groups,omitempty" yaml:"security_groups,omitempty" mapstructure:"security_groups"`
+	KubeletNodeLabels []string           `json:"kubelet_node_labels,omitempty" yaml:"kubelet_node_labels,omitempty" mapstructure:"kubelet_node_labels"`
+	KubeletNodeTaints []string           `json:"kubelet_node_taints,omitempty" yaml:"kubelet_node_taints,omitempty" mapstructure:"kubelet_node_taints"`
+	SSHBastion        *config.SSHBastion `json:"ssh_bastion,omitempty" yaml:"ssh_bastion,omitempty" mapstructure:"ssh_bastion"`
 }
 
 // MergeNodePools merges the node pools in this configuration with the given
@@ -167,12 +174,19 @@ func (c *Config) MergeWithMapConfig(m map[interface{}]interface{}) {
 		case "node_pools":
 			m1 := v.(map[interface{}]interface{})
 			c.NodePools = getNodePools(m1)
+		case "ssh_bastion":
+			m1, _ := v.(map[interface{}]interface{})
+			c.SSHBastion = config.GetSSHBastion(m1)
 		case "dns_servers":
 			c.DNSServers = config.GetListFromInterface(v)
 		case "dns_search":
 			c.DNSSearch = config.GetListFromInterface(v)
 		case "time_servers":
 			c.TimeServers = config.GetListFromInterface(v)
+		case "ssh_auth_methods":
+			c.SSHAuthMethods = config.GetListFromInterface(v)
+		case "ssh_private_key_files":
+			c.SSHPrivateKeyFiles = config.GetListFromInterface(v)
 		case "openstack_password", "openstack_user_name", "openstack_auth_url":
 			panic(fmt.Errorf("field %s is obsolete, please remove it from cluster.yaml and use kubekit login for credentials", name))
 		default:
@@ -192,6 +206,9 @@ func getNodePool(m map[interface{}]interface{}) NodePool {
 			n.KubeletNodeLabels = config.GetListFromInterface(v)
 		case "kubelet_node_taints":
 			n.KubeletNodeTaints = config.GetListFromInterface(v)
+		case "ssh_bastion":
+			m1, _ := v.(map[interface{}]interface{})
+			n.SSHBastion = config.GetSSHBastion(m1)
 		default:
 			config.SetField(&n, name, v)
 		}
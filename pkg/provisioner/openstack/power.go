@@ -0,0 +1,91 @@
+package openstack
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/startstop"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/liferaft/kubekit/pkg/provisioner/state"
+)
+
+// powerTimeout is the number of seconds to wait for a server to be active or stopped
+const powerTimeout = 300
+
+// PowerOn starts the given nodes, or all the cluster nodes if none is given
+func (p *Platform) PowerOn(nodes []*state.Node) error {
+	return p.power(nodes, true)
+}
+
+// PowerOff stops the given nodes, or all the cluster nodes if none is given
+func (p *Platform) PowerOff(nodes []*state.Node) error {
+	return p.power(nodes, false)
+}
+
+// power starts or stops the servers of the given nodes. The servers are
+// identified by name, which is the node private DNS
+func (p *Platform) power(nodes []*state.Node, on bool) error {
+	if len(nodes) == 0 {
+		nodes = p.Nodes()
+	}
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	provider, err := openstack.AuthenticatedClient(gophercloud.AuthOptions{
+		IdentityEndpoint: p.config.OpenstackAuthURL,
+		Username:         p.config.OpenstackUserName,
+		Password:         p.config.OpenstackPassword,
+		TenantName:       p.config.OpenstackTenantName,
+		DomainName:       p.config.OpenstackDomainName,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to authenticate to Openstack. %s", err)
+	}
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: p.config.OpenstackRegion,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create the Openstack compute client. %s", err)
+	}
+
+	for _, n := range nodes {
+		pages, err := servers.List(client, servers.ListOpts{Name: "^" + n.PrivateDNS + "$"}).AllPages()
+		if err != nil {
+			return fmt.Errorf("failed to find the server %q. %s", n.PrivateDNS, err)
+		}
+		list, err := servers.ExtractServers(pages)
+		if err != nil {
+			return fmt.Errorf("failed to find the server %q. %s", n.PrivateDNS, err)
+		}
+		if len(list) != 1 {
+			return fmt.Errorf("found %d servers named %q, expected 1", len(list), n.PrivateDNS)
+		}
+		server := list[0]
+
+		status := "SHUTOFF"
+		if on {
+			status = "ACTIVE"
+		}
+		if server.Status == status {
+			continue
+		}
+
+		if on {
+			p.ui.Log.Infof("starting node %s", server.Name)
+			err = startstop.Start(client, server.ID).ExtractErr()
+		} else {
+			p.ui.Log.Infof("stopping node %s", server.Name)
+			err = startstop.Stop(client, server.ID).ExtractErr()
+		}
+		if err != nil {
+			return fmt.Errorf("failed to start/stop the server %q. %s", server.Name, err)
+		}
+		if err := servers.WaitForStatus(client, server.ID, status, powerTimeout); err != nil {
+			return fmt.Errorf("the server %q did not reach the status %s. %s", server.Name, status, err)
+		}
+	}
+
+	return nil
+}
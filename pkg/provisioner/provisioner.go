@@ -6,6 +6,7 @@ import (
 
 	"github.com/kraken/terraformer"
 	"github.com/kraken/ui"
+	"github.com/liferaft/kubekit/pkg/configurator/ssh"
 	"github.com/liferaft/kubekit/pkg/provisioner/aks"
 	"github.com/liferaft/kubekit/pkg/provisioner/ec2"
 	"github.com/liferaft/kubekit/pkg/provisioner/eks"
@@ -43,6 +44,58 @@ type Provisioner interface {
 	MergeWithEnv(map[string]string) error
 }
 
+// Scaler is implemented by the platforms that can change the number of nodes
+// of a node pool. The new nodes are only required by the platforms that do not
+// create the nodes (i.e. raw and stacki), when scaling down they are the nodes
+// to remove.
+type Scaler interface {
+	PoolSize(pool string) (int, error)
+	Scale(pool string, count int, nodes []*state.Node) error
+}
+
+// PowerManager is implemented by the platforms that can power on and off the
+// cluster nodes. If no node is given, all the cluster nodes are powered on or off
+type PowerManager interface {
+	PowerOn(nodes []*state.Node) error
+	PowerOff(nodes []*state.Node) error
+}
+
+// Refresher is implemented by the platforms with a Terraform state that can be
+// refreshed with the real infrastructure, to find changes done out of KubeKit.
+// SensitiveAttributes returns the attributes of the state resources that should
+// not be displayed
+type Refresher interface {
+	Refresh() error
+	SensitiveAttributes() (map[string]map[string]bool, error)
+}
+
+// PlanFiler is implemented by the platforms with a Terraform plan that can be
+// rendered as resource changes, saved to a file and applied later from the file
+type PlanFiler interface {
+	Changes(*terraformer.Plan) ([]*terraformer.ResourceChange, error)
+	SavePlan(filename string) error
+	ApplyPlan(filename string) error
+}
+
+// HostKeyer is implemented by the platforms that report the SSH host keys of the
+// nodes, so they are pinned before the first connection. The keys, in the
+// authorized_keys format, are indexed by the node IP addresses
+type HostKeyer interface {
+	HostKeys(nodes []*state.Node) (map[string][]string, error)
+}
+
+// HostKeyVerifier is implemented by the platforms that connect with SSH to the
+// hosts they create, to verify the host keys with the cluster known hosts
+type HostKeyVerifier interface {
+	SetKnownHosts(knownHosts *ssh.KnownHosts)
+}
+
+// StateEncrypter is implemented by the platforms with a Terraform state that
+// can be persisted to an encrypted state file
+type StateEncrypter interface {
+	PersistStateToEncryptedFile(filename string, cipher terraformer.StateCipher) error
+}
+
 var allPlatforms = []string{
 	"aks",
 	"ec2",
@@ -105,6 +105,9 @@ type Config struct {
 	PrivateKeyFile         string              `json:"private_key_file" yaml:"private_key_file" mapstructure:"private_key_file"`
 	PublicKey              string              `json:"public_key,omitempty" yaml:"public_key,omitempty" mapstructure:"public_key"`
 	PublicKeyFile          string              `json:"public_key_file" yaml:"public_key_file" mapstructure:"public_key_file"`
+	SSHKeyType             string              `json:"ssh_key_type,omitempty" yaml:"ssh_key_type,omitempty" mapstructure:"ssh_key_type"`
+	SSHAuthMethods         []string            `json:"ssh_auth_methods,omitempty" yaml:"ssh_auth_methods,omitempty" mapstructure:"ssh_auth_methods"`
+	SSHPrivateKeyFiles     []string            `json:"ssh_private_key_files,omitempty" yaml:"ssh_private_key_files,omitempty" mapstructure:"ssh_private_key_files"`
 	DNSServers             []string            `json:"dns_servers" yaml:"dns_servers" mapstructure:"dns_servers"`
 	DNSSearch              []string            `json:"dns_search" yaml:"dns_search" mapstructure:"dns_search"`
 	TimeServers            []string            `json:"time_servers" yaml:"time_servers" mapstructure:"time_servers"`
@@ -234,6 +237,10 @@ func (c *Config) MergeWithMapConfig(m map[interface{}]interface{}) {
 			c.DNSSearch = config.GetListFromInterface(v)
 		case "time_servers":
 			c.TimeServers = config.GetListFromInterface(v)
+		case "ssh_auth_methods":
+			c.SSHAuthMethods = config.GetListFromInterface(v)
+		case "ssh_private_key_files":
+			c.SSHPrivateKeyFiles = config.GetListFromInterface(v)
 		default:
 			config.SetField(c, name, v)
 		}
@@ -0,0 +1,74 @@
+package raw
+
+import (
+	"testing"
+
+	"github.com/liferaft/kubekit/pkg/provisioner/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func scaleRAW() *Platform {
+	return &Platform{
+		name: "raw",
+		config: &Config{
+			NodePools: map[string]NodePool{
+				"master": NodePool{
+					Nodes: []Node{
+						Node{PublicIP: "10.0.0.1", PrivateIP: "192.168.0.1"},
+					},
+				},
+				"worker": NodePool{
+					Nodes: []Node{
+						Node{PublicIP: "10.0.0.2", PrivateIP: "192.168.0.2"},
+						Node{PublicIP: "10.0.0.3", PrivateIP: "192.168.0.3"},
+					},
+				},
+			},
+		},
+		ui:      tUI,
+		version: version,
+	}
+}
+
+func TestPlatform_Scale(t *testing.T) {
+	tests := []struct {
+		name    string
+		pool    string
+		count   int
+		nodes   []*state.Node
+		want    []string
+		wantErr bool
+	}{
+		{"unknown pool", "foo", 1, nil, nil, true},
+		{"same size", "worker", 2, nil, []string{"10.0.0.2", "10.0.0.3"}, false},
+		{"scale up", "worker", 3, []*state.Node{&state.Node{PublicIP: "10.0.0.4"}}, []string{"10.0.0.2", "10.0.0.3", "10.0.0.4"}, false},
+		{"scale up without addresses", "worker", 3, nil, nil, true},
+		{"scale up with existing address", "worker", 3, []*state.Node{&state.Node{PublicIP: "10.0.0.1"}}, nil, true},
+		{"scale down without addresses", "worker", 1, nil, nil, true},
+		{"scale down given nodes", "worker", 1, []*state.Node{&state.Node{PrivateIP: "192.168.0.2"}}, []string{"10.0.0.3"}, false},
+		{"scale down unknown nodes", "worker", 1, []*state.Node{&state.Node{PublicIP: "10.0.0.9"}}, nil, true},
+		{"scale down wrong number of nodes", "worker", 0, []*state.Node{&state.Node{PublicIP: "10.0.0.2"}}, nil, true},
+		{"negative size", "worker", -1, nil, nil, true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			p := scaleRAW()
+			err := p.Scale(tt.pool, tt.count, tt.nodes)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Platform.Scale() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			got := []string{}
+			for _, n := range p.config.NodePools[tt.pool].Nodes {
+				got = append(got, n.PublicIP)
+			}
+			assert.Equal(t, tt.want, got)
+
+			size, _ := p.PoolSize(tt.pool)
+			assert.Equal(t, len(tt.want), size)
+		})
+	}
+}
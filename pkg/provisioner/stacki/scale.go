@@ -0,0 +1,67 @@
+package stacki
+
+import (
+	"fmt"
+
+	"github.com/liferaft/kubekit/pkg/provisioner/state"
+)
+
+// PoolSize returns the number of nodes in the address pool of the given node pool
+func (p *Platform) PoolSize(pool string) (int, error) {
+	nodePool, ok := p.config.NodePools[pool]
+	if !ok {
+		return 0, fmt.Errorf("node pool %q not found in the %s configuration", pool, p.name)
+	}
+	return len(nodePool.Nodes), nil
+}
+
+// Scale sets the number of nodes of the given node pool. The stacki platform does
+// not create nodes, so to scale up the addresses of the new nodes are required.
+// To scale down, the given nodes are removed from the address pool
+func (p *Platform) Scale(pool string, count int, nodes []*state.Node) error {
+	nodePool, ok := p.config.NodePools[pool]
+	if !ok {
+		return fmt.Errorf("node pool %q not found in the %s configuration", pool, p.name)
+	}
+
+	addresses := make([]*state.Node, 0, len(nodePool.Nodes))
+	for _, n := range nodePool.Nodes {
+		addresses = append(addresses, &state.Node{
+			PublicIP:   n.PublicIP,
+			PrivateIP:  n.PrivateIP,
+			PublicDNS:  n.PublicDNS,
+			PrivateDNS: n.PrivateDNS,
+		})
+	}
+
+	scaled, err := state.ScaleAddressPool(pool, addresses, count, nodes, p.findNode)
+	if err != nil {
+		return err
+	}
+
+	nodePool.Nodes = make([]Node, 0, len(scaled))
+	for _, n := range scaled {
+		nodePool.Nodes = append(nodePool.Nodes, Node{
+			PublicIP:   n.PublicIP,
+			PrivateIP:  n.PrivateIP,
+			PublicDNS:  n.PublicDNS,
+			PrivateDNS: n.PrivateDNS,
+		})
+	}
+	p.config.NodePools[pool] = nodePool
+
+	return nil
+}
+
+// findNode returns the name of the node pool with a node with the given IP
+// address, or empty if the address is not in any node pool
+func (p *Platform) findNode(ip string) string {
+	for name, nodePool := range p.config.NodePools {
+		for _, node := range nodePool.Nodes {
+			if node.PublicIP == ip || node.PrivateIP == ip {
+				return name
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,70 @@
+package state
+
+import "fmt"
+
+// ScaleAddressPool returns the address pool of the given node pool with count
+// nodes, for the platforms that do not create the nodes (i.e. raw and stacki).
+// To scale up, the given new nodes are appended to the address pool. To scale
+// down, the given nodes are removed from the address pool, they are required
+// because the cluster nodes may not be in the same order. The function
+// `findNode` returns the node pool with the given IP address, if any
+func ScaleAddressPool(pool string, addresses []*Node, count int, nodes []*Node, findNode func(ip string) string) ([]*Node, error) {
+	if count < 0 {
+		return nil, fmt.Errorf("cannot scale the node pool %q to %d nodes", pool, count)
+	}
+
+	current := len(addresses)
+	switch {
+	case count > current:
+		if len(nodes) != count-current {
+			return nil, fmt.Errorf("%d new node addresses are required to scale the node pool %q to %d nodes, %d were given", count-current, pool, count, len(nodes))
+		}
+		scaled := append([]*Node{}, addresses...)
+		for _, n := range nodes {
+			if n.PublicIP == "" {
+				return nil, fmt.Errorf("the public IP is required for every new node of the node pool %q", pool)
+			}
+			if found := findNode(n.PublicIP); found != "" {
+				return nil, fmt.Errorf("the node %s is already in the node pool %q", n.PublicIP, found)
+			}
+			scaled = append(scaled, &Node{
+				PublicIP:   n.PublicIP,
+				PrivateIP:  n.PrivateIP,
+				PublicDNS:  n.PublicDNS,
+				PrivateDNS: n.PrivateDNS,
+			})
+		}
+		return scaled, nil
+	case count < current:
+		if len(nodes) == 0 {
+			return nil, fmt.Errorf("the addresses of the %d nodes to remove from the node pool %q are required", current-count, pool)
+		}
+		if len(nodes) != current-count {
+			return nil, fmt.Errorf("%d nodes have to be removed to scale the node pool %q to %d nodes, %d were given", current-count, pool, count, len(nodes))
+		}
+		remaining := []*Node{}
+		for _, address := range addresses {
+			if !nodeIn(address, nodes) {
+				remaining = append(remaining, address)
+			}
+		}
+		if len(remaining) != count {
+			return nil, fmt.Errorf("some of the nodes to remove were not found in the node pool %q", pool)
+		}
+		return remaining, nil
+	default:
+		return addresses, nil
+	}
+}
+
+func nodeIn(node *Node, nodes []*Node) bool {
+	for _, n := range nodes {
+		if n.PublicIP != "" && n.PublicIP == node.PublicIP {
+			return true
+		}
+		if n.PrivateIP != "" && n.PrivateIP == node.PrivateIP {
+			return true
+		}
+	}
+	return false
+}
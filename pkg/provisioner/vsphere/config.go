@@ -2,7 +2,6 @@ package vsphere
 
 import (
 	"encoding/json"
-	"fmt"
 
 	"github.com/johandry/merger"
 	"github.com/liferaft/kubekit/pkg/provisioner/config"
@@ -97,6 +96,9 @@ type Config struct {
 	PrivateKeyFile          string              `json:"private_key_file" yaml:"private_key_file" mapstructure:"private_key_file"`
 	PublicKey               string              `json:"public_key,omitempty" yaml:"public_key,omitempty" mapstructure:"public_key"`
 	PublicKeyFile           string              `json:"public_key_file" yaml:"public_key_file" mapstructure:"public_key_file"`
+	SSHKeyType              string              `json:"ssh_key_type,omitempty" yaml:"ssh_key_type,omitempty" mapstructure:"ssh_key_type"`
+	SSHAuthMethods          []string            `json:"ssh_auth_methods,omitempty" yaml:"ssh_auth_methods,omitempty" mapstructure:"ssh_auth_methods"`
+	SSHPrivateKeyFiles      []string            `json:"ssh_private_key_files,omitempty" yaml:"ssh_private_key_files,omitempty" mapstructure:"ssh_private_key_files"`
 	DefaultNodePool         NodePool            `json:"default_node_pool" yaml:"default_node_pool" mapstructure:"default_node_pool"`
 	NodePools               map[string]NodePool `json:"node_pools" yaml:"node_pools" mapstructure:"node_pools"`
 }
@@ -187,8 +189,14 @@ func (c *Config) MergeWithMapConfig(m map[interface{}]interface{}) {
 			c.DNSSearch = config.GetListFromInterface(v)
 		case "time_servers":
 			c.TimeServers = config.GetListFromInterface(v)
+		case "ssh_auth_methods":
+			c.SSHAuthMethods = config.GetListFromInterface(v)
+		case "ssh_private_key_files":
+			c.SSHPrivateKeyFiles = config.GetListFromInterface(v)
 		case "vsphere_password", "vsphere_username", "vsphere_server":
-			panic(fmt.Errorf("field %s is obsolete, please remove it from cluster.yaml and use kubekit login for credentials", name))
+			// obsolete, the cluster configuration migration moves them to the
+			// credentials file
+			continue
 		default:
 			config.SetField(c, name, v)
 		}
@@ -0,0 +1,93 @@
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/liferaft/kubekit/pkg/provisioner/state"
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// PowerOn starts the given nodes, or all the cluster nodes if none is given
+func (p *Platform) PowerOn(nodes []*state.Node) error {
+	return p.power(nodes, true)
+}
+
+// PowerOff stops the given nodes, or all the cluster nodes if none is given
+func (p *Platform) PowerOff(nodes []*state.Node) error {
+	return p.power(nodes, false)
+}
+
+// power starts or stops the virtual machines of the given nodes. The virtual
+// machines are identified by name, which is the node private DNS
+func (p *Platform) power(nodes []*state.Node, on bool) error {
+	if len(nodes) == 0 {
+		nodes = p.Nodes()
+	}
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	ctx := context.Background()
+
+	u, err := url.Parse("https://" + p.config.VsphereServer + "/sdk")
+	if err != nil {
+		return fmt.Errorf("invalid vSphere server %q. %s", p.config.VsphereServer, err)
+	}
+	u.User = url.UserPassword(p.config.VsphereUsername, p.config.VspherePassword)
+
+	client, err := govmomi.NewClient(ctx, u, true)
+	if err != nil {
+		return fmt.Errorf("failed to connect to the vSphere server %s. %s", p.config.VsphereServer, err)
+	}
+	defer client.Logout(ctx)
+
+	finder := find.NewFinder(client.Client, true)
+	dc, err := finder.Datacenter(ctx, p.config.Datacenter)
+	if err != nil {
+		return fmt.Errorf("failed to find the datacenter %q. %s", p.config.Datacenter, err)
+	}
+	finder.SetDatacenter(dc)
+
+	for _, n := range nodes {
+		name := strings.Split(n.PrivateDNS, ".")[0]
+		vmPath := name
+		if len(p.config.Folder) != 0 {
+			vmPath = path.Join(p.config.Folder, name)
+		}
+		vm, err := finder.VirtualMachine(ctx, vmPath)
+		if err != nil {
+			return fmt.Errorf("failed to find the virtual machine %q. %s", vmPath, err)
+		}
+
+		var task *object.Task
+		var powerState types.VirtualMachinePowerState
+		if on {
+			p.ui.Log.Infof("starting node %s", name)
+			task, err = vm.PowerOn(ctx)
+			powerState = types.VirtualMachinePowerStatePoweredOn
+		} else {
+			p.ui.Log.Infof("stopping node %s", name)
+			task, err = vm.PowerOff(ctx)
+			powerState = types.VirtualMachinePowerStatePoweredOff
+		}
+		if err != nil {
+			return fmt.Errorf("failed to power on/off the virtual machine %q. %s", name, err)
+		}
+		// The task fails if the VM is already in the requested power state
+		if err := task.Wait(ctx); err != nil {
+			if current, errState := vm.PowerState(ctx); errState == nil && current == powerState {
+				continue
+			}
+			return fmt.Errorf("failed to power on/off the virtual machine %q. %s", name, err)
+		}
+	}
+
+	return nil
+}
@@ -52,6 +52,56 @@ func (p *Platform) Plan(destroy bool) (plan *terraformer.Plan, err error) {
 	return p.t.Plan(destroy)
 }
 
+// Refresh refreshes the Terraform state with the real infrastructure of the
+// cluster on this platform. The refreshed state is not persisted
+func (p *Platform) Refresh() error {
+	if p.t == nil {
+		return fmt.Errorf("cannot refresh the state, the %s plaftorm is not a provisioner yet", p.name)
+	}
+
+	p.ui.Log.Debug("refreshing the cluster state")
+	return p.t.Refresh(false)
+}
+
+// SensitiveAttributes returns the attributes with sensitive values of every
+// resource in the state of this platform, indexed by the resource address
+func (p *Platform) SensitiveAttributes() (map[string]map[string]bool, error) {
+	if p.t == nil {
+		return nil, fmt.Errorf("cannot get the sensitive attributes, the %s plaftorm is not a provisioner yet", p.name)
+	}
+
+	return p.t.SensitiveAttributes()
+}
+
+// Changes returns the resource changes of the given plan of this platform
+func (p *Platform) Changes(plan *terraformer.Plan) ([]*terraformer.ResourceChange, error) {
+	if p.t == nil {
+		return nil, fmt.Errorf("cannot get the plan changes, the %s plaftorm is not a provisioner yet", p.name)
+	}
+
+	return p.t.Changes(plan)
+}
+
+// SavePlan saves the last plan of this platform to the given file
+func (p *Platform) SavePlan(filename string) error {
+	if p.t == nil {
+		return fmt.Errorf("cannot save the plan, the %s plaftorm is not a provisioner yet", p.name)
+	}
+
+	p.ui.Log.Debugf("saving the cluster plan to %s", filename)
+	return p.t.SavePlan(filename)
+}
+
+// ApplyPlan applies the changes of the plan saved in the given file
+func (p *Platform) ApplyPlan(filename string) error {
+	if p.t == nil {
+		return fmt.Errorf("cannot apply the plan, the %s plaftorm is not a provisioner yet", p.name)
+	}
+
+	p.ui.Log.Debugf("applying the cluster plan from %s", filename)
+	return p.t.ApplyPlan(filename)
+}
+
 // Apply apply the changes either to create or destroy the cluster on this platform
 func (p *Platform) Apply(destroy bool) error {
 	if p.t == nil {
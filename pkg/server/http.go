@@ -99,6 +99,7 @@ func (s *Server) StartHTTP(port string) *Server {
 	if s.healthServer != nil {
 		s.setHealthCheck(mux)
 	}
+	s.setHTTPHandlers(mux)
 	mux.Handle("/", gwmux)
 
 	s.httpServer = &http.Server{
@@ -163,3 +164,12 @@ func (s *Server) setSwagger(mux *http.ServeMux) {
 		})
 	}
 }
+
+func (s *Server) setHTTPHandlers(mux *http.ServeMux) {
+	for name, serv := range s.services {
+		for path, handler := range serv.HTTPHandlers {
+			s.ui.Log.Debugf("registering handler for service %s on HTTP/REST (%s)", name, path)
+			mux.Handle(path, handler)
+		}
+	}
+}
@@ -112,6 +112,7 @@ func (s *Server) StartMux(port string) *Server {
 	if s.healthServer != nil {
 		s.setHealthCheck(mux)
 	}
+	s.setHTTPHandlers(mux)
 	mux.Handle("/", gwmux)
 
 	var httpMux http.Handler
@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"google.golang.org/grpc/health/grpc_health_v1"
 
@@ -27,6 +28,9 @@ type Service struct {
 	ServiceRegister             ServiceRegisterable
 	RegisterHandlerFromEndpoint RegisterHandlerFromEndpoint
 	SwaggerBytes                []byte
+	// HTTPHandlers are HTTP/REST handlers not served by the gRPC Gateway, such
+	// as streams, indexed by the path pattern
+	HTTPHandlers map[string]http.Handler
 }
 
 // Services is a collection of services
@@ -2,7 +2,6 @@ package tls
 
 import (
 	"crypto/rand"
-	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
 	"io/ioutil"
@@ -14,8 +13,7 @@ func (crt *Certificate) generateTemplate() (x509.Certificate, error) {
 		return x509.Certificate{}, err
 	}
 
-	publicKey := crt.PrivateKey.Public().(*rsa.PublicKey)
-	subjectKeyID, err := generateSubjectKeyID(publicKey)
+	subjectKeyID, err := generateSubjectKeyID(crt.PrivateKey.Public())
 	if err != nil {
 		return x509.Certificate{}, err
 	}
@@ -2,7 +2,6 @@ package tls
 
 import (
 	"crypto/rand"
-	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
 )
@@ -66,8 +65,7 @@ func (crt *Certificate) generateTemplateFromCSR(ca *Certificate) (x509.Certifica
 		return x509.Certificate{}, err
 	}
 
-	publicKey := crt.PrivateKey.Public().(*rsa.PublicKey)
-	subjectKeyID, err := generateSubjectKeyID(publicKey)
+	subjectKeyID, err := generateSubjectKeyID(crt.PrivateKey.Public())
 	if err != nil {
 		return x509.Certificate{}, err
 	}
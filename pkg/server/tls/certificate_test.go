@@ -1,8 +1,11 @@
 package tls
 
 import (
+	"crypto/rsa"
+	"crypto/x509"
 	"fmt"
 	"io/ioutil"
+	"reflect"
 	"testing"
 )
 
@@ -29,10 +32,14 @@ func TestCertificate_GenerateCertificateAuthority(t *testing.T) {
 				return
 			}
 
-			if bits := tt.crt.PrivateKey.N.BitLen(); bits != defaultRSABits {
+			privKey, ok := tt.crt.PrivateKey.(*rsa.PrivateKey)
+			if !ok {
+				t.Fatalf("Certificate.GenerateCertificateAuthority() failed, expected a RSA key, received %T", tt.crt.PrivateKey)
+			}
+			if bits := privKey.N.BitLen(); bits != defaultRSABits {
 				t.Errorf("Certificate.GenerateCertificateAuthority() failed, key too short. expected = %v, received = %v", defaultRSABits, bits)
 			}
-			if err := tt.crt.PrivateKey.Validate(); err != nil {
+			if err := privKey.Validate(); err != nil {
 				t.Errorf("Certificate.GenerateCertificateAuthority() failed, it's not valid: %v", err)
 			}
 		})
@@ -132,6 +139,75 @@ func BenchmarkCertificate_GenerateCertificate(b *testing.B) {
 	}
 }
 
+func TestCertificate_GenerateSignedCertificate_keyAlgorithms(t *testing.T) {
+	tests := []struct {
+		name               string
+		keyAlgorithm       string
+		signatureAlgorithm string
+		wantSig            x509.SignatureAlgorithm
+		wantErr            bool
+	}{
+		{"rsa-2048", "rsa-2048", "", x509.SHA256WithRSA, false},
+		{"rsa-3072 SHA384-RSA", "rsa-3072", "SHA384-RSA", x509.SHA384WithRSA, false},
+		{"rsa-4096 SHA512-RSAPSS", "rsa-4096", "SHA512-RSAPSS", x509.SHA512WithRSAPSS, false},
+		{"ecdsa-p256", "ecdsa-p256", "", x509.ECDSAWithSHA256, false},
+		{"ecdsa-p256 ECDSA-SHA512", "ecdsa-p256", "ECDSA-SHA512", x509.ECDSAWithSHA512, false},
+		{"ecdsa-p384", "ecdsa-p384", "", x509.ECDSAWithSHA384, false},
+		{"ed25519", "ed25519", "", x509.PureEd25519, false},
+		{"ed25519 Ed25519", "ed25519", "Ed25519", x509.PureEd25519, false},
+		{"unknown key algorithm", "dsa-1024", "", x509.UnknownSignatureAlgorithm, true},
+		{"unknown signature algorithm", "rsa-2048", "MD5-RSA", x509.UnknownSignatureAlgorithm, true},
+		{"unmatched signature algorithm", "ecdsa-p256", "SHA256-RSA", x509.UnknownSignatureAlgorithm, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ca := NewCertificate("ca", nil).WithKeyAlgorithm(tt.keyAlgorithm).WithSignatureAlgorithm(tt.signatureAlgorithm).GenerateCertificateAuthority()
+			if err := ca.Error(); (err != nil) != tt.wantErr {
+				t.Fatalf("Certificate.GenerateCertificateAuthority() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			opts := DefaultCertificateOpts("server", "")
+			opts.DNSNames = []string{"localhost"}
+			crt := NewCertificate("server", opts).WithKeyAlgorithm(tt.keyAlgorithm).WithSignatureAlgorithm(tt.signatureAlgorithm).WithPassphrase("Test1ng").GenerateSignedCertificate(ca)
+			if err := crt.Error(); err != nil {
+				t.Fatalf("Certificate.GenerateSignedCertificate() error = %v", err)
+			}
+
+			for _, cert := range []*x509.Certificate{ca.Certificate, crt.Certificate} {
+				if cert.SignatureAlgorithm != tt.wantSig {
+					t.Errorf("certificate %s signature algorithm = %v, want %v", cert.Subject.CommonName, cert.SignatureAlgorithm, tt.wantSig)
+				}
+			}
+
+			roots := x509.NewCertPool()
+			roots.AddCert(ca.Certificate)
+			if _, err := crt.Certificate.Verify(x509.VerifyOptions{
+				DNSName:   "localhost",
+				Roots:     roots,
+				KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+			}); err != nil {
+				t.Errorf("failed to verify the certificate chain. %s", err)
+			}
+
+			// The encrypted private key PEM has to generate the same key
+			privKeyPEM := crt.PrivateKeyPEM()
+			if err := crt.Error(); err != nil {
+				t.Fatalf("Certificate.PrivateKeyPEM() error = %v", err)
+			}
+			loaded := NewCertificate("server", nil).WithPassphrase("Test1ng").GeneratePrivateKeyFromPEM(privKeyPEM)
+			if err := loaded.Error(); err != nil {
+				t.Fatalf("Certificate.GeneratePrivateKeyFromPEM() error = %v", err)
+			}
+			if !reflect.DeepEqual(loaded.PrivateKey.Public(), crt.PrivateKey.Public()) {
+				t.Errorf("Certificate.GeneratePrivateKeyFromPEM() loaded a different private key")
+			}
+		})
+	}
+}
+
 func mustLoad(tb testing.TB, bits int) []byte {
 	filename := fmt.Sprintf("testdata/test%d.key", bits)
 	out, err := ioutil.ReadFile(filename)
@@ -1,8 +1,8 @@
 package tls
 
 import (
+	"crypto"
 	"crypto/rand"
-	"crypto/rsa"
 	"crypto/sha1"
 	"crypto/x509"
 	"crypto/x509/pkix"
@@ -14,6 +14,8 @@ import (
 	"os"
 	"path/filepath"
 	"time"
+
+	ktls "github.com/liferaft/kubekit/pkg/crypto/tls"
 )
 
 const (
@@ -55,6 +57,8 @@ type CertificateOpts struct {
 	PrivateKeyFile     string
 	Passphrase         string
 	Bits               int
+	KeyAlgorithm       string
+	SignatureAlgorithm string
 	Organization       string
 	OrganizationalUnit string
 	Locality           string
@@ -205,6 +209,13 @@ func (opts *CertificateOpts) GenerateTemplate() (x509.Certificate, error) {
 		return x509.Certificate{}, err
 	}
 
+	signatureAlgorithm := x509.UnknownSignatureAlgorithm
+	if len(opts.SignatureAlgorithm) != 0 {
+		if signatureAlgorithm, err = ktls.ParseSignatureAlgorithm(opts.SignatureAlgorithm); err != nil {
+			return x509.Certificate{}, err
+		}
+	}
+
 	ipAddresses := generateIPs(opts.IPAddresses)
 	uriList := generateURLs(opts.URIs)
 
@@ -223,6 +234,7 @@ func (opts *CertificateOpts) GenerateTemplate() (x509.Certificate, error) {
 		IPAddresses:  ipAddresses,
 		URIs:         uriList,
 		// ExtraExtensions: []pkix.Extension{*ext},
+		SignatureAlgorithm: signatureAlgorithm,
 	}
 
 	switch opts.UseAs {
@@ -243,16 +255,23 @@ func (opts *CertificateOpts) GenerateTemplate() (x509.Certificate, error) {
 	return template, nil
 }
 
-func generateSubjectKeyID(publicKey *rsa.PublicKey) ([]byte, error) {
-	publicKeyBytes, err := asn1.Marshal(rsa.PublicKey{
-		N: publicKey.N,
-		E: publicKey.E,
-	})
+// generateSubjectKeyID returns the SHA-1 hash of the subject public key, as
+// the method (1) of RFC 5280 section 4.2.1.2, for any type of key
+func generateSubjectKeyID(publicKey crypto.PublicKey) ([]byte, error) {
+	pkixPublicKey, err := x509.MarshalPKIXPublicKey(publicKey)
 	if err != nil {
 		return nil, err
 	}
 
-	subjectKeyID := sha1.Sum(publicKeyBytes)
+	var subjectPublicKeyInfo struct {
+		Algorithm        pkix.AlgorithmIdentifier
+		SubjectPublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(pkixPublicKey, &subjectPublicKeyInfo); err != nil {
+		return nil, err
+	}
+
+	subjectKeyID := sha1.Sum(subjectPublicKeyInfo.SubjectPublicKey.Bytes)
 
 	return subjectKeyID[:], nil
 }
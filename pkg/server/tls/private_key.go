@@ -6,6 +6,8 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"io/ioutil"
+
+	ktls "github.com/liferaft/kubekit/pkg/crypto/tls"
 )
 
 // GenerateRSAKey generates an RSA Private Key for the given size in the options
@@ -26,6 +28,26 @@ func (crt *Certificate) GenerateRSAKey() *Certificate {
 	return crt
 }
 
+// GeneratePrivateKey generates a Private Key with the algorithm in the options,
+// or a RSA Private Key for the given size in the options if the algorithm is
+// not set
+func (crt *Certificate) GeneratePrivateKey() *Certificate {
+	if crt.err != nil {
+		return crt
+	}
+	if len(crt.Opts.KeyAlgorithm) == 0 {
+		return crt.GenerateRSAKey()
+	}
+
+	privKey, err := ktls.GenPrivateKey(crt.Opts.KeyAlgorithm)
+	if err != nil {
+		return crt.withErr(err)
+	}
+	crt.PrivateKey = privKey
+
+	return crt
+}
+
 // GeneratePrivateKeyFromPEM generates a Key Pair from the given private key PEM data
 func (crt *Certificate) GeneratePrivateKeyFromPEM(data []byte) *Certificate {
 	if crt.err != nil {
@@ -44,11 +66,13 @@ func (crt *Certificate) GeneratePrivateKeyFromPEM(data []byte) *Certificate {
 		}
 	}
 
-	if pemPrivBlock.Type != "RSA PRIVATE KEY" {
+	switch pemPrivBlock.Type {
+	case "RSA PRIVATE KEY", "EC PRIVATE KEY", "PRIVATE KEY":
+	default:
 		return crt.withErrf("unmatched type (%q)", pemPrivBlock.Type)
 	}
 
-	privKey, err := x509.ParsePKCS1PrivateKey(der)
+	privKey, err := ktls.ParsePrivateKey(pemPrivBlock.Type, der)
 	if err != nil {
 		return crt.withErrf("failed to parse DER encoded private key. %s", err)
 	}
@@ -67,19 +91,22 @@ func (crt *Certificate) PrivateKeyPEM() []byte {
 		return nil
 	}
 
-	privateKeyPEMBytes := x509.MarshalPKCS1PrivateKey(crt.PrivateKey)
+	blockType, privateKeyPEMBytes, err := ktls.MarshalPrivateKey(crt.PrivateKey)
+	if err != nil {
+		crt.err = err
+		return nil
+	}
 	var block *pem.Block
 
 	if len(crt.Opts.Passphrase) != 0 {
-		var err error
-		block, err = x509.EncryptPEMBlock(rand.Reader, "RSA PRIVATE KEY", privateKeyPEMBytes, []byte(crt.Opts.Passphrase), x509.PEMCipher3DES)
+		block, err = x509.EncryptPEMBlock(rand.Reader, blockType, privateKeyPEMBytes, []byte(crt.Opts.Passphrase), x509.PEMCipher3DES)
 		if err != nil {
 			crt.err = err
 			return nil
 		}
 	} else {
 		block = &pem.Block{
-			Type:  "RSA PRIVATE KEY",
+			Type:  blockType,
 			Bytes: privateKeyPEMBytes,
 		}
 	}
@@ -133,10 +160,10 @@ func (crt *Certificate) ReadPrivateKeyFile() *Certificate {
 }
 
 // GetPrivateKey loads the private key from the given file if exists,
-// otherwise generates a RSA key
+// otherwise generates a key with the algorithm in the options
 func (crt *Certificate) GetPrivateKey() *Certificate {
 	if crt.Opts.IsKeyFileFound() {
 		return crt.ReadPrivateKeyFile()
 	}
-	return crt.GenerateRSAKey()
+	return crt.GeneratePrivateKey()
 }
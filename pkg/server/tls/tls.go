@@ -1,7 +1,7 @@
 package tls
 
 import (
-	"crypto/rsa"
+	"crypto"
 	"crypto/x509"
 	"fmt"
 )
@@ -9,7 +9,7 @@ import (
 // Certificate is a X.509 Key Pair or Certificate that includes the Private Key,
 // Certificate and other useful information about them.
 type Certificate struct {
-	PrivateKey         *rsa.PrivateKey // Private Key may be included/repeated inside Certificate
+	PrivateKey         crypto.Signer // Private Key may be included/repeated inside Certificate
 	Certificate        *x509.Certificate
 	CertificateRequest *x509.CertificateRequest
 	err                error
@@ -46,6 +46,22 @@ func (crt *Certificate) WithBits(bits int) *Certificate {
 	return crt
 }
 
+// WithKeyAlgorithm assigns the algorithm to generate the Private Key, such as
+// "rsa-3072", "ecdsa-p256" or "ed25519". If empty, a RSA Private Key with the
+// number of bits in the options is generated
+func (crt *Certificate) WithKeyAlgorithm(algorithm string) *Certificate {
+	crt.Opts.KeyAlgorithm = algorithm
+	return crt
+}
+
+// WithSignatureAlgorithm assigns the algorithm to sign the certificate, such as
+// "SHA384-RSA" or "ECDSA-SHA256". If empty, the default algorithm for the
+// signing key is used
+func (crt *Certificate) WithSignatureAlgorithm(algorithm string) *Certificate {
+	crt.Opts.SignatureAlgorithm = algorithm
+	return crt
+}
+
 // WithPassphrase assigns the given password to the Key Pair that is used to
 // open encrypted key files
 func (crt *Certificate) WithPassphrase(passphrase string) *Certificate {
@@ -1,6 +1,7 @@
 package tls
 
 import (
+	"crypto/rsa"
 	"testing"
 )
 
@@ -13,7 +14,12 @@ func validateCertificate(t *testing.T, crt *Certificate, size int, funcName stri
 		t.Errorf("Certificate.%s() failed, the Private Key does not exists (nil)", funcName)
 	}
 
-	if err := crt.PrivateKey.Validate(); err != nil {
+	privKey, ok := crt.PrivateKey.(*rsa.PrivateKey)
+	if !ok {
+		t.Fatalf("Certificate.%s() failed, expected a RSA Private Key, received %T", funcName, crt.PrivateKey)
+	}
+
+	if err := privKey.Validate(); err != nil {
 		t.Errorf("Certificate.%s() failed, it's not valid: %v", funcName, err)
 	}
 
@@ -21,7 +27,7 @@ func validateCertificate(t *testing.T, crt *Certificate, size int, funcName stri
 		size = defaultRSABits
 	}
 
-	if bits := crt.PrivateKey.N.BitLen(); bits != size {
+	if bits := privKey.N.BitLen(); bits != size {
 		t.Errorf("Certificate.%s() failed, key too short. expected = %v, received = %v", funcName, size, bits)
 	}
 }
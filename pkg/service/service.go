@@ -2,6 +2,7 @@ package service
 
 import (
 	"fmt"
+	"net/http"
 
 	"github.com/kraken/ui"
 	apiv1 "github.com/liferaft/kubekit/api/kubekit/v1"
@@ -19,13 +20,18 @@ func ServicesForVersion(version string, dry bool, a ...interface{}) (server.Serv
 		clustersPath := a[0].(string)
 		parentUI := a[1].(*ui.UI)
 
+		kubekitService := servicev1.NewKubeKitService(clustersPath, parentUI, dry)
+
 		return server.Services{
 			"v1.Kubekit": &server.Service{
 				Version:                     version,
 				Name:                        "Kubekit",
-				ServiceRegister:             servicev1.NewKubeKitService(clustersPath, parentUI, dry),
+				ServiceRegister:             kubekitService,
 				RegisterHandlerFromEndpoint: apiv1.RegisterKubekitHandlerFromEndpoint,
 				SwaggerBytes:                apiv1.Swagger,
+				HTTPHandlers: map[string]http.Handler{
+					servicev1.EventsPath: kubekitService.EventsHandler(),
+				},
 			},
 		}, nil
 	default:
@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 
+	"github.com/kraken/ui"
 	apiv1 "github.com/liferaft/kubekit/api/kubekit/v1"
 	"github.com/liferaft/kubekit/pkg/crypto/tls"
 	"github.com/liferaft/kubekit/pkg/kluster"
@@ -30,6 +31,7 @@ func (s *KubeKitService) Apply(ctx context.Context, in *apiv1.ApplyRequest) (*ap
 
 	// only apply if not dry
 	if !s.dry {
+		s.events.reset(cluster.Name)
 		go s.doApply(ctx, cluster, in)
 	}
 
@@ -52,9 +54,12 @@ func (s *KubeKitService) doApply(ctx context.Context, cluster *kluster.Kluster,
 	platform := cluster.Platform()
 
 	defer func() {
+		event := ui.Event{Type: ui.PhaseChanged, Cluster: cluster.Name, Phase: PhaseDone}
 		if err != nil {
 			s.ui.Log.Errorf("failed to apply the cluster %s. %s", cluster.Name, err)
+			event.Phase, event.Message = PhaseFailed, err.Error()
 		}
+		s.ui.Emit(event)
 		cluster.State[platform].Status = status
 		if errS := cluster.Save(); errS != nil {
 			s.ui.Log.Errorf("failed to save the cluster configuration file for %s. %s", cluster.Name, errS)
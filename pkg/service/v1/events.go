@@ -0,0 +1,148 @@
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/kraken/ui"
+)
+
+// EventsPath is the HTTP/REST path to stream the progress events of a cluster,
+// the cluster name follows the path
+const EventsPath = "/api/" + apiVersion + "/events/"
+
+// Phases reported when the apply of a cluster finish
+const (
+	PhaseDone   = "done"
+	PhaseFailed = "failed"
+)
+
+const (
+	maxEventsPerCluster = 1000
+	subscriberBuffer    = 100
+)
+
+// eventsBroker keeps the progress events of the last apply of every cluster
+// and sends the new events to the subscribers
+type eventsBroker struct {
+	events      map[string][]ui.Event
+	subscribers map[chan ui.Event]string
+	l           sync.Mutex
+}
+
+func newEventsBroker() *eventsBroker {
+	return &eventsBroker{
+		events:      make(map[string][]ui.Event),
+		subscribers: make(map[chan ui.Event]string),
+	}
+}
+
+// reset removes the events of a previous apply of the cluster
+func (b *eventsBroker) reset(cluster string) {
+	b.l.Lock()
+	defer b.l.Unlock()
+	delete(b.events, cluster)
+}
+
+// publish stores the event and sends it to the subscribers of the cluster.
+// Slow subscribers lose the events instead of blocking the apply
+func (b *eventsBroker) publish(event ui.Event) {
+	b.l.Lock()
+	defer b.l.Unlock()
+
+	events := append(b.events[event.Cluster], event)
+	if len(events) > maxEventsPerCluster {
+		events = events[len(events)-maxEventsPerCluster:]
+	}
+	b.events[event.Cluster] = events
+
+	for ch, cluster := range b.subscribers {
+		if cluster != event.Cluster {
+			continue
+		}
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// subscribe returns the stored events of the cluster and a channel to receive
+// the new ones. The returned function cancels the subscription
+func (b *eventsBroker) subscribe(cluster string) ([]ui.Event, chan ui.Event, func()) {
+	b.l.Lock()
+	defer b.l.Unlock()
+
+	events := make([]ui.Event, len(b.events[cluster]))
+	copy(events, b.events[cluster])
+
+	ch := make(chan ui.Event, subscriberBuffer)
+	b.subscribers[ch] = cluster
+
+	return events, ch, func() {
+		b.l.Lock()
+		defer b.l.Unlock()
+		delete(b.subscribers, ch)
+	}
+}
+
+// finished returns true if the event is the last one of an apply
+func finished(event ui.Event) bool {
+	return event.Type == ui.PhaseChanged && (event.Phase == PhaseDone || event.Phase == PhaseFailed)
+}
+
+// EventsHandler returns the HTTP handler streaming the progress events of the
+// cluster in the path as JSON lines. The events of the last apply are sent
+// first, then the new events until the apply finish. With the query parameter
+// `follow=false` only the stored events are sent
+func (s *KubeKitService) EventsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		cluster := strings.Trim(strings.TrimPrefix(r.URL.Path, EventsPath), "/")
+		if len(cluster) == 0 {
+			http.Error(w, "cannot identify the cluster name from the path "+r.URL.Path, http.StatusBadRequest)
+			return
+		}
+
+		events, ch, cancel := s.events.subscribe(cluster)
+		defer cancel()
+
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		encoder := json.NewEncoder(w)
+		flusher, _ := w.(http.Flusher)
+		send := func(event ui.Event) bool {
+			if err := encoder.Encode(event); err != nil {
+				return false
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+			return !finished(event)
+		}
+
+		for _, event := range events {
+			if !send(event) {
+				return
+			}
+		}
+		if r.URL.Query().Get("follow") == "false" {
+			return
+		}
+
+		for {
+			select {
+			case event := <-ch:
+				if !send(event) {
+					return
+				}
+			case <-r.Context().Done():
+				return
+			}
+		}
+	})
+}
@@ -0,0 +1,104 @@
+package v1
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kraken/ui"
+)
+
+func TestKubeKitService_EventsHandler(t *testing.T) {
+	stored := []ui.Event{
+		{Type: ui.PhaseChanged, Cluster: "foo", Phase: "configure"},
+		{Type: ui.PhaseChanged, Cluster: "bar", Phase: "configure"},
+		{Type: ui.HostTaskStarted, Cluster: "foo", Host: "master000", Task: "docker/systemd"},
+	}
+	published := []ui.Event{
+		{Type: ui.HostTaskFinished, Cluster: "foo", Host: "master000", Task: "docker/systemd", Status: ui.StatusOk},
+		{Type: ui.HostTaskFinished, Cluster: "bar", Host: "master000", Task: "docker/systemd", Status: ui.StatusOk},
+		{Type: ui.PhaseChanged, Cluster: "foo", Phase: PhaseDone},
+		{Type: ui.PhaseChanged, Cluster: "foo", Phase: "after done"},
+	}
+
+	tests := []struct {
+		name       string
+		path       string
+		wantStatus int
+		wantStored int
+		want       []ui.Event
+	}{
+		{"stored events", "/api/v1/events/foo?follow=false", http.StatusOK, 2, []ui.Event{stored[0], stored[2]}},
+		{"follow until done", "/api/v1/events/foo", http.StatusOK, 2, []ui.Event{stored[0], stored[2], published[0], published[2]}},
+		{"no events", "/api/v1/events/baz?follow=false", http.StatusOK, 0, []ui.Event{}},
+		{"no cluster", "/api/v1/events/", http.StatusBadRequest, 0, []ui.Event{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &KubeKitService{events: newEventsBroker()}
+			for _, event := range stored {
+				s.events.publish(event)
+			}
+
+			server := httptest.NewServer(s.EventsHandler())
+			defer server.Close()
+
+			resp, err := http.Get(server.URL + tt.path)
+			if err != nil {
+				t.Fatalf("GET %s error = %v", tt.path, err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("GET %s status = %d, want %d", tt.path, resp.StatusCode, tt.wantStatus)
+			}
+
+			got := []ui.Event{}
+			scanner := bufio.NewScanner(resp.Body)
+			if tt.wantStatus == http.StatusOK {
+				for len(got) < tt.wantStored && scanner.Scan() {
+					var event ui.Event
+					json.Unmarshal(scanner.Bytes(), &event)
+					got = append(got, event)
+				}
+				// the stored events were received, so the handler is subscribed
+				for _, event := range published {
+					s.events.publish(event)
+				}
+				for scanner.Scan() {
+					var event ui.Event
+					json.Unmarshal(scanner.Bytes(), &event)
+					got = append(got, event)
+				}
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GET %s = %+v, want %+v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEventsBroker_publish(t *testing.T) {
+	b := newEventsBroker()
+	_, ch, cancel := b.subscribe("foo")
+
+	for i := 0; i < maxEventsPerCluster+subscriberBuffer; i++ {
+		b.publish(ui.Event{Type: ui.HostTaskStarted, Cluster: "foo", Time: time.Unix(int64(i), 0)})
+	}
+	cancel()
+
+	events, _, _ := b.subscribe("foo")
+	if len(events) != maxEventsPerCluster {
+		t.Errorf("publish() stored %d events, want %d", len(events), maxEventsPerCluster)
+	}
+	if first := events[0].Time.Unix(); first != subscriberBuffer {
+		t.Errorf("publish() first stored event = %d, want %d", first, subscriberBuffer)
+	}
+	if len(ch) != subscriberBuffer {
+		t.Errorf("publish() sent %d events, want %d", len(ch), subscriberBuffer)
+	}
+}
@@ -16,6 +16,7 @@ type KubeKitService struct {
 	clustersPath string
 	ui           *ui.UI
 	dry          bool
+	events       *eventsBroker
 }
 
 // NewKubeKitService creates a new KubeKit service
@@ -24,11 +25,16 @@ func NewKubeKitService(clustersPath string, parentUI *ui.UI, dry bool) *KubeKitS
 		parentUI.Log.Warn("Starting the KubeKit service in dry mode. API calls will be inert.")
 	}
 
-	return &KubeKitService{
+	s := &KubeKitService{
 		clustersPath: clustersPath,
 		ui:           parentUI,
 		dry:          dry,
+		events:       newEventsBroker(),
 	}
+	// the clusters UI are copies of the parent UI, so their events reach the broker
+	parentUI.OnEvent(s.events.publish)
+
+	return s
 }
 
 // Register registers this service to the given gRPC server
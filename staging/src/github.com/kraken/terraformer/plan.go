@@ -0,0 +1,331 @@
+package terraformer
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform/backend/local"
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/hashicorp/terraform/plans"
+	"github.com/hashicorp/terraform/plans/planfile"
+	"github.com/hashicorp/terraform/providers"
+	"github.com/hashicorp/terraform/states"
+	"github.com/hashicorp/terraform/states/statefile"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// KnownAfterApply is the value of the attributes that will be known after the
+// changes are applied
+const KnownAfterApply = "(known after apply)"
+
+// Actions of the resource changes in a plan
+const (
+	ActionNoOp    = "no-op"
+	ActionCreate  = "create"
+	ActionRead    = "read"
+	ActionUpdate  = "update"
+	ActionReplace = "replace"
+	ActionDelete  = "delete"
+)
+
+// ResourceChange is a change to a resource instance in a plan. Before and After
+// are the values of the resource attributes before and after the change,
+// Sensitive has the attributes with sensitive values according to the provider
+// schema
+type ResourceChange struct {
+	Address   string
+	Action    string
+	Before    map[string]interface{}
+	After     map[string]interface{}
+	Sensitive map[string]bool
+}
+
+// Changes returns the resource changes in the given plan. The plan has to be
+// created with this Terraformer to know the providers schemas
+func (t *Terraformer) Changes(plan *Plan) ([]*ResourceChange, error) {
+	if plan == nil || plan.Changes == nil {
+		return nil, nil
+	}
+	if t.context == nil {
+		return nil, fmt.Errorf("cannot get the changes of a plan without a context, get the plan first")
+	}
+
+	schemas := t.context.Schemas()
+	changes := []*ResourceChange{}
+	for _, rcs := range plan.Changes.Resources {
+		// the changes to the deposed objects are part of the current object replacement
+		if rcs.DeposedKey != states.NotDeposed {
+			continue
+		}
+
+		addr := rcs.Addr.Resource.Resource
+		schema, _ := schemas.ResourceTypeConfig(rcs.ProviderAddr.ProviderConfig.Type.Type, addr.Mode, addr.Type)
+		if schema == nil {
+			return nil, fmt.Errorf("schema not found for resource %s", rcs.Addr)
+		}
+		rc, err := rcs.Decode(schema.ImpliedType())
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode the changes of resource %s. %s", rcs.Addr, err)
+		}
+
+		sensitive := sensitiveAttributes(schema)
+
+		before, _ := ctyToInterface(rc.Before).(map[string]interface{})
+		after, _ := ctyToInterface(rc.After).(map[string]interface{})
+		changes = append(changes, &ResourceChange{
+			Address:   rcs.Addr.String(),
+			Action:    actionName(rc.Action),
+			Before:    before,
+			After:     after,
+			Sensitive: sensitive,
+		})
+	}
+
+	return changes, nil
+}
+
+// SensitiveAttributes returns the attributes with sensitive values of every
+// resource instance in State, indexed by the resource instance address. The
+// attributes are taken from the providers schemas, so State has to be refreshed
+// or planned with this Terraformer first
+func (t *Terraformer) SensitiveAttributes() (map[string]map[string]bool, error) {
+	if t.State == nil {
+		return nil, nil
+	}
+	if t.context == nil {
+		return nil, fmt.Errorf("cannot get the sensitive attributes without a context, refresh the state first")
+	}
+
+	schemas := t.context.Schemas()
+	attributes := map[string]map[string]bool{}
+	for _, module := range t.State.Modules {
+		for _, rs := range module.Resources {
+			addr := rs.Addr
+			schema, _ := schemas.ResourceTypeConfig(rs.ProviderConfig.ProviderConfig.Type.Type, addr.Mode, addr.Type)
+			if schema == nil {
+				return nil, fmt.Errorf("schema not found for resource %s", addr.Absolute(module.Addr))
+			}
+			sensitive := sensitiveAttributes(schema)
+			for key := range rs.Instances {
+				attributes[addr.Instance(key).Absolute(module.Addr).String()] = sensitive
+			}
+		}
+	}
+
+	return attributes, nil
+}
+
+func sensitiveAttributes(schema *configschema.Block) map[string]bool {
+	sensitive := map[string]bool{}
+	for name, attr := range schema.Attributes {
+		if attr.Sensitive {
+			sensitive[name] = true
+		}
+	}
+	return sensitive
+}
+
+// SavePlan saves the last plan to the given file with the Terraform code, the
+// variables and the refreshed state used to create it. The saved plan can be
+// applied later with ApplyPlan. The file is readable only by the owner, the
+// variables and the state may have credentials
+func (t *Terraformer) SavePlan(filename string) error {
+	if t.plan == nil || t.context == nil || t.snapshot == nil {
+		return fmt.Errorf("there is no plan to save, get the plan first")
+	}
+
+	// the plan file requires a backend but Terraformer does not use any
+	backendConfig, err := plans.NewDynamicValue(cty.EmptyObjectVal, cty.EmptyObject)
+	if err != nil {
+		return err
+	}
+	t.plan.Backend = plans.Backend{
+		Type:      "local",
+		Config:    backendConfig,
+		Workspace: "default",
+	}
+
+	// planfile.Create keeps the permissions of an existing file
+	f, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to create the plan file %s. %s", filename, err)
+	}
+	f.Close()
+	if err := os.Chmod(filename, 0600); err != nil {
+		return fmt.Errorf("failed to set the permissions of the plan file %s. %s", filename, err)
+	}
+
+	sf := statefile.New(t.context.State(), "", 0)
+	if err := planfile.Create(filename, t.snapshot, sf, t.plan); err != nil {
+		return fmt.Errorf("failed to save the plan to %s. %s", filename, err)
+	}
+
+	return nil
+}
+
+// ApplyPlan applies exactly the changes in the plan saved in the given file.
+// The current state (State) is refreshed and compared with the state used to
+// create the plan, if they are different the plan is stale and it's not applied
+func (t *Terraformer) ApplyPlan(filename string) error {
+	t.lw.SetLogOut()
+	defer t.lw.RestoreLogOut()
+
+	r, err := planfile.Open(filename)
+	if err != nil {
+		return fmt.Errorf("failed to open the plan file %s. %s", filename, err)
+	}
+	defer r.Close()
+
+	plan, err := r.ReadPlan()
+	if err != nil {
+		return fmt.Errorf("failed to read the plan from %s. %s", filename, err)
+	}
+	sf, err := r.ReadStateFile()
+	if err != nil {
+		return fmt.Errorf("failed to read the state from the plan file %s. %s", filename, err)
+	}
+	cfg, diags := r.ReadConfig()
+	if diags.HasErrors() {
+		return fmt.Errorf("failed to read the Terraform code from the plan file %s. %s", filename, diags.Err())
+	}
+
+	vars := make(terraform.InputValues, len(plan.VariableValues))
+	for name, dv := range plan.VariableValues {
+		val, err := dv.Decode(cty.DynamicPseudoType)
+		if err != nil {
+			return fmt.Errorf("failed to decode the variable %q from the plan file %s. %s", name, filename, err)
+		}
+		vars[name] = &terraform.InputValue{
+			Value:      val,
+			SourceType: terraform.ValueFromPlan,
+		}
+	}
+
+	ctxOpts := terraform.ContextOpts{
+		Config:           cfg,
+		State:            t.State,
+		Variables:        vars,
+		ProviderResolver: providers.ResolverFixed(t.providers),
+		Provisioners:     t.provisioners,
+	}
+
+	// a plan is stale if the infrastructure changed after it was created
+	t.lw.Logger.Debugf("refreshing to verify the plan is not stale")
+	refreshCtx, diags := terraform.NewContext(&ctxOpts)
+	if diags.HasErrors() {
+		return fmt.Errorf("error creating context. %s", diags.Err())
+	}
+	current, diags := refreshCtx.Refresh()
+	if diags.HasErrors() {
+		return fmt.Errorf("error refreshing state before apply. %s", diags.Err())
+	}
+	if !current.Equal(sf.State) {
+		return fmt.Errorf("the plan in %s is stale, the infrastructure changed after the plan was created. Create a new plan", filename)
+	}
+
+	countHook := new(local.CountHook)
+	stateHook := new(local.StateHook)
+	t.Hooks = append(t.Hooks, stateHook, countHook)
+
+	ctxOpts.State = sf.State
+	ctxOpts.Changes = plan.Changes
+	ctxOpts.Destroy = isDestroy(plan)
+	ctxOpts.Hooks = t.Hooks
+	ctxOpts.ProviderSHA256s = plan.ProviderSHA256s
+	ctx, diags := terraform.NewContext(&ctxOpts)
+	if diags.HasErrors() {
+		return fmt.Errorf("error creating context. %s", diags.Err())
+	}
+	t.context = ctx
+	stateHook.StateMgr = t.stateMgr
+
+	t.Stats = NewStats(plan)
+	t.lw.Logger.Infof("actions: %d to add, %d to change, %d to destroy", t.Stats.Add, t.Stats.Change, t.Stats.Destroy)
+
+	state, diags := ctx.Apply()
+	t.State = state
+	if diags.HasErrors() {
+		return fmt.Errorf("error applying changes. The state has been partially updated with successfully completed resources. %s", diags.Err())
+	}
+
+	t.lw.Logger.Infof("apply complete, resources: %d added, %d changed, %d destroyed", countHook.Added, countHook.Changed, countHook.Removed)
+
+	return nil
+}
+
+// isDestroy returns true if the plan only destroys resources
+func isDestroy(plan *Plan) bool {
+	if plan.Changes == nil || plan.Changes.Empty() {
+		return false
+	}
+	for _, rc := range plan.Changes.Resources {
+		if rc.Action != plans.Delete && rc.Action != plans.NoOp {
+			return false
+		}
+	}
+	return true
+}
+
+func actionName(action plans.Action) string {
+	switch action {
+	case plans.Create:
+		return ActionCreate
+	case plans.Read:
+		return ActionRead
+	case plans.Update:
+		return ActionUpdate
+	case plans.DeleteThenCreate, plans.CreateThenDelete:
+		return ActionReplace
+	case plans.Delete:
+		return ActionDelete
+	default:
+		return ActionNoOp
+	}
+}
+
+// ctyToInterface converts a cty value to a Go value as it's done by the JSON
+// decoder, the unknown values are converted to KnownAfterApply
+func ctyToInterface(val cty.Value) interface{} {
+	if !val.IsKnown() {
+		return KnownAfterApply
+	}
+	if val.IsNull() {
+		return nil
+	}
+
+	ty := val.Type()
+	switch {
+	case ty == cty.String:
+		return val.AsString()
+	case ty == cty.Bool:
+		return val.True()
+	case ty == cty.Number:
+		bf := val.AsBigFloat()
+		if bf.IsInt() {
+			if i, acc := bf.Int64(); acc == big.Exact {
+				return i
+			}
+		}
+		f, _ := bf.Float64()
+		return f
+	case ty.IsListType() || ty.IsSetType() || ty.IsTupleType():
+		list := []interface{}{}
+		for it := val.ElementIterator(); it.Next(); {
+			_, v := it.Element()
+			list = append(list, ctyToInterface(v))
+		}
+		return list
+	case ty.IsMapType() || ty.IsObjectType():
+		m := map[string]interface{}{}
+		for it := val.ElementIterator(); it.Next(); {
+			k, v := it.Element()
+			m[k.AsString()] = ctyToInterface(v)
+		}
+		return m
+	default:
+		return strings.TrimSpace(fmt.Sprintf("%#v", val))
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"github.com/hashicorp/terraform/addrs"
 	"github.com/hashicorp/terraform/backend/local"
@@ -46,6 +47,7 @@ type Terraformer struct {
 	providers    map[addrs.Provider]providers.Factory
 	provisioners map[string]provisioners.Factory
 	context      *terraform.Context
+	snapshot     *configload.Snapshot
 	stateMgr     statemgr.Writer
 }
 
@@ -193,16 +195,16 @@ func (t *Terraformer) Apply(destroy bool) (err error) {
 	return nil
 }
 
-// Refresh refreshes state of the existig (or not) infrastructure.
+// Refresh refreshes state of the existig (or not) infrastructure. The refreshed
+// state is assigned to State but it's not persisted.
 func (t *Terraformer) Refresh(destroy bool) (err error) {
 	// Do not set the log out before planning bc Plan also set it out and then
 	// restore it. So, this will cause the following lines to log as TF does.
 	t.lw.SetLogOut()
 	defer t.lw.RestoreLogOut()
 
-	ctx := t.context
-	if ctx != nil {
-		ctx, err = t.NewContext(destroy)
+	if t.context == nil {
+		ctx, err := t.NewContext(destroy)
 		if err != nil {
 			return err
 		}
@@ -210,7 +212,13 @@ func (t *Terraformer) Refresh(destroy bool) (err error) {
 		t.context = ctx
 	}
 
-	return t.refresh()
+	state, diag := t.context.Refresh()
+	if diag.HasErrors() {
+		return diag.Err()
+	}
+	t.State = state
+
+	return nil
 }
 
 func (t *Terraformer) refresh() error {
@@ -445,7 +453,8 @@ func (t *Terraformer) saveCode(dir string) error {
 }
 
 // config returns a Terraform config where the Terraform template or
-// Terraformer Code will be loaded
+// Terraformer Code will be loaded. The snapshot of the loaded configuration is
+// kept to be saved with the plan
 func (t *Terraformer) config() (*configs.Config, error) {
 	if len(t.Code) == 0 {
 		return nil, fmt.Errorf("Code was not found")
@@ -466,10 +475,11 @@ func (t *Terraformer) config() (*configs.Config, error) {
 		return nil, err
 	}
 
-	cnf, diags := cnfLoader.LoadConfig(tfrDir)
+	cnf, snapshot, diags := cnfLoader.LoadConfigWithSnapshot(tfrDir)
 	if diags.HasErrors() {
 		return nil, fmt.Errorf("error loading the configuration. %s", diags.Error())
 	}
+	t.snapshot = snapshot
 
 	return cnf, nil
 }
@@ -492,17 +502,35 @@ func (t *Terraformer) variables(v map[string]*configs.Variable) (terraform.Input
 	return iv, nil
 }
 
+// StateCipher encrypts and decrypts the state file. Decrypt should return the
+// data as is if it's not encrypted
+type StateCipher interface {
+	Encrypt(plaintext []byte) ([]byte, error)
+	Decrypt(data []byte) ([]byte, error)
+}
+
 // PersistStateToFile reads the state from the given file, if exists. Then will save
 // the current state to the given file every time it changes during the Terraform
 // actions.
 func (t *Terraformer) PersistStateToFile(filename string) error {
+	return t.PersistStateToEncryptedFile(filename, nil)
+}
+
+// PersistStateToEncryptedFile is like PersistStateToFile but the state file is
+// encrypted with the given cipher. If the cipher is nil the state file is not
+// encrypted
+func (t *Terraformer) PersistStateToEncryptedFile(filename string, c StateCipher) error {
 	readStateFromFile := func() error {
-		file, err := os.Open(filename)
-		defer file.Close()
+		data, err := ioutil.ReadFile(filename)
 		if err != nil {
 			return err
 		}
-		return t.LoadState(file)
+		if c != nil {
+			if data, err = c.Decrypt(data); err != nil {
+				return fmt.Errorf("failed to decrypt the state file %s. %s", filename, err)
+			}
+		}
+		return t.LoadState(bytes.NewReader(data))
 	}
 
 	if _, err := os.Stat(filename); !os.IsNotExist(err) {
@@ -513,6 +541,16 @@ func (t *Terraformer) PersistStateToFile(filename string) error {
 		os.Rename(filename, filename+".bkp")
 	}
 
+	if c != nil {
+		stateMgr := &encryptedStateMgr{filename: filename, cipher: c}
+		// The files does not exists, create it with the current state: empty or loaded
+		if err := stateMgr.WriteState(t.State); err != nil {
+			return err
+		}
+		t.stateMgr = stateMgr
+		return nil
+	}
+
 	writeStateToFile := func() error {
 		var state bytes.Buffer
 		if err := t.SaveState(&state); err != nil {
@@ -531,3 +569,27 @@ func (t *Terraformer) PersistStateToFile(filename string) error {
 
 	return nil
 }
+
+// encryptedStateMgr saves the state to an encrypted file every time the state
+// changes during the Terraform actions
+type encryptedStateMgr struct {
+	filename string
+	cipher   StateCipher
+	mu       sync.Mutex
+}
+
+// WriteState encrypts the given state and saves it to the file
+func (m *encryptedStateMgr) WriteState(state *states.State) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var b bytes.Buffer
+	if err := SaveState(&b, state); err != nil {
+		return err
+	}
+	data, err := m.cipher.Encrypt(b.Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to encrypt the state file %s. %s", m.filename, err)
+	}
+	return ioutil.WriteFile(m.filename, data, 0600)
+}
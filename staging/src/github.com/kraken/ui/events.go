@@ -0,0 +1,222 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EventType is the type of a progress event
+type EventType string
+
+// Progress event types
+const (
+	HostTaskStarted  EventType = "HostTaskStarted"
+	HostTaskFinished EventType = "HostTaskFinished"
+	HostFailed       EventType = "HostFailed"
+	PhaseChanged     EventType = "PhaseChanged"
+)
+
+// Status of the finished tasks
+const (
+	StatusOk     = "ok"
+	StatusFailed = "failed"
+)
+
+// Event is a progress event of the cluster creation or configuration
+type Event struct {
+	Time    time.Time `json:"time"`
+	Type    EventType `json:"type"`
+	Cluster string    `json:"cluster,omitempty"`
+	Phase   string    `json:"phase,omitempty"`
+	Host    string    `json:"host,omitempty"`
+	Task    string    `json:"task,omitempty"`
+	Status  string    `json:"status,omitempty"`
+	Message string    `json:"message,omitempty"`
+}
+
+// EventHandler receives the progress events
+type EventHandler func(Event)
+
+// eventHandlers are the handlers registered to a UI, shared with the copies of
+// the UI so the events of every cluster reach them
+type eventHandlers struct {
+	handlers []EventHandler
+	l        sync.Mutex
+}
+
+// OnEvent registers a handler to receive the progress events emitted by this
+// UI and its copies
+func (ui *UI) OnEvent(handler EventHandler) {
+	ui.events.l.Lock()
+	defer ui.events.l.Unlock()
+	ui.events.handlers = append(ui.events.handlers, handler)
+}
+
+// SetCluster sets the cluster name of the events emitted without one
+func (ui *UI) SetCluster(name string) {
+	ui.cluster = name
+}
+
+// Emit sends the event to all the registered handlers, in the order they were
+// registered. The handlers are called without holding the lock, so they can
+// emit events or register handlers, and have to be safe for concurrent use as
+// the events may be emitted from different goroutines
+func (ui *UI) Emit(event Event) {
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+	if len(event.Cluster) == 0 {
+		event.Cluster = ui.cluster
+	}
+
+	ui.events.l.Lock()
+	handlers := make([]EventHandler, len(ui.events.handlers))
+	copy(handlers, ui.events.handlers)
+	ui.events.l.Unlock()
+
+	for _, handler := range handlers {
+		handler(event)
+	}
+}
+
+// EmitPhase emits a PhaseChanged event for the given phase
+func (ui *UI) EmitPhase(phase string) {
+	ui.Emit(Event{Type: PhaseChanged, Phase: phase})
+}
+
+// NewJSONLinesHandler returns a handler printing every event as a JSON document
+// in a line to the given writer
+func NewJSONLinesHandler(w io.Writer) EventHandler {
+	encoder := json.NewEncoder(w)
+	var l sync.Mutex
+	return func(event Event) {
+		l.Lock()
+		defer l.Unlock()
+		encoder.Encode(event)
+	}
+}
+
+// hostProgress is the progress of the configuration of a host
+type hostProgress struct {
+	task     string
+	started  time.Time
+	finished int
+	failed   bool
+	message  string
+}
+
+// Progress renders the progress of every host in a line, the lines are
+// updated in place every time an event is received
+type Progress struct {
+	out   io.Writer
+	phase string
+	hosts map[string]*hostProgress
+	order []string
+	lines int
+	l     sync.Mutex
+}
+
+// NewProgress creates a Progress renderer printing to the given writer
+func NewProgress(out io.Writer) *Progress {
+	return &Progress{
+		out:   out,
+		hosts: make(map[string]*hostProgress),
+	}
+}
+
+// Handle updates the progress with the given event and renders it. It's an
+// EventHandler
+func (p *Progress) Handle(event Event) {
+	p.l.Lock()
+	defer p.l.Unlock()
+
+	p.update(event)
+	p.render()
+}
+
+func (p *Progress) update(event Event) {
+	if event.Type == PhaseChanged {
+		p.phase = event.Phase
+		return
+	}
+	if len(event.Host) == 0 {
+		return
+	}
+
+	host, ok := p.hosts[event.Host]
+	if !ok {
+		host = &hostProgress{}
+		p.hosts[event.Host] = host
+		p.order = append(p.order, event.Host)
+		sort.Strings(p.order)
+	}
+
+	switch event.Type {
+	case HostTaskStarted:
+		host.task, host.started = event.Task, event.Time
+	case HostTaskFinished:
+		host.finished++
+		host.task = ""
+		if event.Status == StatusFailed {
+			host.failed = true
+			host.message = fmt.Sprintf("task %s failed", event.Task)
+		}
+	case HostFailed:
+		host.failed = true
+		host.task = ""
+		if len(event.Message) != 0 {
+			host.message = event.Message
+		}
+	}
+}
+
+// render moves the cursor up to the first line of the previous rendering and
+// prints the progress again
+func (p *Progress) render() {
+	if p.lines != 0 {
+		fmt.Fprintf(p.out, "\033[%dA", p.lines)
+	}
+	output := p.string()
+	for _, line := range strings.Split(strings.TrimSuffix(output, "\n"), "\n") {
+		fmt.Fprintf(p.out, "\033[2K%s\n", line)
+	}
+	p.lines = strings.Count(output, "\n")
+}
+
+// String returns the progress of every host, one per line, after the current
+// phase
+func (p *Progress) String() string {
+	p.l.Lock()
+	defer p.l.Unlock()
+	return p.string()
+}
+
+func (p *Progress) string() string {
+	var b strings.Builder
+	phase := p.phase
+	if len(phase) == 0 {
+		phase = "starting"
+	}
+	fmt.Fprintf(&b, "%sPhase: %s%s\n", BlackBold, phase, Reset)
+
+	for _, name := range p.order {
+		host := p.hosts[name]
+		var status string
+		switch {
+		case host.failed:
+			status = fmt.Sprintf("%sfailed%s: %s", Red, Reset, host.message)
+		case len(host.task) != 0:
+			status = fmt.Sprintf("%s%s%s (%s)", Yellow, host.task, Reset, time.Since(host.started).Round(time.Second))
+		default:
+			status = fmt.Sprintf("%sok%s", Green, Reset)
+		}
+		fmt.Fprintf(&b, "  %-20s %3d tasks  %s\n", name, host.finished, status)
+	}
+
+	return b.String()
+}
@@ -31,8 +31,10 @@ type UI struct {
 	scroll bool
 	lines  int
 	order  []string
+	// events are shared with the copies of this UI
+	events  *eventHandlers
+	cluster string
 	// Out *Output
-	// cluster  string
 }
 
 // New creates a new UI
@@ -42,6 +44,7 @@ func New(scroll bool, logger *log.Logger) *UI {
 		Tasks:  NewTasks(),
 		Log:    logger,
 		scroll: scroll,
+		events: &eventHandlers{},
 	}
 	if !scroll {
 
@@ -60,11 +63,13 @@ func (ui *UI) Copy() *UI {
 	copy(order, ui.order)
 
 	return &UI{
-		Tasks:  tasks,
-		Log:    logger,
-		scroll: ui.scroll,
-		lines:  ui.lines,
-		order:  order,
+		Tasks:   tasks,
+		Log:     logger,
+		scroll:  ui.scroll,
+		lines:   ui.lines,
+		order:   order,
+		events:  ui.events,
+		cluster: ui.cluster,
 	}
 }
 
@@ -2,6 +2,7 @@ package azure
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
 	"github.com/Azure/go-autorest/autorest/azure"
@@ -70,3 +71,45 @@ func UpdateVM(vmClient *compute.VirtualMachinesClient, resourceGroupName, vmName
 
 	return nil
 }
+
+// ListVMNames lists the virtual machine names in a resource group
+func ListVMNames(vmClient *compute.VirtualMachinesClient, resourceGroupName string) ([]string, error) {
+	var names []string
+
+	vms, err := GetVMs(vmClient, resourceGroupName, nil)
+	if err != nil {
+		return names, err
+	}
+
+	for _, vm := range vms {
+		names = append(names, *vm.Name)
+	}
+
+	return names, nil
+}
+
+// PowerOffVMWithContext powers off the specified VM and waits until it's stopped
+func PowerOffVMWithContext(vmClient *compute.VirtualMachinesClient, ctx context.Context, resourceGroupName, vmName string) error {
+	future, err := vmClient.PowerOff(ctx, resourceGroupName, vmName, nil)
+	if err != nil {
+		return fmt.Errorf("cannot power off vm: %v", err)
+	}
+	if err := future.WaitForCompletionRef(ctx, vmClient.Client); err != nil {
+		return fmt.Errorf("cannot get the vm power off future response: %v", err)
+	}
+
+	return nil
+}
+
+// StartVMWithContext starts the specified VM and waits until it's running
+func StartVMWithContext(vmClient *compute.VirtualMachinesClient, ctx context.Context, resourceGroupName, vmName string) error {
+	future, err := vmClient.Start(ctx, resourceGroupName, vmName)
+	if err != nil {
+		return fmt.Errorf("cannot start vm: %v", err)
+	}
+	if err := future.WaitForCompletionRef(ctx, vmClient.Client); err != nil {
+		return fmt.Errorf("cannot get the vm start future response: %v", err)
+	}
+
+	return nil
+}
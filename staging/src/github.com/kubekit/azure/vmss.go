@@ -162,3 +162,39 @@ func ListVMSSNames(vmssClient *compute.VirtualMachineScaleSetsClient, resourceGr
 
 	return names, nil
 }
+
+// PowerOffVMSSWithContext powers off the given instances, or all the instances if none is given, of the virtual machine scale set and waits until they are stopped
+func PowerOffVMSSWithContext(vmssClient *compute.VirtualMachineScaleSetsClient, ctx context.Context, resourceGroupName, vmssName string, instanceIDs ...string) error {
+	future, err := vmssClient.PowerOff(ctx, resourceGroupName, vmssName, vmssInstanceIDs(instanceIDs), nil)
+	if err != nil {
+		return fmt.Errorf("cannot power off vmss: %v", err)
+	}
+	if err := future.WaitForCompletionRef(ctx, vmssClient.Client); err != nil {
+		return fmt.Errorf("cannot get the vmss power off future response: %v", err)
+	}
+
+	return nil
+}
+
+// StartVMSSWithContext starts the given instances, or all the instances if none is given, of the virtual machine scale set and waits until they are running
+func StartVMSSWithContext(vmssClient *compute.VirtualMachineScaleSetsClient, ctx context.Context, resourceGroupName, vmssName string, instanceIDs ...string) error {
+	future, err := vmssClient.Start(ctx, resourceGroupName, vmssName, vmssInstanceIDs(instanceIDs))
+	if err != nil {
+		return fmt.Errorf("cannot start vmss: %v", err)
+	}
+	if err := future.WaitForCompletionRef(ctx, vmssClient.Client); err != nil {
+		return fmt.Errorf("cannot get the vmss start future response: %v", err)
+	}
+
+	return nil
+}
+
+// vmssInstanceIDs returns the instance IDs parameter of a virtual machine scale set operation, nil to apply it to all the instances
+func vmssInstanceIDs(instanceIDs []string) *compute.VirtualMachineScaleSetVMInstanceIDs {
+	if len(instanceIDs) == 0 {
+		return nil
+	}
+	return &compute.VirtualMachineScaleSetVMInstanceIDs{
+		InstanceIds: &instanceIDs,
+	}
+}